@@ -0,0 +1,90 @@
+// Package inventory is an example third-party adapter: the metrics of an
+// in-house service, listed in code rather than fetched, registered from
+// init so a blank import is all a binary needs.
+package inventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// version stands in for a commit; bump it when the metric list changes.
+const version = "2026-10-18"
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "inventory-service",
+		Category:    domain.SourceInternal,
+		Description: "Inventory service metrics",
+		Schedule:    adapter.ScheduleWeekly,
+		Tags:        []string{"inhouse"},
+		New: func(adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(), nil
+		},
+	})
+}
+
+type Adapter struct{}
+
+func NewAdapter() *Adapter {
+	return &Adapter{}
+}
+
+func (a *Adapter) Name() string {
+	return "inventory-service"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceInternal
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMetadata
+}
+
+func (a *Adapter) RepoURL() string {
+	return "https://git.example.com/inventory"
+}
+
+func (a *Adapter) Fetch(_ context.Context, _ adapter.FetchOptions) (*adapter.FetchResult, error) {
+	ts, err := time.Parse(time.DateOnly, version)
+	if err != nil {
+		return nil, err
+	}
+	return &adapter.FetchResult{
+		Commit:    version,
+		Timestamp: ts,
+	}, nil
+}
+
+func (a *Adapter) Extract(_ context.Context, _ *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	return []*adapter.RawMetric{
+		{
+			Name:             "inventory.reservations",
+			Description:      "Stock reservations made",
+			InstrumentType:   string(domain.InstrumentCounter),
+			Unit:             "{reservation}",
+			Attributes:       []domain.Attribute{{Name: "warehouse", Type: "string"}},
+			EnabledByDefault: true,
+			ComponentType:    "service",
+			ComponentName:    "inventory",
+		},
+		{
+			Name:             "inventory.stock_level",
+			Description:      "Units on hand per SKU",
+			InstrumentType:   string(domain.InstrumentGauge),
+			Unit:             "{unit}",
+			Attributes:       []domain.Attribute{{Name: "warehouse", Type: "string"}, {Name: "sku", Type: "string"}},
+			EnabledByDefault: true,
+			ComponentType:    "service",
+			ComponentName:    "inventory",
+		},
+	}, nil
+}
@@ -0,0 +1,27 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+func TestInventoryAdapter_Registered(t *testing.T) {
+	adp, err := adapter.New("inventory-service", adapter.Options{})
+	if err != nil {
+		t.Fatalf("expected the blank import to register the adapter: %v", err)
+	}
+
+	result, err := adp.Fetch(context.Background(), adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected fetch error: %v", err)
+	}
+	metrics, err := adp.Extract(context.Background(), result)
+	if err != nil {
+		t.Fatalf("unexpected extract error: %v", err)
+	}
+	if len(metrics) != 2 {
+		t.Errorf("expected 2 metrics, got %d", len(metrics))
+	}
+}
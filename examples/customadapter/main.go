@@ -0,0 +1,23 @@
+// Command customadapter is the glossary CLI with one extra adapter,
+// inventory-service, compiled in. It imports only packages outside
+// internal/, so a binary in another module is built the same way: blank
+// import the adapter packages, then hand the arguments to glossary.Main.
+//
+//	go run ./examples/customadapter adapters list -tag inhouse
+//	go run ./examples/customadapter extract -adapter inventory-service
+package main
+
+import (
+	"fmt"
+	"os"
+
+	_ "github.com/base-14/metric-library/examples/customadapter/inventory"
+	"github.com/base-14/metric-library/pkg/glossary"
+)
+
+func main() {
+	if err := glossary.Main(os.Args[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,92 @@
+package clouddata
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Adapter serves one embedded table. There is nothing to clone; the table's
+// version is reported as the commit so re-extracting an unchanged table
+// records the same snapshot.
+type Adapter struct {
+	table *Table
+}
+
+// providers names the upstream of each table directory.
+var providers = map[string]string{
+	"cloudwatch": "AWS CloudWatch",
+	"gcp":        "Google Cloud Monitoring",
+	"azure":      "Azure Monitor",
+}
+
+// Every embedded table registers as an adapter, so adding a data file is
+// all a new cloud service needs.
+func init() {
+	for _, key := range Keys() {
+		t, err := Load(key)
+		if err != nil {
+			panic(err)
+		}
+		provider, service, _ := strings.Cut(key, "/")
+		adapter.Register(adapter.Registration{
+			Name:        t.Adapter,
+			Category:    domain.SourceCloud,
+			Description: providers[provider] + " " + t.Component + " metrics",
+			Schedule:    adapter.ScheduleWeekly,
+			Tags:        []string{"cloud", provider, service},
+			New: func(adapter.Options) (adapter.Adapter, error) {
+				return &Adapter{table: t}, nil
+			},
+		})
+	}
+}
+
+// NewAdapter returns the adapter for an embedded table such as
+// "cloudwatch/ec2". It panics if the table is missing or invalid, which the
+// package tests rule out for every file under data/.
+func NewAdapter(key string) *Adapter {
+	t, err := Load(key)
+	if err != nil {
+		panic(err)
+	}
+	return &Adapter{table: t}
+}
+
+func (a *Adapter) Name() string {
+	return a.table.Adapter
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceCloud
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceDocumented
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionScrape
+}
+
+func (a *Adapter) RepoURL() string {
+	return a.table.Source
+}
+
+func (a *Adapter) Fetch(_ context.Context, _ adapter.FetchOptions) (*adapter.FetchResult, error) {
+	ts, err := time.Parse(VersionLayout, a.table.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &adapter.FetchResult{
+		Commit:    a.table.Commit(),
+		Timestamp: ts,
+	}, nil
+}
+
+func (a *Adapter) Extract(_ context.Context, _ *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	return a.table.RawMetrics(), nil
+}
@@ -0,0 +1,117 @@
+package clouddata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func TestAdapterImplementsInterface(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("gcp/compute")
+}
+
+func TestAdapterProperties(t *testing.T) {
+	a := NewAdapter("cloudwatch/ec2")
+
+	if a.Name() != "cloudwatch-ec2" {
+		t.Errorf("expected name cloudwatch-ec2, got %s", a.Name())
+	}
+	if a.SourceCategory() != domain.SourceCloud {
+		t.Errorf("expected source category cloud, got %s", a.SourceCategory())
+	}
+	if a.Confidence() != domain.ConfidenceDocumented {
+		t.Errorf("expected confidence documented, got %s", a.Confidence())
+	}
+	if a.ExtractionMethod() != domain.ExtractionScrape {
+		t.Errorf("expected extraction method scrape, got %s", a.ExtractionMethod())
+	}
+	if a.RepoURL() == "" {
+		t.Error("expected a source URL")
+	}
+}
+
+func TestAdapterFetchReportsTableVersion(t *testing.T) {
+	a := NewAdapter("azure/vm")
+
+	result, err := a.Fetch(context.Background(), adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("unexpected fetch error: %v", err)
+	}
+	// The embedded tables were transcribed, not captured, so the commit
+	// says so until a refresh
+	if want := a.table.Version + "-unverified"; result.Commit != want {
+		t.Errorf("expected commit %s, got %s", want, result.Commit)
+	}
+	if result.Timestamp.Format(VersionLayout) != a.table.Version {
+		t.Errorf("expected timestamp on %s, got %s", a.table.Version, result.Timestamp)
+	}
+
+	metrics, err := a.Extract(context.Background(), result)
+	if err != nil {
+		t.Fatalf("unexpected extract error: %v", err)
+	}
+	if len(metrics) != len(a.table.Metrics) {
+		t.Errorf("expected %d metrics, got %d", len(a.table.Metrics), len(metrics))
+	}
+}
+
+func TestNewAdapterPanicsOnUnknownTable(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	NewAdapter("gcp/nope")
+}
+
+func TestAdapterExtractAzureTables(t *testing.T) {
+	tests := []struct {
+		key            string
+		count          int
+		componentName  string
+		sourceLocation string
+	}{
+		{"azure/aks", 30, "AKS", "Microsoft.ContainerService/managedClusters"},
+		{"azure/appgateway", 20, "Application Gateway", "Microsoft.Network/applicationGateways"},
+		{"azure/blobstorage", 11, "Blob Storage", "Microsoft.Storage/storageAccounts/blobServices"},
+		{"azure/cosmosdb", 26, "Cosmos DB", "Microsoft.DocumentDB/databaseAccounts"},
+		{"azure/functions", 16, "Azure Functions", "Microsoft.Web/sites"},
+		{"azure/servicebus", 20, "Service Bus", "Microsoft.ServiceBus/namespaces"},
+		{"azure/sqldatabase", 39, "SQL Database", "Microsoft.Sql/servers/databases"},
+		{"azure/vm", 36, "Virtual Machines", "Microsoft.Compute/virtualMachines"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			a := NewAdapter(tt.key)
+			result, err := a.Fetch(context.Background(), adapter.FetchOptions{})
+			if err != nil {
+				t.Fatalf("unexpected fetch error: %v", err)
+			}
+			metrics, err := a.Extract(context.Background(), result)
+			if err != nil {
+				t.Fatalf("unexpected extract error: %v", err)
+			}
+
+			if len(metrics) != tt.count {
+				t.Errorf("expected %d metrics, got %d", tt.count, len(metrics))
+			}
+			for _, m := range metrics {
+				if m.Name == "" {
+					t.Error("metric name should not be empty")
+				}
+				if m.ComponentName != tt.componentName {
+					t.Errorf("expected component name %s, got %s", tt.componentName, m.ComponentName)
+				}
+				if m.ComponentType != string(domain.ComponentPlatform) {
+					t.Errorf("expected component type platform, got %s", m.ComponentType)
+				}
+				if m.SourceLocation != tt.sourceLocation {
+					t.Errorf("expected source location %s, got %s", tt.sourceLocation, m.SourceLocation)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package clouddata
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+var (
+	// Match a lower-case letter or digit followed by an upper-case letter,
+	// the word boundaries of REST names like TotalRequestUnits
+	camelBoundaryPattern = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+	// Match the end of an acronym, as in DiskIOPSConsumed
+	acronymBoundaryPattern = regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`)
+	nonAlnumPattern        = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// azureUnits maps Azure Monitor units to UCUM.
+var azureUnits = map[string]string{
+	"percent":        "%",
+	"bytes":          "By",
+	"bytespersecond": "By/s",
+	"count":          "1",
+	"countpersecond": "1/s",
+	"milliseconds":   "ms",
+	"seconds":        "s",
+	"bitspersecond":  "bit/s",
+}
+
+// ParseAzureMetrics reads a saved Azure Monitor supported-metrics page.
+// Both the current layout, where the Metric cell holds the display name and
+// description and a "Name in REST API" column follows, and the older one
+// with separate Metric and Description columns are understood. Names are
+// the REST name in snake case under prefix, e.g. "azure.vm.". Aggregation
+// types become the rows' statistics, the first being the recommended one,
+// and the finest time grain their period.
+func ParseAzureMetrics(content []byte, prefix string) (*Table, error) {
+	parsed := &Table{}
+	seen := make(map[string]bool)
+
+	for _, t := range readTables(string(content)) {
+		metricCol := t.column("metric")
+		restCol := t.column("name in rest api")
+		unitCol := t.column("unit")
+		if metricCol == -1 || unitCol == -1 {
+			continue
+		}
+		aggCol := t.column("aggregation")
+		descCol := t.column("description")
+		dimsCol := t.column("dimension")
+		grainCol := t.column("time grain")
+
+		for _, row := range t.rows {
+			rest := firstLine(cell(row, metricCol))
+			description := cell(row, descCol)
+			if restCol != -1 {
+				// Display name on the first line, description below it
+				rest = firstLine(cell(row, restCol))
+				_, description, _ = strings.Cut(cell(row, metricCol), "\n")
+			}
+			name := azureName(rest)
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			statistics := azureAggregations(cell(row, aggCol))
+			m := Metric{
+				Name:        prefix + name,
+				Description: firstSentence(strings.ReplaceAll(description, "\n", " ")),
+				Unit:        azureUnit(cell(row, unitCol)),
+				Type:        azureType(statistics),
+				Dimensions:  splitList(cell(row, dimsCol)),
+				Statistics:  statistics,
+				Period:      finestTimeGrain(cell(row, grainCol)),
+			}
+			if len(statistics) > 0 {
+				m.Statistic = statistics[0]
+			}
+			parsed.Metrics = append(parsed.Metrics, m)
+		}
+	}
+
+	if len(parsed.Metrics) == 0 {
+		return nil, errors.New("no Azure Monitor metric tables found")
+	}
+	return parsed, nil
+}
+
+// azureAggregations reads an aggregation cell such as "Total (Sum),
+// Average, Maximum" into Azure's aggregation type names.
+func azureAggregations(cell string) []string {
+	var aggregations []string
+	for _, name := range splitList(cell) {
+		name, _, _ = strings.Cut(name, " ")
+		switch strings.ToLower(name) {
+		case "total", "sum":
+			name = "Total"
+		case "average", "avg":
+			name = "Average"
+		case "minimum", "min":
+			name = "Minimum"
+		case "maximum", "max":
+			name = "Maximum"
+		case "count":
+			name = "Count"
+		default:
+			continue
+		}
+		if !contains(aggregations, name) {
+			aggregations = append(aggregations, name)
+		}
+	}
+	return aggregations
+}
+
+// finestTimeGrain returns the smallest ISO 8601 grain in a cell such as
+// "PT1M, PT5M, PT1H" as a duration, or "" when there is none.
+func finestTimeGrain(cell string) string {
+	var finest time.Duration
+	for _, grain := range splitList(cell) {
+		d, err := time.ParseDuration(strings.ToLower(strings.TrimPrefix(strings.ToUpper(grain), "PT")))
+		if err != nil || d <= 0 {
+			continue
+		}
+		if finest == 0 || d < finest {
+			finest = d
+		}
+	}
+	if finest == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%ds", int(finest.Seconds()))
+}
+
+// azureName snake-cases a REST metric name: "Disk Read Bytes/sec" becomes
+// disk_read_bytes_per_sec and "TotalRequestUnits" total_request_units.
+func azureName(rest string) string {
+	name := strings.ReplaceAll(strings.TrimSpace(rest), "/", " per ")
+	name = acronymBoundaryPattern.ReplaceAllString(name, "${1}_${2}")
+	name = strings.ToLower(camelBoundaryPattern.ReplaceAllString(name, "${1}_${2}"))
+	return strings.Trim(nonAlnumPattern.ReplaceAllString(name, "_"), "_")
+}
+
+func azureUnit(unit string) string {
+	unit = strings.TrimSpace(unit)
+	if ucum, ok := azureUnits[strings.ToLower(unit)]; ok {
+		return ucum
+	}
+	return unit
+}
+
+// azureType reads the default (first listed) aggregation: Total and Count
+// accumulate per time grain, Average, Minimum and Maximum sample a level.
+func azureType(aggregations []string) string {
+	if len(aggregations) > 0 && (aggregations[0] == "Total" || aggregations[0] == "Count") {
+		return string(domain.InstrumentCounter)
+	}
+	return string(domain.InstrumentGauge)
+}
@@ -0,0 +1,93 @@
+package clouddata
+
+import "testing"
+
+const azurePage = `---
+title: Supported metrics - Microsoft.Compute/virtualMachines
+---
+
+## Category: Disk
+
+|Metric|Name in REST API|Unit|Aggregation|Dimensions|Time Grains|DS Export|
+|---|---|---|---|---|---|---|
+|**Disk Read Bytes**<br><br>Bytes read from disk during monitoring period.|` + "`Disk Read Bytes`" + `|Bytes|Total (Sum), Average, Minimum, Maximum|<none>|PT1M|Yes|
+|**OS Disk Read Bytes/Sec**<br><br>Bytes/Sec read from a single disk during monitoring period for OS disk.|` + "`OS Disk Read Bytes/sec`" + `|BytesPerSecond|Average, Minimum, Maximum|<none>|PT1M|Yes|
+|**Percentage CPU**<br><br>The percentage of allocated compute units that are currently in use by the Virtual Machine(s).|` + "`Percentage CPU`" + `|Percent|Average, Minimum, Maximum|<none>|PT1M|Yes|
+`
+
+func TestParseAzureMetrics(t *testing.T) {
+	parsed, err := ParseAzureMetrics([]byte(azurePage), "azure.vm.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := parsed.Metrics
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d", len(metrics))
+	}
+
+	if m := metrics[0]; m.Name != "azure.vm.disk_read_bytes" || m.Unit != "By" || m.Type != "counter" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := metrics[1]; m.Name != "azure.vm.os_disk_read_bytes_per_sec" || m.Unit != "By/s" || m.Type != "gauge" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := metrics[2]; m.Name != "azure.vm.percentage_cpu" || m.Unit != "%" ||
+		m.Description != "The percentage of allocated compute units that are currently in use by the Virtual Machine(s)" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+}
+
+func TestParseAzureMetricsAggregations(t *testing.T) {
+	parsed, err := ParseAzureMetrics([]byte(azurePage), "azure.vm.")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	disk := parsed.Metrics[0]
+	if want := []string{"Total", "Average", "Minimum", "Maximum"}; !equalStrings(disk.Statistics, want) || disk.Statistic != "Total" {
+		t.Errorf("expected aggregations %v recommending Total, got %v and %s", want, disk.Statistics, disk.Statistic)
+	}
+	if disk.Period != "60s" || len(disk.Dimensions) != 0 {
+		t.Errorf("expected a 60s period and no dimensions, got %+v", disk)
+	}
+}
+
+func TestFinestTimeGrain(t *testing.T) {
+	tests := map[string]string{
+		"PT1M":             "60s",
+		"PT5M, PT1M, PT1H": "60s",
+		"PT1H":             "3600s",
+		"":                 "",
+		"n/a":              "",
+	}
+	for cell, want := range tests {
+		if got := finestTimeGrain(cell); got != want {
+			t.Errorf("finestTimeGrain(%q) = %q, want %q", cell, got, want)
+		}
+	}
+}
+
+func TestParseAzureMetricsLegacyLayout(t *testing.T) {
+	page := `|Metric|Exportable via Diagnostic Settings?|Metric Display Name|Unit|Aggregation Type|Description|Dimensions|
+|---|---|---|---|---|---|---|
+|TotalRequestUnits|Yes|Total Request Units|Count|Total|Request Units consumed|DatabaseName|
+|DiskIOPSConsumedPercentage|Yes|Disk IOPS Consumed|Percent|Average|Percentage of disk IOPS consumed|<none>|
+`
+	parsed, err := ParseAzureMetrics([]byte(page), "azure.cosmosdb.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := parsed.Metrics
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+	if m := metrics[0]; m.Name != "azure.cosmosdb.total_request_units" || m.Unit != "1" || m.Type != "counter" || m.Description != "Request Units consumed" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := metrics[1]; m.Name != "azure.cosmosdb.disk_iops_consumed_percentage" || m.Type != "gauge" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := metrics[0]; !equalStrings(m.Dimensions, []string{"DatabaseName"}) || m.Statistic != "Total" {
+		t.Errorf("expected DatabaseName dimension and Total aggregation, got %+v", m)
+	}
+}
@@ -0,0 +1,151 @@
+package clouddata
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+var (
+	cloudWatchNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.\-]*$`)
+	// Match the "Units: Percent" note AWS puts under a description
+	cloudWatchUnitPattern = regexp.MustCompile(`(?i)^units?\s*:\s*(.+)$`)
+	// Match "Valid statistics: ...", "Meaningful statistics: ..." and
+	// "The most useful statistic is Sum" notes
+	cloudWatchStatsPattern = regexp.MustCompile(`(?i)statistics?\b[^:]*?(?::|\bis\b|\bare\b)\s*(.+)$`)
+	statisticPattern       = regexp.MustCompile(`\b(Sum|Average|Maximum|Minimum|SampleCount)\b`)
+)
+
+// ParseCloudWatch reads the metric and dimension tables of a saved
+// CloudWatch documentation page, HTML as served or Markdown from the docs
+// sources. The result holds the metrics and the dimension descriptions.
+func ParseCloudWatch(content []byte) (*Table, error) {
+	parsed := &Table{}
+	seen := make(map[string]bool)
+
+	for _, t := range readTables(string(content)) {
+		descCol := t.column("description")
+		if dimCol := t.column("dimension"); dimCol == 0 && descCol != -1 {
+			for _, row := range t.rows {
+				if fields := strings.Fields(cell(row, dimCol)); len(fields) > 0 {
+					parsed.Dimensions = append(parsed.Dimensions, Dimension{
+						Name:        fields[0],
+						Description: firstSentence(strings.ReplaceAll(cell(row, descCol), "\n", " ")),
+					})
+				}
+			}
+			continue
+		}
+
+		nameCol := t.column("metric")
+		if nameCol == -1 || descCol == -1 {
+			continue
+		}
+		unitCol := t.column("unit")
+		statsCol := t.column("statistic")
+		dimsCol := t.column("dimension")
+
+		for _, row := range t.rows {
+			fields := strings.Fields(cell(row, nameCol))
+			if len(fields) == 0 || !cloudWatchNamePattern.MatchString(fields[0]) || seen[fields[0]] {
+				continue
+			}
+			seen[fields[0]] = true
+
+			var description []string
+			unit := firstLine(cell(row, unitCol))
+			stats := cell(row, statsCol)
+			for _, line := range strings.Split(cell(row, descCol), "\n") {
+				if m := cloudWatchUnitPattern.FindStringSubmatch(line); m != nil {
+					unit = m[1]
+					continue
+				}
+				if m := cloudWatchStatsPattern.FindStringSubmatch(line); m != nil && len(description) > 0 {
+					stats += " " + m[1]
+					continue
+				}
+				description = append(description, line)
+			}
+
+			unit = cloudWatchUnit(unit)
+			statistics := cloudWatchStatistics(stats)
+			m := Metric{
+				Name:        fields[0],
+				Description: firstSentence(strings.Join(description, " ")),
+				Unit:        unit,
+				Type:        cloudWatchType(unit, statistics),
+				Dimensions:  splitList(cell(row, dimsCol)),
+				Statistics:  statistics,
+			}
+			if len(statistics) > 0 {
+				m.Statistic = statistics[0]
+			}
+			parsed.Metrics = append(parsed.Metrics, m)
+		}
+	}
+
+	if len(parsed.Metrics) == 0 {
+		return nil, errors.New("no CloudWatch metric tables found")
+	}
+	return parsed, nil
+}
+
+// cloudWatchStatistics lists the statistics a note names, in order, so the
+// recommended one comes first.
+func cloudWatchStatistics(stats string) []string {
+	var statistics []string
+	for _, m := range statisticPattern.FindAllStringSubmatch(stats, -1) {
+		if !contains(statistics, m[1]) {
+			statistics = append(statistics, m[1])
+		}
+	}
+	return statistics
+}
+
+// cloudWatchUnit keeps the first unit of notes like "Bytes or Count".
+func cloudWatchUnit(unit string) string {
+	unit = strings.TrimSuffix(strings.TrimSpace(unit), ".")
+	if fields := strings.Fields(unit); len(fields) > 0 {
+		return strings.TrimSuffix(fields[0], ",")
+	}
+	return ""
+}
+
+// cloudWatchType guesses an instrument type. CloudWatch has no types, only
+// statistics: a metric whose recommended statistic is Sum accumulates per
+// period, anything averaged or maxed is a gauge. Without a statistic note,
+// Count and Bytes are taken as counters.
+func cloudWatchType(unit string, statistics []string) string {
+	if len(statistics) > 0 {
+		if statistics[0] == "Sum" {
+			return string(domain.InstrumentCounter)
+		}
+		return string(domain.InstrumentGauge)
+	}
+	if unit == "Count" || unit == "Bytes" {
+		return string(domain.InstrumentCounter)
+	}
+	return string(domain.InstrumentGauge)
+}
+
+// splitList splits a cell listing names by commas or lines, dropping
+// placeholders such as "None" and "<none>".
+func splitList(s string) []string {
+	var names []string
+	for _, name := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '\n' }) {
+		name = strings.Trim(strings.TrimSpace(name), "`")
+		switch strings.ToLower(name) {
+		case "", "none", "<none>", "n/a", "-":
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return line
+}
@@ -0,0 +1,120 @@
+package clouddata
+
+import "testing"
+
+const cloudWatchPage = `<html><body>
+<h2>Instance metrics</h2>
+<div class="table-container"><table id="w1">
+  <tr><th>Metric</th><th>Description</th></tr>
+  <tr>
+    <td><p><code class="code">CPUUtilization</code></p></td>
+    <td><p>The percentage of physical CPU time that Amazon EC2 uses to run the EC2 instance.
+    Includes time spent to run both the user code and the Amazon EC2 code.</p>
+    <p><b>Units:</b> Percent</p></td>
+  </tr>
+  <tr>
+    <td><code class="code">NetworkIn</code></td>
+    <td><p>The number of bytes received by the instance on all network interfaces.</p>
+    <p>Units: Bytes</p>
+    <p>Meaningful statistics: Sum, Average, Minimum, Maximum</p></td>
+  </tr>
+  <tr>
+    <td><code class="code">CPUCreditBalance</code></td>
+    <td><p>The number of earned CPU credits that an instance has accrued.</p>
+    <p>Units: Credits (vCPU-minutes)</p>
+    <p>The most useful statistic is Average.</p></td>
+  </tr>
+</table></div>
+<table><tr><th>Dimension</th><th>Description</th></tr>
+  <tr><td>InstanceId</td><td>Filters by instance.</td></tr>
+</table>
+</body></html>`
+
+func TestParseCloudWatchHTML(t *testing.T) {
+	parsed, err := ParseCloudWatch([]byte(cloudWatchPage))
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := parsed.Metrics
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d: %+v", len(metrics), metrics)
+	}
+
+	cpu := metrics[0]
+	if cpu.Name != "CPUUtilization" || cpu.Unit != "Percent" || cpu.Type != "gauge" {
+		t.Errorf("unexpected metric %+v", cpu)
+	}
+	if cpu.Description != "The percentage of physical CPU time that Amazon EC2 uses to run the EC2 instance" {
+		t.Errorf("unexpected description %q", cpu.Description)
+	}
+	if m := metrics[1]; m.Unit != "Bytes" || m.Type != "counter" {
+		t.Errorf("expected Sum statistic to make a counter, got %+v", m)
+	}
+	if m := metrics[2]; m.Unit != "Credits" || m.Type != "gauge" {
+		t.Errorf("expected Average statistic to make a gauge, got %+v", m)
+	}
+}
+
+func TestParseCloudWatchDimensionsAndStatistics(t *testing.T) {
+	parsed, err := ParseCloudWatch([]byte(cloudWatchPage))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(parsed.Dimensions) != 1 || parsed.Dimensions[0].Name != "InstanceId" || parsed.Dimensions[0].Description != "Filters by instance" {
+		t.Errorf("unexpected dimensions %+v", parsed.Dimensions)
+	}
+	network := parsed.Metrics[1]
+	if want := []string{"Sum", "Average", "Minimum", "Maximum"}; !equalStrings(network.Statistics, want) || network.Statistic != "Sum" {
+		t.Errorf("expected statistics %v recommending Sum, got %v and %s", want, network.Statistics, network.Statistic)
+	}
+	if m := parsed.Metrics[0]; len(m.Statistics) != 0 || m.Statistic != "" {
+		t.Errorf("expected no statistics without a note, got %+v", m)
+	}
+
+	page := `| Metric | Description | Dimensions | Statistics |
+| --- | --- | --- | --- |
+| ` + "`Duration`" + ` | Time spent. | FunctionName, Resource | Average, Maximum |
+| ` + "`Throttles`" + ` | Throttled calls. | None | Sum |
+`
+	parsed, err = ParseCloudWatch([]byte(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m := parsed.Metrics[0]; !equalStrings(m.Dimensions, []string{"FunctionName", "Resource"}) || m.Statistic != "Average" || m.Type != "gauge" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := parsed.Metrics[1]; len(m.Dimensions) != 0 || m.Statistic != "Sum" || m.Type != "counter" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+}
+
+func TestParseCloudWatchMarkdown(t *testing.T) {
+	page := `# Amazon SQS metrics
+
+| Metric | Description | Units |
+| --- | --- | --- |
+| ` + "`ApproximateNumberOfMessagesVisible`" + ` | The number of messages available for retrieval from the queue. | Count |
+| ` + "`NumberOfMessagesSent`" + ` | The number of messages added to a queue.<br>Meaningful statistics: Sum | Count |
+`
+	parsed, err := ParseCloudWatch([]byte(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := parsed.Metrics
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+	if m := metrics[0]; m.Name != "ApproximateNumberOfMessagesVisible" || m.Unit != "Count" || m.Type != "counter" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := metrics[1]; m.Description != "The number of messages added to a queue" || m.Type != "counter" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+}
+
+func TestParseCloudWatchNoTables(t *testing.T) {
+	if _, err := ParseCloudWatch([]byte("<p>moved</p>")); err == nil {
+		t.Error("expected error for a page without metric tables")
+	}
+}
@@ -0,0 +1,181 @@
+package clouddata
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	htmlTablePattern = regexp.MustCompile(`(?is)<table\b.*?</table>`)
+	htmlRowPattern   = regexp.MustCompile(`(?is)<tr\b[^>]*>(.*?)</tr>`)
+	htmlCellPattern  = regexp.MustCompile(`(?is)<t([hd])\b[^>]*>(.*?)</t[hd]>`)
+	// Match tags that end a line of cell text
+	htmlBreakPattern  = regexp.MustCompile(`(?i)<br\s*/?>|</p>|</li>|</div>`)
+	htmlTagPattern    = regexp.MustCompile(`<[^>]+>`)
+	mdSeparatorCell   = regexp.MustCompile(`^:?-{3,}:?$`)
+	blankRunPattern   = regexp.MustCompile(`[ \t\x{a0}]+`)
+	markdownDecorator = strings.NewReplacer("**", "", "`", "", `\|`, "|")
+)
+
+// docTable is a table read from a saved documentation page. Header cells are
+// lower-cased; cell text keeps its line breaks so notes such as "Units:"
+// stay on their own line.
+type docTable struct {
+	header []string
+	rows   [][]string
+}
+
+// column returns the first column whose header contains one of names, or -1.
+func (t docTable) column(names ...string) int {
+	for _, name := range names {
+		for i, h := range t.header {
+			if strings.Contains(h, name) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// cell returns row[i], or "" when the row is short or i is -1.
+func cell(row []string, i int) string {
+	if i < 0 || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
+
+// readTables finds the tables in an HTML page or, failing that, the pipe
+// tables in a Markdown page.
+func readTables(content string) []docTable {
+	if tables := readHTMLTables(content); len(tables) > 0 {
+		return tables
+	}
+	return readMarkdownTables(content)
+}
+
+func readHTMLTables(content string) []docTable {
+	var tables []docTable
+	for _, table := range htmlTablePattern.FindAllString(content, -1) {
+		var t docTable
+		for _, row := range htmlRowPattern.FindAllStringSubmatch(table, -1) {
+			var cells []string
+			header := true
+			for _, c := range htmlCellPattern.FindAllStringSubmatch(row[1], -1) {
+				header = header && strings.EqualFold(c[1], "h")
+				cells = append(cells, htmlText(c[2]))
+			}
+			switch {
+			case len(cells) == 0:
+			case t.header == nil && header:
+				t.header = lowerAll(cells)
+			default:
+				t.rows = append(t.rows, cells)
+			}
+		}
+		if t.header == nil && len(t.rows) > 0 {
+			t.header, t.rows = lowerAll(t.rows[0]), t.rows[1:]
+		}
+		tables = append(tables, t)
+	}
+	return tables
+}
+
+func readMarkdownTables(content string) []docTable {
+	var tables []docTable
+	var block []string
+
+	flush := func() {
+		// A pipe table is a header line, a separator line and its rows
+		if len(block) >= 2 && isSeparatorRow(splitMarkdownRow(block[1])) {
+			t := docTable{header: lowerAll(splitMarkdownRow(block[0]))}
+			for _, line := range block[2:] {
+				t.rows = append(t.rows, splitMarkdownRow(line))
+			}
+			tables = append(tables, t)
+		}
+		block = nil
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "|") {
+			block = append(block, line)
+			continue
+		}
+		flush()
+	}
+	flush()
+
+	return tables
+}
+
+// splitMarkdownRow splits a pipe table row on unescaped pipes.
+func splitMarkdownRow(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+
+	var cells []string
+	start := 0
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '|':
+			cells = append(cells, markdownText(line[start:i]))
+			start = i + 1
+		}
+	}
+	return append(cells, markdownText(line[start:]))
+}
+
+func isSeparatorRow(cells []string) bool {
+	for _, c := range cells {
+		if !mdSeparatorCell.MatchString(c) {
+			return false
+		}
+	}
+	return len(cells) > 0
+}
+
+func htmlText(s string) string {
+	s = htmlBreakPattern.ReplaceAllString(s, "\n")
+	s = htmlTagPattern.ReplaceAllString(s, "")
+	return tidyLines(html.UnescapeString(s))
+}
+
+func markdownText(s string) string {
+	s = htmlBreakPattern.ReplaceAllString(s, "\n")
+	s = markdownDecorator.Replace(s)
+	return tidyLines(html.UnescapeString(s))
+}
+
+// tidyLines collapses runs of blanks and drops empty lines.
+func tidyLines(s string) string {
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimSpace(blankRunPattern.ReplaceAllString(line, " "))
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func lowerAll(cells []string) []string {
+	out := make([]string, len(cells))
+	for i, c := range cells {
+		out[i] = strings.ToLower(c)
+	}
+	return out
+}
+
+// firstSentence trims a description to its first sentence without the
+// trailing period, the style the tables use.
+func firstSentence(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, ". "); i != -1 {
+		s = s[:i]
+	}
+	return strings.TrimSuffix(s, ".")
+}
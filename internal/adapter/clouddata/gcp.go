@@ -0,0 +1,91 @@
+package clouddata
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// gcpDescriptor is the subset of a Cloud Monitoring MetricDescriptor the
+// tables keep.
+type gcpDescriptor struct {
+	Type        string `json:"type"`
+	MetricKind  string `json:"metricKind"`
+	ValueType   string `json:"valueType"`
+	Unit        string `json:"unit"`
+	Description string `json:"description"`
+	Labels      []struct {
+		Key         string `json:"key"`
+		Description string `json:"description"`
+	} `json:"labels"`
+	Metadata struct {
+		SamplePeriod string `json:"samplePeriod"`
+	} `json:"metadata"`
+}
+
+// ParseGCPDescriptors reads a saved metricDescriptors.list response, or a
+// bare array of descriptors, keeping those whose type starts with prefix,
+// e.g. "compute.googleapis.com/". Metric labels become the rows' dimensions;
+// monitored resource labels are not part of a descriptor.
+func ParseGCPDescriptors(content []byte, prefix string) (*Table, error) {
+	var descriptors []gcpDescriptor
+	if trimmed := strings.TrimSpace(string(content)); strings.HasPrefix(trimmed, "[") {
+		if err := json.Unmarshal(content, &descriptors); err != nil {
+			return nil, fmt.Errorf("parse descriptors: %w", err)
+		}
+	} else {
+		var list struct {
+			MetricDescriptors []gcpDescriptor `json:"metricDescriptors"`
+		}
+		if err := json.Unmarshal(content, &list); err != nil {
+			return nil, fmt.Errorf("parse descriptors: %w", err)
+		}
+		descriptors = list.MetricDescriptors
+	}
+
+	parsed := &Table{}
+	seen := make(map[string]bool)
+	described := make(map[string]bool)
+	for _, d := range descriptors {
+		if !strings.HasPrefix(d.Type, prefix) || seen[d.Type] {
+			continue
+		}
+		seen[d.Type] = true
+
+		m := Metric{
+			Name:        d.Type,
+			Description: firstSentence(d.Description),
+			Unit:        d.Unit,
+			Type:        gcpType(d.MetricKind, d.ValueType),
+			Period:      d.Metadata.SamplePeriod,
+		}
+		for _, l := range d.Labels {
+			m.Dimensions = append(m.Dimensions, l.Key)
+			if !described[l.Key] {
+				described[l.Key] = true
+				parsed.Dimensions = append(parsed.Dimensions, Dimension{Name: l.Key, Description: firstSentence(l.Description)})
+			}
+		}
+		parsed.Metrics = append(parsed.Metrics, m)
+	}
+
+	if len(parsed.Metrics) == 0 {
+		return nil, errors.New("no descriptors match " + prefix)
+	}
+	return parsed, nil
+}
+
+// gcpType maps metric kind and value type: distributions are histograms,
+// CUMULATIVE and DELTA series are counters and GAUGE series gauges.
+func gcpType(kind, valueType string) string {
+	switch {
+	case valueType == "DISTRIBUTION":
+		return string(domain.InstrumentHistogram)
+	case kind == "CUMULATIVE", kind == "DELTA":
+		return string(domain.InstrumentCounter)
+	}
+	return string(domain.InstrumentGauge)
+}
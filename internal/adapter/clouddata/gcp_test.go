@@ -0,0 +1,84 @@
+package clouddata
+
+import "testing"
+
+const gcpDescriptors = `{
+  "metricDescriptors": [
+    {
+      "type": "compute.googleapis.com/instance/cpu/utilization",
+      "metricKind": "GAUGE",
+      "valueType": "DOUBLE",
+      "unit": "10^2.%",
+      "description": "Fractional utilization of allocated CPU on this instance. Values are typically numbers between 0.0 and 1.0.",
+      "labels": [{"key": "instance_name", "description": "The name of the VM instance."}],
+      "metadata": {"samplePeriod": "60s"}
+    },
+    {
+      "type": "compute.googleapis.com/instance/disk/read_bytes_count",
+      "metricKind": "DELTA",
+      "valueType": "INT64",
+      "unit": "By",
+      "description": "Count of bytes read from disk."
+    },
+    {
+      "type": "compute.googleapis.com/instance/network/latency",
+      "metricKind": "DELTA",
+      "valueType": "DISTRIBUTION",
+      "unit": "ms",
+      "description": "Latency."
+    },
+    {
+      "type": "run.googleapis.com/request_count",
+      "metricKind": "DELTA",
+      "valueType": "INT64",
+      "unit": "1"
+    }
+  ]
+}`
+
+func TestParseGCPDescriptors(t *testing.T) {
+	parsed, err := ParseGCPDescriptors([]byte(gcpDescriptors), "compute.googleapis.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := parsed.Metrics
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d", len(metrics))
+	}
+
+	if m := metrics[0]; m.Type != "gauge" || m.Unit != "10^2.%" || m.Description != "Fractional utilization of allocated CPU on this instance" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := metrics[1]; m.Type != "counter" || m.Description != "Count of bytes read from disk" {
+		t.Errorf("unexpected metric %+v", m)
+	}
+	if m := metrics[2]; m.Type != "histogram" {
+		t.Errorf("expected distribution to be a histogram, got %+v", m)
+	}
+
+	if m := metrics[0]; !equalStrings(m.Dimensions, []string{"instance_name"}) || m.Period != "60s" {
+		t.Errorf("expected instance_name label and 60s period, got %+v", m)
+	}
+	if len(parsed.Dimensions) != 1 || parsed.Dimensions[0].Description != "The name of the VM instance" {
+		t.Errorf("unexpected label descriptions %+v", parsed.Dimensions)
+	}
+}
+
+func TestParseGCPDescriptorsArray(t *testing.T) {
+	content := `[{"type": "pubsub.googleapis.com/topic/send_request_count", "metricKind": "DELTA", "valueType": "INT64", "unit": "1"}]`
+
+	parsed, err := ParseGCPDescriptors([]byte(content), "pubsub.googleapis.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	metrics := parsed.Metrics
+	if len(metrics) != 1 || metrics[0].Type != "counter" {
+		t.Errorf("unexpected metrics %+v", metrics)
+	}
+}
+
+func TestParseGCPDescriptorsNoMatch(t *testing.T) {
+	if _, err := ParseGCPDescriptors([]byte(gcpDescriptors), "storage.googleapis.com/"); err == nil {
+		t.Error("expected error when no descriptor matches")
+	}
+}
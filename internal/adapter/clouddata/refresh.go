@@ -0,0 +1,130 @@
+package clouddata
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SourceDir is where the embedded tables live relative to the repository
+// root, the default destination of a refresh.
+const SourceDir = "internal/adapter/clouddata/data"
+
+// Refresh rebuilds the embedded table key from a locally saved copy of its
+// upstream listing: a CloudWatch documentation page, a Cloud Monitoring
+// metricDescriptors.list response or an Azure Monitor supported-metrics
+// page. The result carries version, which defaults to today, and drops any
+// source note: its rows are now the upstream copy's.
+func Refresh(key string, upstream []byte, version string) (*Table, error) {
+	current, err := Load(key)
+	if err != nil {
+		return nil, err
+	}
+	if version == "" {
+		version = time.Now().Format(VersionLayout)
+	}
+
+	provider, service, _ := strings.Cut(key, "/")
+	var parsed *Table
+	switch provider {
+	case "cloudwatch":
+		parsed, err = ParseCloudWatch(upstream)
+	case "gcp":
+		parsed, err = ParseGCPDescriptors(upstream, current.Namespace+"/")
+	case "azure":
+		parsed, err = ParseAzureMetrics(upstream, "azure."+service+".")
+	default:
+		return nil, fmt.Errorf("no upstream format for %s", key)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+
+	refreshed := current.merge(parsed)
+	refreshed.Version = version
+	refreshed.SourceNote = ""
+	if err := refreshed.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", key, err)
+	}
+	return refreshed, nil
+}
+
+// merge takes upstream's metric list, names, descriptions and units. Types
+// are inferred by the parsers, so a metric already in the table keeps its
+// reviewed type and namespace override, and any dimensions, statistics or
+// period the upstream copy doesn't state; metrics upstream no longer lists
+// are dropped. A kept metric's recommended statistic also survives while
+// upstream still lists it. Dimension descriptions are added to the table's.
+func (t *Table) merge(upstream *Table) *Table {
+	existing := make(map[string]Metric, len(t.Metrics))
+	for _, m := range t.Metrics {
+		existing[m.Name] = m
+	}
+
+	merged := *t
+	merged.Dimensions = mergeDimensions(t.Dimensions, upstream.Dimensions)
+	merged.Metrics = make([]Metric, 0, len(upstream.Metrics))
+	for _, m := range upstream.Metrics {
+		if old, ok := existing[m.Name]; ok {
+			m.Type = old.Type
+			m.Namespace = old.Namespace
+			if m.Description == "" {
+				m.Description = old.Description
+			}
+			if len(m.Dimensions) == 0 {
+				m.Dimensions = old.Dimensions
+			}
+			if len(m.Statistics) == 0 {
+				m.Statistics, m.Statistic = old.Statistics, old.Statistic
+			}
+			// The parsers recommend the first aggregation upstream lists;
+			// a reviewed choice stands while it is still valid
+			if old.Statistic != "" && contains(merged.statistics(m), old.Statistic) {
+				m.Statistic = old.Statistic
+			}
+			if m.Period == "" {
+				m.Period = old.Period
+			}
+		}
+		// A row repeating the table's statistics doesn't need its own
+		if equalStrings(m.Statistics, merged.Statistics) {
+			m.Statistics = nil
+		}
+		if m.Period == merged.Period {
+			m.Period = ""
+		}
+		merged.Metrics = append(merged.Metrics, m)
+	}
+	return &merged
+}
+
+func mergeDimensions(current, upstream []Dimension) []Dimension {
+	merged := append([]Dimension(nil), current...)
+	index := make(map[string]int, len(merged))
+	for i, d := range merged {
+		index[d.Name] = i
+	}
+	for _, d := range upstream {
+		i, ok := index[d.Name]
+		switch {
+		case !ok:
+			index[d.Name] = len(merged)
+			merged = append(merged, d)
+		case d.Description != "":
+			merged[i].Description = d.Description
+		}
+	}
+	return merged
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,99 @@
+package clouddata
+
+import "testing"
+
+func TestRefreshKeepsReviewedTypes(t *testing.T) {
+	current, err := Load("azure/vm")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refreshed, err := Refresh("azure/vm", []byte(azurePage), "2025-04-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if refreshed.Version != "2025-04-01" {
+		t.Errorf("expected version 2025-04-01, got %s", refreshed.Version)
+	}
+	if refreshed.SourceNote != "" || refreshed.Commit() != "2025-04-01" {
+		t.Errorf("expected a captured table, got note %q and commit %s", refreshed.SourceNote, refreshed.Commit())
+	}
+	if refreshed.Adapter != current.Adapter || refreshed.Namespace != current.Namespace || refreshed.Source != current.Source {
+		t.Errorf("refresh changed the table header: %+v", refreshed)
+	}
+	if len(refreshed.Metrics) != 3 {
+		t.Fatalf("expected upstream's 3 metrics, got %d", len(refreshed.Metrics))
+	}
+
+	reviewed := make(map[string]Metric)
+	for _, m := range current.Metrics {
+		reviewed[m.Name] = m
+	}
+	for _, m := range refreshed.Metrics {
+		if old, ok := reviewed[m.Name]; ok && old.Type != m.Type {
+			t.Errorf("%s: expected reviewed type %s, got %s", m.Name, old.Type, m.Type)
+		}
+	}
+}
+
+func TestRefreshDropsRepeatedTableDefaults(t *testing.T) {
+	refreshed, err := Refresh("azure/vm", []byte(azurePage), "2025-04-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byName := make(map[string]Metric)
+	for _, m := range refreshed.Metrics {
+		byName[m.Name] = m
+	}
+	if m := byName["azure.vm.percentage_cpu"]; m.Statistics != nil || m.Period != "" || m.Statistic != "Average" {
+		t.Errorf("expected the table's statistics and period to apply, got %+v", m)
+	}
+	if m := byName["azure.vm.disk_read_bytes"]; m.Statistic != "Total" || !contains(m.Statistics, "Total") {
+		t.Errorf("expected upstream aggregations to be kept, got %+v", m)
+	}
+}
+
+func TestMergeKeepsReviewedStatistic(t *testing.T) {
+	current := &Table{
+		Statistics: []string{"Average", "Minimum", "Maximum"},
+		Metrics: []Metric{
+			{Name: "requests", Type: "counter", Statistic: "Maximum"},
+			{Name: "latency", Type: "gauge", Statistic: "Minimum", Statistics: []string{"Average", "Minimum"}},
+		},
+	}
+	upstream := &Table{
+		Metrics: []Metric{
+			{Name: "requests", Type: "gauge", Statistic: "Total", Statistics: []string{"Total", "Average", "Maximum"}},
+			{Name: "latency", Type: "gauge", Statistic: "Average", Statistics: []string{"Average", "Maximum"}},
+		},
+	}
+
+	merged := current.merge(upstream)
+
+	if m := merged.Metrics[0]; m.Statistic != "Maximum" {
+		t.Errorf("expected the reviewed statistic to stay, got %+v", m)
+	}
+	if m := merged.Metrics[1]; m.Statistic != "Average" {
+		t.Errorf("expected upstream's statistic once the reviewed one is gone, got %+v", m)
+	}
+}
+
+func TestRefreshDefaultsVersionToToday(t *testing.T) {
+	refreshed, err := Refresh("gcp/compute", []byte(gcpDescriptors), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := refreshed.Validate(); err != nil {
+		t.Errorf("refreshed table is invalid: %v", err)
+	}
+}
+
+func TestRefreshRejectsWrongFormat(t *testing.T) {
+	if _, err := Refresh("cloudwatch/ec2", []byte(gcpDescriptors), ""); err == nil {
+		t.Error("expected error for descriptors given to a CloudWatch table")
+	}
+	if _, err := Refresh("gcp/nope", []byte(gcpDescriptors), ""); err == nil {
+		t.Error("expected error for an unknown table")
+	}
+}
@@ -0,0 +1,272 @@
+package clouddata
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+//go:embed data
+var dataFS embed.FS
+
+// VersionLayout is the date format of a table's version.
+const VersionLayout = "2006-01-02"
+
+// Table is one cloud service's metric list as published by its provider,
+// e.g. data/cloudwatch/ec2.yaml. Version is the date the upstream page or
+// descriptor list was captured and stands in for a commit.
+type Table struct {
+	Adapter string `yaml:"adapter"`
+	Version string `yaml:"version"`
+	Source  string `yaml:"source"`
+	// SourceNote explains rows that were not captured from Source, such as
+	// a table transcribed from older code; Version is then the date of
+	// that code and the table is reported as unverified until a refresh
+	// replaces it
+	SourceNote string `yaml:"source_note,omitempty"`
+	Component  string `yaml:"component"`
+	Namespace  string `yaml:"namespace"`
+	// Period is the interval metrics are published at unless a row says
+	// otherwise, as a duration such as 60s
+	Period string `yaml:"period,omitempty"`
+	// Statistics are the provider's aggregations valid for every row that
+	// lists none: CloudWatch statistics or Azure aggregation types
+	Statistics []string `yaml:"statistics,omitempty"`
+	// Dimensions describes the dimension (CloudWatch, Azure) or metric
+	// label (GCP) names rows refer to
+	Dimensions []Dimension `yaml:"dimensions,omitempty"`
+	// Resources are the GCP monitored resources whose labels identify a
+	// time series, matched to rows by name prefix
+	Resources []Resource `yaml:"resources,omitempty"`
+	Metrics   []Metric   `yaml:"metrics"`
+}
+
+// Dimension is a named dimension or label.
+type Dimension struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// Resource is a monitored resource type and its labels. It applies to the
+// metrics whose name starts with Prefix; an empty prefix matches all.
+type Resource struct {
+	Type   string      `yaml:"type"`
+	Prefix string      `yaml:"prefix,omitempty"`
+	Labels []Dimension `yaml:"labels"`
+}
+
+// Metric is one row of a table.
+type Metric struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	Unit        string `yaml:"unit,omitempty"`
+	Type        string `yaml:"type"`
+	// Namespace overrides the table's namespace, e.g. AWS/Usage quotas
+	// listed alongside a service's own metrics
+	Namespace  string   `yaml:"namespace,omitempty"`
+	Dimensions []string `yaml:"dimensions,omitempty"`
+	// Statistic is the recommended aggregation, e.g. Sum for a count of
+	// requests; Statistics overrides the table's list
+	Statistic  string   `yaml:"statistic,omitempty"`
+	Statistics []string `yaml:"statistics,omitempty"`
+	Period     string   `yaml:"period,omitempty"`
+}
+
+// Keys lists the embedded tables as provider/service, e.g. "gcp/compute".
+func Keys() []string {
+	var keys []string
+	_ = fs.WalkDir(dataFS, "data", func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() && strings.HasSuffix(path, ".yaml") {
+			keys = append(keys, strings.TrimSuffix(strings.TrimPrefix(path, "data/"), ".yaml"))
+		}
+		return nil
+	})
+	sort.Strings(keys)
+	return keys
+}
+
+// Load reads an embedded table by key.
+func Load(key string) (*Table, error) {
+	content, err := dataFS.ReadFile("data/" + key + ".yaml")
+	if err != nil {
+		return nil, fmt.Errorf("unknown cloud metric table %q", key)
+	}
+	t, err := Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("table %s: %w", key, err)
+	}
+	return t, nil
+}
+
+// Parse decodes and validates a table.
+func Parse(content []byte) (*Table, error) {
+	var t Table
+	if err := yaml.Unmarshal(content, &t); err != nil {
+		return nil, err
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Validate checks the header and that every metric has a name and a known
+// instrument type.
+func (t *Table) Validate() error {
+	if t.Adapter == "" {
+		return errors.New("adapter is required")
+	}
+	if _, err := time.Parse(VersionLayout, t.Version); err != nil {
+		return fmt.Errorf("version %q is not a YYYY-MM-DD date", t.Version)
+	}
+	if t.Component == "" || t.Namespace == "" {
+		return errors.New("component and namespace are required")
+	}
+	if err := validPeriod(t.Period); err != nil {
+		return err
+	}
+	for _, r := range t.Resources {
+		if r.Type == "" {
+			return errors.New("resource type is required")
+		}
+	}
+
+	seen := make(map[string]bool, len(t.Metrics))
+	for i, m := range t.Metrics {
+		if m.Name == "" {
+			return fmt.Errorf("metric %d has no name", i)
+		}
+		if seen[m.Name] {
+			return fmt.Errorf("duplicate metric %s", m.Name)
+		}
+		seen[m.Name] = true
+		if !domain.InstrumentType(m.Type).IsValid() {
+			return fmt.Errorf("metric %s: invalid type %q", m.Name, m.Type)
+		}
+		if err := validPeriod(m.Period); err != nil {
+			return fmt.Errorf("metric %s: %w", m.Name, err)
+		}
+		if m.Statistic != "" && !contains(t.statistics(m), m.Statistic) {
+			return fmt.Errorf("metric %s: statistic %s is not one of %v", m.Name, m.Statistic, t.statistics(m))
+		}
+	}
+	return nil
+}
+
+func validPeriod(period string) error {
+	if period == "" {
+		return nil
+	}
+	if d, err := time.ParseDuration(period); err != nil || d <= 0 {
+		return fmt.Errorf("period %q is not a positive duration", period)
+	}
+	return nil
+}
+
+// statistics returns the row's statistics, falling back to the table's.
+func (t *Table) statistics(m Metric) []string {
+	if len(m.Statistics) > 0 {
+		return m.Statistics
+	}
+	return t.Statistics
+}
+
+// resource returns the first resource whose prefix matches the row.
+func (t *Table) resource(m Metric) *Resource {
+	for i, r := range t.Resources {
+		if strings.HasPrefix(m.Name, r.Prefix) {
+			return &t.Resources[i]
+		}
+	}
+	return nil
+}
+
+// attributes turns the row's dimensions and its resource's labels into
+// catalog attributes, describing each from the table.
+func (t *Table) attributes(m Metric) []domain.Attribute {
+	descriptions := make(map[string]string, len(t.Dimensions))
+	for _, d := range t.Dimensions {
+		descriptions[d.Name] = d.Description
+	}
+
+	var attrs []domain.Attribute
+	for _, name := range m.Dimensions {
+		attrs = append(attrs, domain.Attribute{Name: name, Type: "string", Description: descriptions[name]})
+	}
+	if r := t.resource(m); r != nil {
+		for _, l := range r.Labels {
+			attrs = append(attrs, domain.Attribute{Name: l.Name, Type: "string", Description: l.Description, Resource: true})
+		}
+	}
+	return attrs
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Marshal renders a table in the layout of the embedded files.
+func (t *Table) Marshal() ([]byte, error) {
+	var b strings.Builder
+	enc := yaml.NewEncoder(&b)
+	enc.SetIndent(2)
+	if err := enc.Encode(t); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return []byte(b.String()), nil
+}
+
+// Commit is the snapshot extraction records for the table: its version,
+// marked unverified while a source note says the rows were not captured.
+func (t *Table) Commit() string {
+	if t.SourceNote != "" {
+		return t.Version + "-unverified"
+	}
+	return t.Version
+}
+
+// RawMetrics converts the table rows to catalog metrics.
+func (t *Table) RawMetrics() []*adapter.RawMetric {
+	metrics := make([]*adapter.RawMetric, 0, len(t.Metrics))
+	for _, m := range t.Metrics {
+		namespace := m.Namespace
+		if namespace == "" {
+			namespace = t.Namespace
+		}
+		period := m.Period
+		if period == "" {
+			period = t.Period
+		}
+		metrics = append(metrics, &adapter.RawMetric{
+			Name:                 m.Name,
+			Description:          m.Description,
+			Unit:                 m.Unit,
+			InstrumentType:       m.Type,
+			Attributes:           t.attributes(m),
+			ComponentName:        t.Component,
+			ComponentType:        string(domain.ComponentPlatform),
+			SourceLocation:       namespace,
+			Statistics:           t.statistics(m),
+			RecommendedStatistic: m.Statistic,
+			Period:               period,
+		})
+	}
+	return metrics
+}
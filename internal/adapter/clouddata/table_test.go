@@ -0,0 +1,175 @@
+package clouddata
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmbeddedTablesLoad(t *testing.T) {
+	keys := Keys()
+	if len(keys) != 41 {
+		t.Errorf("expected 41 tables, got %d", len(keys))
+	}
+
+	adapters := make(map[string]string)
+	for _, key := range keys {
+		table, err := Load(key)
+		if err != nil {
+			t.Errorf("%s: %v", key, err)
+			continue
+		}
+		if len(table.Metrics) == 0 {
+			t.Errorf("%s: no metrics", key)
+		}
+		if other, ok := adapters[table.Adapter]; ok {
+			t.Errorf("%s and %s both declare adapter %s", key, other, table.Adapter)
+		}
+		adapters[table.Adapter] = key
+	}
+}
+
+func TestLoadUnknownTable(t *testing.T) {
+	if _, err := Load("cloudwatch/nope"); err == nil {
+		t.Error("expected error for unknown table")
+	}
+}
+
+func TestParseValidation(t *testing.T) {
+	header := "adapter: cloudwatch-test\nversion: \"2025-03-01\"\ncomponent: Test\nnamespace: AWS/Test\n"
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"missing adapter", "version: \"2025-03-01\"\ncomponent: Test\nnamespace: AWS/Test\n"},
+		{"bad version", strings.Replace(header, "2025-03-01", "March", 1)},
+		{"missing namespace", strings.Replace(header, "namespace: AWS/Test\n", "", 1)},
+		{"unnamed metric", header + "metrics:\n  - type: gauge\n"},
+		{"bad type", header + "metrics:\n  - name: A\n    type: meter\n"},
+		{"duplicate", header + "metrics:\n  - name: A\n    type: gauge\n  - name: A\n    type: gauge\n"},
+		{"bad period", header + "period: 1m0\nmetrics: []\n"},
+		{"bad metric period", header + "metrics:\n  - name: A\n    type: gauge\n    period: -60s\n"},
+		{"untyped resource", header + "resources:\n  - prefix: a/\n    labels: []\nmetrics: []\n"},
+		{"statistic not listed", header + "statistics: [Average]\nmetrics:\n  - name: A\n    type: gauge\n    statistic: Sum\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse([]byte(tt.content)); err == nil {
+				t.Error("expected validation error")
+			}
+		})
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	table, err := Load("cloudwatch/rds")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content, err := table.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := Parse(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(again.Metrics) != len(table.Metrics) || again.Version != table.Version || again.SourceNote != table.SourceNote {
+		t.Errorf("round trip changed the table: %d metrics at %s", len(again.Metrics), again.Version)
+	}
+}
+
+func TestRawMetricsNamespaceOverride(t *testing.T) {
+	table := &Table{
+		Adapter:   "cloudwatch-test",
+		Version:   "2025-03-01",
+		Component: "Test",
+		Namespace: "AWS/Test",
+		Metrics: []Metric{
+			{Name: "Requests", Unit: "Count", Type: "counter"},
+			{Name: "AccountMaxReads", Unit: "Count", Type: "gauge", Namespace: "AWS/Usage"},
+		},
+	}
+
+	metrics := table.RawMetrics()
+	if metrics[0].SourceLocation != "AWS/Test" || metrics[1].SourceLocation != "AWS/Usage" {
+		t.Errorf("unexpected source locations %s, %s", metrics[0].SourceLocation, metrics[1].SourceLocation)
+	}
+	if metrics[0].ComponentName != "Test" || metrics[0].ComponentType != "platform" {
+		t.Errorf("unexpected component %s/%s", metrics[0].ComponentType, metrics[0].ComponentName)
+	}
+}
+
+func TestRawMetricsStatisticsAndDimensions(t *testing.T) {
+	table := &Table{
+		Adapter:    "cloudwatch-test",
+		Version:    "2025-03-01",
+		Component:  "Test",
+		Namespace:  "AWS/Test",
+		Period:     "60s",
+		Statistics: []string{"Average", "Sum"},
+		Dimensions: []Dimension{{Name: "QueueName", Description: "The queue"}},
+		Metrics: []Metric{
+			{Name: "Sent", Type: "counter", Dimensions: []string{"QueueName"}, Statistic: "Sum"},
+			{Name: "Size", Type: "gauge", Statistics: []string{"Average"}, Statistic: "Average", Period: "86400s"},
+		},
+	}
+	if err := table.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	metrics := table.RawMetrics()
+	sent, size := metrics[0], metrics[1]
+	if !equalStrings(sent.Statistics, []string{"Average", "Sum"}) || sent.RecommendedStatistic != "Sum" || sent.Period != "60s" {
+		t.Errorf("expected the table's statistics and period, got %v %s %s", sent.Statistics, sent.RecommendedStatistic, sent.Period)
+	}
+	if len(sent.Attributes) != 1 || sent.Attributes[0].Name != "QueueName" || sent.Attributes[0].Description != "The queue" || sent.Attributes[0].Resource {
+		t.Errorf("unexpected attributes %+v", sent.Attributes)
+	}
+	if !equalStrings(size.Statistics, []string{"Average"}) || size.Period != "86400s" || len(size.Attributes) != 0 {
+		t.Errorf("expected the row's overrides, got %+v", size)
+	}
+}
+
+func TestRawMetricsResourceLabels(t *testing.T) {
+	table, err := Load("gcp/gke")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	labels := make(map[string][]string)
+	for _, m := range table.RawMetrics() {
+		for _, a := range m.Attributes {
+			if a.Resource {
+				labels[m.Name] = append(labels[m.Name], a.Name)
+			}
+		}
+	}
+	if got := labels["kubernetes.io/container/cpu/core_usage_time"]; !contains(got, "container_name") {
+		t.Errorf("expected k8s_container labels on container metrics, got %v", got)
+	}
+	if got := labels["kubernetes.io/node/cpu/core_usage_time"]; !contains(got, "node_name") || contains(got, "container_name") {
+		t.Errorf("expected k8s_node labels on node metrics, got %v", got)
+	}
+}
+
+func TestEmbeddedTablesDescribeDimensions(t *testing.T) {
+	for _, key := range Keys() {
+		table, err := Load(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		described := make(map[string]bool)
+		for _, d := range table.Dimensions {
+			described[d.Name] = true
+		}
+		for _, m := range table.Metrics {
+			for _, name := range m.Dimensions {
+				if !described[name] {
+					t.Errorf("%s: %s uses undescribed dimension %s", key, m.Name, name)
+				}
+			}
+		}
+	}
+}
@@ -4,12 +4,25 @@ import (
 	"context"
 	"time"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type Adapter struct{}
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "codingagent-claude-code",
+		Category:    domain.SourceCodingAgent,
+		Description: "Claude Code monitoring guide metrics",
+		Schedule:    adapter.ScheduleWeekly,
+		Tags:        []string{"codingagent"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(_ string) *Adapter {
 	return &Adapter{}
 }
@@ -11,9 +11,9 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const repoURL = "https://github.com/openai/codex"
@@ -24,6 +24,19 @@ type Adapter struct {
 	fetcher *fetcher.GitFetcher
 }
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "codingagent-codex",
+		Category:    domain.SourceCodingAgent,
+		Description: "OpenAI Codex CLI OpenTelemetry metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"codingagent", "rust"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
 		fetcher: fetcher.NewGitFetcher(cacheDir),
@@ -8,9 +8,9 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const repoURL = "https://github.com/google-gemini/gemini-cli"
@@ -30,6 +30,19 @@ type Adapter struct {
 	fetcher *fetcher.GitFetcher
 }
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "codingagent-gemini",
+		Category:    domain.SourceCodingAgent,
+		Description: "Gemini CLI OpenTelemetry metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"codingagent", "typescript"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
 		fetcher: fetcher.NewGitFetcher(cacheDir),
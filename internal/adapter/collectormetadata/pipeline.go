@@ -0,0 +1,145 @@
+// Package collectormetadata turns the metadata.yaml files of an OpenTelemetry
+// Collector repository into raw metrics and component records. It is shared by
+// the contrib and core collector adapters.
+package collectormetadata
+
+import (
+	"github.com/base-14/metric-library/internal/discovery"
+	"github.com/base-14/metric-library/internal/extractor"
+	"github.com/base-14/metric-library/internal/parser"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+type Pipeline struct {
+	sourceName string
+	discovery  *discovery.MetadataDiscovery
+	parser     *parser.MetadataParser
+}
+
+func NewPipeline(sourceName string) *Pipeline {
+	return &Pipeline{
+		sourceName: sourceName,
+		discovery:  discovery.NewMetadataDiscovery(),
+		parser:     parser.NewMetadataParser(),
+	}
+}
+
+func (p *Pipeline) Metrics(repoPath string) ([]*adapter.RawMetric, error) {
+	files, err := p.discovery.FindMetadataFiles(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []*adapter.RawMetric
+
+	for _, file := range files {
+		meta, err := p.parser.ParseFile(file.Path)
+		if err != nil {
+			continue
+		}
+
+		ext := extractor.NewMetricExtractor(p.sourceName, file.ComponentName, file.ComponentType)
+		extracted, err := ext.Extract(meta)
+		if err != nil {
+			continue
+		}
+
+		for _, m := range extracted {
+			rawMetric := &adapter.RawMetric{
+				Name:             m.MetricName,
+				Description:      m.Description,
+				Unit:             m.Unit,
+				InstrumentType:   string(m.InstrumentType),
+				Attributes:       m.Attributes,
+				EnabledByDefault: m.EnabledByDefault,
+				ComponentName:    file.ComponentName,
+				ComponentType:    string(m.ComponentType),
+				SourceLocation:   file.Path,
+				Path:             file.Path,
+				Signal:           string(m.Signal),
+
+				ValueType:              string(m.ValueType),
+				Monotonic:              m.Monotonic,
+				AggregationTemporality: string(m.AggregationTemporality),
+				BucketBoundaries:       m.BucketBoundaries,
+			}
+
+			metrics = append(metrics, rawMetric)
+		}
+	}
+
+	return metrics, nil
+}
+
+// Components returns one record per component. Subcomponent metadata
+// (scrapers and the like, see discovery) only contributes its metric warnings
+// to the parent, unless the parent has no metadata of its own. Components in
+// grouping directories such as extension/observer are records of their own.
+func (p *Pipeline) Components(repoPath string) ([]*domain.Component, error) {
+	files, err := p.discovery.FindMetadataFiles(repoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]*domain.Component)
+	var keys []string
+	var nested []discovery.MetadataFile
+
+	for _, file := range files {
+		if file.Subcomponent != "" {
+			nested = append(nested, file)
+			continue
+		}
+
+		component := p.component(file)
+		if component == nil {
+			continue
+		}
+		key := file.ComponentType + "/" + file.ComponentName
+		if _, ok := byKey[key]; !ok {
+			keys = append(keys, key)
+		}
+		byKey[key] = component
+	}
+
+	for _, file := range nested {
+		component := p.component(file)
+		if component == nil {
+			continue
+		}
+
+		key := file.ComponentType + "/" + file.ComponentName
+		parent, ok := byKey[key]
+		if !ok {
+			keys = append(keys, key)
+			byKey[key] = component
+			continue
+		}
+		for _, w := range component.Warnings {
+			if w.Metric != "" {
+				parent.Warnings = append(parent.Warnings, w)
+			}
+		}
+	}
+
+	components := make([]*domain.Component, 0, len(keys))
+	for _, key := range keys {
+		components = append(components, byKey[key])
+	}
+
+	return components, nil
+}
+
+func (p *Pipeline) component(file discovery.MetadataFile) *domain.Component {
+	meta, err := p.parser.ParseFile(file.Path)
+	if err != nil {
+		return nil
+	}
+
+	ext := extractor.NewMetricExtractor(p.sourceName, file.ComponentName, file.ComponentType)
+	component := ext.ExtractComponent(meta)
+	component.Path = file.Path
+
+	return component
+}
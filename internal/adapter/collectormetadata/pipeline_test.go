@@ -0,0 +1,157 @@
+package collectormetadata
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeRepo(t *testing.T, structure map[string]string) string {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	for path, content := range structure {
+		fullPath := filepath.Join(tmpDir, path)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+	return tmpDir
+}
+
+var hostmetricsRepo = map[string]string{
+	"receiver/hostmetricsreceiver/metadata.yaml": `
+type: hostmetrics
+
+status:
+  class: receiver
+  stability:
+    beta: [metrics]
+  codeowners:
+    active: [dmitryax]
+`,
+	"receiver/hostmetricsreceiver/internal/scraper/cpuscraper/metadata.yaml": `
+type: cpu
+parent: hostmetrics
+
+status:
+  class: scraper
+  stability:
+    alpha: [metrics]
+
+metrics:
+  system.cpu.time:
+    enabled: true
+    unit: s
+    sum:
+      value_type: double
+      monotonic: true
+      aggregation_temporality: cumulative
+  system.cpu.frequency:
+    enabled: false
+    unit: Hz
+    gauge:
+      value_type: double
+    warnings:
+      if_enabled_not_set: This metric will be enabled by default soon.
+`,
+}
+
+func TestPipeline_Metrics_Nested(t *testing.T) {
+	repo := writeRepo(t, hostmetricsRepo)
+
+	metrics, err := NewPipeline("test-source").Metrics(repo)
+	if err != nil {
+		t.Fatalf("Metrics failed: %v", err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+
+	for _, m := range metrics {
+		if m.ComponentName != "hostmetricsreceiver" || m.ComponentType != "receiver" {
+			t.Errorf("expected %s to be attributed to receiver/hostmetricsreceiver, got %s/%s", m.Name, m.ComponentType, m.ComponentName)
+		}
+		if filepath.Base(filepath.Dir(m.SourceLocation)) != "cpuscraper" {
+			t.Errorf("expected source location inside cpuscraper, got %q", m.SourceLocation)
+		}
+	}
+}
+
+func TestPipeline_Components_MergesNestedWarnings(t *testing.T) {
+	repo := writeRepo(t, hostmetricsRepo)
+
+	components, err := NewPipeline("test-source").Components(repo)
+	if err != nil {
+		t.Fatalf("Components failed: %v", err)
+	}
+
+	if len(components) != 1 {
+		t.Fatalf("expected 1 component, got %d", len(components))
+	}
+
+	c := components[0]
+	if c.Stability["metrics"] != "beta" {
+		t.Errorf("expected the parent's stability to win, got %v", c.Stability)
+	}
+	if filepath.Base(filepath.Dir(c.Path)) != "hostmetricsreceiver" {
+		t.Errorf("expected the parent's path, got %q", c.Path)
+	}
+	if len(c.Warnings) != 1 || c.Warnings[0].Metric != "system.cpu.frequency" {
+		t.Errorf("expected the scraper's metric warning on the parent, got %v", c.Warnings)
+	}
+}
+
+func TestPipeline_Components_GroupingDirectory(t *testing.T) {
+	repo := writeRepo(t, map[string]string{
+		"extension/observer/dockerobserver/metadata.yaml": `
+type: docker_observer
+status:
+  class: extension
+  stability:
+    beta: [extension]
+`,
+		"extension/observer/k8sobserver/metadata.yaml": `
+type: k8s_observer
+status:
+  class: extension
+  stability:
+    alpha: [extension]
+`,
+	})
+
+	components, err := NewPipeline("test-source").Components(repo)
+	if err != nil {
+		t.Fatalf("Components failed: %v", err)
+	}
+
+	names := make(map[string]string)
+	for _, c := range components {
+		names[c.Name] = string(c.Stability["extension"])
+	}
+	if len(names) != 2 || names["dockerobserver"] != "beta" || names["k8sobserver"] != "alpha" {
+		t.Errorf("expected two independent observers, got %v", names)
+	}
+}
+
+func TestPipeline_Components_NestedOnly(t *testing.T) {
+	repo := writeRepo(t, map[string]string{
+		"receiver/hostmetricsreceiver/internal/scraper/cpuscraper/metadata.yaml": hostmetricsRepo["receiver/hostmetricsreceiver/internal/scraper/cpuscraper/metadata.yaml"],
+	})
+
+	components, err := NewPipeline("test-source").Components(repo)
+	if err != nil {
+		t.Fatalf("Components failed: %v", err)
+	}
+
+	if len(components) != 1 || components[0].Name != "hostmetricsreceiver" {
+		t.Fatalf("expected the nested metadata to stand in for its parent, got %v", components)
+	}
+	if components[0].Stability["metrics"] != "alpha" {
+		t.Errorf("unexpected stability: %v", components[0].Stability)
+	}
+}
@@ -0,0 +1,178 @@
+package datadog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/DataDog/integrations-core"
+
+// Datadog unit names that have a UCUM equivalent
+var units = map[string]string{
+	"bit":         "bit",
+	"byte":        "By",
+	"kibibyte":    "KiBy",
+	"mebibyte":    "MiBy",
+	"gibibyte":    "GiBy",
+	"nanosecond":  "ns",
+	"microsecond": "us",
+	"millisecond": "ms",
+	"second":      "s",
+	"minute":      "min",
+	"hour":        "h",
+	"day":         "d",
+	"percent":     "%",
+	"fraction":    "1",
+	"hertz":       "Hz",
+}
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "vendor-datadog",
+		Category:    domain.SourceVendor,
+		Description: "Datadog integrations-core metadata.csv files",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"vendor", "datadog"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "vendor-datadog"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceVendor
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceVendorClaimed
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMetadata
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	entries, err := os.ReadDir(result.RepoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []*adapter.RawMetric
+
+	// Each integration is a top-level directory with its own metadata.csv
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		filePath := filepath.Join(result.RepoPath, entry.Name(), "metadata.csv")
+		rows, err := ParseFile(filePath)
+		if err != nil {
+			continue
+		}
+
+		relPath, _ := filepath.Rel(result.RepoPath, filePath)
+
+		for _, row := range rows {
+			rawMetric := &adapter.RawMetric{
+				Name:             row.Name,
+				Description:      row.Description,
+				Unit:             unit(row.Unit, row.PerUnit),
+				InstrumentType:   instrumentType(row.Type),
+				EnabledByDefault: true,
+				ComponentType:    string(domain.ComponentPlatform),
+				ComponentName:    entry.Name(),
+				SourceLocation:   row.Integration,
+				Path:             relPath,
+				Orientation:      row.Orientation,
+			}
+
+			// Datadog counts are reported per flush interval
+			if row.Type == "count" {
+				rawMetric.AggregationTemporality = string(domain.TemporalityDelta)
+			}
+
+			metrics = append(metrics, rawMetric)
+		}
+	}
+
+	return metrics, nil
+}
+
+func instrumentType(metricType string) string {
+	switch metricType {
+	case "count":
+		return string(domain.InstrumentCounter)
+	case "distribution", "histogram":
+		return string(domain.InstrumentHistogram)
+	default:
+		// gauge, and rate which is already a per-second value
+		return string(domain.InstrumentGauge)
+	}
+}
+
+// unit renders unit_name/per_unit_name, e.g. byte + second as By/s.
+func unit(name, perUnit string) string {
+	u := ucum(name)
+	if perUnit == "" {
+		return u
+	}
+	if u == "" {
+		u = "1"
+	}
+	return u + "/" + ucum(perUnit)
+}
+
+func ucum(name string) string {
+	if name == "" {
+		return ""
+	}
+	if u, ok := units[name]; ok {
+		return u
+	}
+	return "{" + name + "}"
+}
@@ -0,0 +1,149 @@
+package datadog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const redisMetadata = `metric_name,metric_type,interval,unit_name,per_unit_name,description,orientation,integration,short_name
+redis.net.commands,rate,,command,second,Number of commands processed by the server.,0,redis,commands
+redis.net.rejected,count,15,connection,,Number of rejected connections.,-1,redis,rejected
+redis.mem.used,gauge,,byte,,Amount of memory allocated by Redis.,0,redis,used memory
+`
+
+func writeRepoFile(t *testing.T, repo, path, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(repo, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func extract(t *testing.T, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestDatadogAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "vendor-datadog" {
+		t.Errorf("expected name 'vendor-datadog', got %q", a.Name())
+	}
+}
+
+func TestDatadogAdapter_Confidence(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Confidence() != domain.ConfidenceVendorClaimed {
+		t.Errorf("expected confidence 'vendor_claimed', got %q", a.Confidence())
+	}
+}
+
+func TestDatadogAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestDatadogAdapter_Extract_ComponentPerIntegration(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join("redisdb", "metadata.csv"), redisMetadata)
+
+	metrics := extract(t, repo)
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d", len(metrics))
+	}
+
+	for name, m := range metrics {
+		if m.ComponentName != "redisdb" || m.ComponentType != string(domain.ComponentPlatform) {
+			t.Errorf("unexpected component for %s: %s/%s", name, m.ComponentType, m.ComponentName)
+		}
+		if m.Path != filepath.Join("redisdb", "metadata.csv") {
+			t.Errorf("expected a repo-relative path for %s, got %q", name, m.Path)
+		}
+	}
+}
+
+func TestDatadogAdapter_Extract_RateIsGauge(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join("redisdb", "metadata.csv"), redisMetadata)
+
+	commands := extract(t, repo)["redis.net.commands"]
+	if commands == nil {
+		t.Fatal("expected redis.net.commands")
+	}
+	if commands.InstrumentType != "gauge" || commands.Unit != "{command}/s" {
+		t.Errorf("expected a per-second gauge, got %q %q", commands.InstrumentType, commands.Unit)
+	}
+}
+
+func TestDatadogAdapter_Extract_CountIsDeltaCounter(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join("redisdb", "metadata.csv"), redisMetadata)
+
+	rejected := extract(t, repo)["redis.net.rejected"]
+	if rejected == nil {
+		t.Fatal("expected redis.net.rejected")
+	}
+	if rejected.InstrumentType != "counter" || rejected.AggregationTemporality != "delta" {
+		t.Errorf("expected a delta counter, got %q %q", rejected.InstrumentType, rejected.AggregationTemporality)
+	}
+}
+
+func TestDatadogAdapter_Extract_Orientation(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join("redisdb", "metadata.csv"), redisMetadata)
+
+	metrics := extract(t, repo)
+	if rejected := metrics["redis.net.rejected"]; rejected == nil || rejected.Orientation != -1 {
+		t.Errorf("expected lower-is-better redis.net.rejected, got %+v", rejected)
+	}
+	if commands := metrics["redis.net.commands"]; commands == nil || commands.Orientation != 0 {
+		t.Errorf("expected no orientation for redis.net.commands, got %+v", commands)
+	}
+}
+
+func TestDatadogAdapter_Extract_SkipsDirectoriesWithoutMetadata(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join("redisdb", "metadata.csv"), redisMetadata)
+	writeRepoFile(t, repo, filepath.Join("datadog_checks_base", "setup.py"), "")
+
+	if metrics := extract(t, repo); len(metrics) != 3 {
+		t.Errorf("expected only the redisdb metrics, got %d", len(metrics))
+	}
+}
+
+func TestInstrumentType(t *testing.T) {
+	tests := []struct {
+		metricType string
+		expected   string
+	}{
+		{"count", "counter"},
+		{"distribution", "histogram"},
+		{"histogram", "histogram"},
+		{"rate", "gauge"},
+		{"gauge", "gauge"},
+	}
+
+	for _, tt := range tests {
+		if got := instrumentType(tt.metricType); got != tt.expected {
+			t.Errorf("instrumentType(%q) = %q, expected %q", tt.metricType, got, tt.expected)
+		}
+	}
+}
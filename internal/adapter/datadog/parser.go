@@ -0,0 +1,104 @@
+package datadog
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// MetricRow is one row of an integration's metadata.csv.
+type MetricRow struct {
+	Name        string
+	Type        string // gauge, count, rate, distribution
+	Interval    string
+	Unit        string
+	PerUnit     string
+	Description string
+	// Orientation is 1 when higher is better, -1 when lower is, 0 otherwise
+	Orientation int
+	Integration string
+}
+
+func ParseFile(path string) ([]MetricRow, error) {
+	f, err := os.Open(path) //nolint:gosec // Reading metadata from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	return Parse(f)
+}
+
+// Parse reads a metadata.csv by header name, since column order has changed
+// over time and newer files carry extra columns.
+func Parse(r io.Reader) ([]MetricRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+	if _, ok := columns["metric_name"]; !ok {
+		return nil, fmt.Errorf("missing metric_name column")
+	}
+
+	field := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var rows []MetricRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read row: %w", err)
+		}
+
+		row := MetricRow{
+			Name:        field(record, "metric_name"),
+			Type:        field(record, "metric_type"),
+			Interval:    field(record, "interval"),
+			Unit:        field(record, "unit_name"),
+			PerUnit:     field(record, "per_unit_name"),
+			Description: field(record, "description"),
+			Orientation: orientation(field(record, "orientation")),
+			Integration: field(record, "integration"),
+		}
+		if row.Name == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// orientation reads the orientation column, treating anything but 1 or -1
+// as no preference.
+func orientation(value string) int {
+	switch n, _ := strconv.Atoi(value); n {
+	case 1, -1:
+		return n
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,52 @@
+package datadog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	csv := "\ufeffmetric_name,metric_type,interval,unit_name,per_unit_name,description,orientation,integration,short_name,curated_metric\n" +
+		"postgresql.connections,gauge,,connection,,\"The number of active connections, per database.\",0,postgres,connections,\n" +
+		"postgresql.rows_fetched,rate,,row,second,The number of rows fetched.,1,postgres,rows fetched,\n" +
+		",gauge,,,,skipped,0,postgres,,\n"
+
+	rows, err := Parse(strings.NewReader(csv))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	if rows[0].Name != "postgresql.connections" || rows[0].Description != "The number of active connections, per database." {
+		t.Errorf("unexpected row: %+v", rows[0])
+	}
+	if rows[1].Type != "rate" || rows[1].PerUnit != "second" || rows[1].Orientation != 1 || rows[1].Integration != "postgres" {
+		t.Errorf("unexpected row: %+v", rows[1])
+	}
+}
+
+func TestParseMissingHeader(t *testing.T) {
+	if _, err := Parse(strings.NewReader("name,type\nfoo,gauge\n")); err == nil {
+		t.Error("expected error without metric_name column")
+	}
+}
+
+func TestUnit(t *testing.T) {
+	tests := []struct {
+		name, perUnit, expected string
+	}{
+		{"byte", "second", "By/s"},
+		{"millisecond", "", "ms"},
+		{"connection", "", "{connection}"},
+		{"", "second", "1/s"},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		if got := unit(tt.name, tt.perUnit); got != tt.expected {
+			t.Errorf("unit(%q, %q) = %q, expected %q", tt.name, tt.perUnit, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Adapter runs a Spec. It needs no Go code per source: everything the
+// hand-written adapters hard-code comes from the spec file.
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+	spec    *Spec
+}
+
+func NewAdapter(cacheDir string, spec *Spec) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+		spec:    spec,
+	}
+}
+
+// LoadAdapters builds an adapter for every spec in dir.
+func LoadAdapters(dir, cacheDir string) ([]*Adapter, error) {
+	specs, err := LoadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	adapters := make([]*Adapter, 0, len(specs))
+	for _, spec := range specs {
+		adapters = append(adapters, NewAdapter(cacheDir, spec))
+	}
+	return adapters, nil
+}
+
+// Register adds every spec in dir to the adapter registry, so the CLI and
+// the API see declarative adapters alongside the compiled ones. A spec
+// named after a compiled adapter is skipped: the Go code wins.
+func Register(dir string) error {
+	specs, err := LoadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, spec := range specs {
+		if _, ok := adapter.Lookup(spec.Name); ok {
+			log.Printf("%s: adapter %s is already registered, skipping", spec.File, spec.Name)
+			continue
+		}
+		adapter.Register(adapter.Registration{
+			Name:        spec.Name,
+			Category:    domain.SourceCategory(spec.SourceCategory),
+			Description: "Declarative spec " + spec.File,
+			Tags:        []string{"declarative"},
+			New: func(opts adapter.Options) (adapter.Adapter, error) {
+				return NewAdapter(opts.CacheDir, spec), nil
+			},
+		})
+	}
+	return nil
+}
+
+func (a *Adapter) Name() string {
+	return a.spec.Name
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceCategory(a.spec.SourceCategory)
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceLevel(a.spec.Confidence)
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMethod(a.spec.ExtractionMethod)
+}
+
+func (a *Adapter) RepoURL() string {
+	return a.spec.RepoURL
+}
+
+// Spec returns the spec the adapter was built from.
+func (a *Adapter) Spec() *Spec {
+	return a.spec
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	if a.spec.LocalPath != "" {
+		info, err := os.Stat(a.spec.LocalPath)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("local_path %s is not a directory", a.spec.LocalPath)
+		}
+		return &adapter.FetchResult{
+			RepoPath:  a.spec.LocalPath,
+			Commit:    "local",
+			Timestamp: info.ModTime(),
+		}, nil
+	}
+
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: a.spec.RepoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(_ context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	var metrics []*adapter.RawMetric
+	seen := make(map[string]bool)
+
+	err := filepath.WalkDir(result.RepoPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		rel, _ := filepath.Rel(result.RepoPath, path)
+		rel = filepath.ToSlash(rel)
+		if !a.spec.selects(rel) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path) //nolint:gosec // Reading files selected by the spec is intentional
+		if err != nil {
+			return err
+		}
+		// A file the extractor cannot parse is skipped, like the
+		// hand-written adapters skip unparsable sources, but reported so a
+		// spec that matches nothing it can read is noticed
+		records, err := a.spec.extractFile(rel, content)
+		if err != nil {
+			log.Printf("%s: skipping %s: %v", a.spec.Name, rel, err)
+			return nil
+		}
+
+		for _, rec := range records {
+			if rec.name == "" {
+				continue
+			}
+			name := a.spec.NamePrefix + rec.name
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			// Sources only name their attributes; the type stays unknown
+			// rather than guessed
+			attrs := make([]domain.Attribute, 0, len(rec.attributes))
+			for _, attr := range rec.attributes {
+				attrs = append(attrs, domain.Attribute{Name: attr})
+			}
+
+			metrics = append(metrics, &adapter.RawMetric{
+				Name:             name,
+				Description:      rec.description,
+				InstrumentType:   a.spec.instrumentType(name, rec.typ),
+				Unit:             rec.unit,
+				Attributes:       attrs,
+				EnabledByDefault: true,
+				ComponentType:    a.spec.Component.Type,
+				ComponentName:    a.spec.componentName(rel, rec.component),
+				SourceLocation:   rel,
+				Path:             rel,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
+
+// selects applies the include globs, or accepts every file when there are
+// none, then the exclude globs.
+func (s *Spec) selects(rel string) bool {
+	included := len(s.Include) == 0
+	for _, pattern := range s.Include {
+		if adapter.MatchGlob(pattern, rel) {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, pattern := range s.Exclude {
+		if adapter.MatchGlob(pattern, rel) {
+			return false
+		}
+	}
+	return true
+}
+
+// instrumentType normalizes an extracted type through type_map, then falls
+// back to the first matching type rule and finally the default type.
+func (s *Spec) instrumentType(name, extracted string) string {
+	t := strings.ToLower(strings.TrimSpace(extracted))
+	if mapped, ok := s.TypeMap[t]; ok {
+		t = mapped
+	}
+	if domain.InstrumentType(t).IsValid() {
+		return t
+	}
+	for _, rule := range s.TypeRules {
+		if rule.re.MatchString(name) {
+			return rule.Type
+		}
+	}
+	return s.DefaultType
+}
+
+func (s *Spec) componentName(rel, extracted string) string {
+	if extracted != "" {
+		return extracted
+	}
+
+	switch nameFrom := s.Component.NameFrom; {
+	case nameFrom == "dir":
+		if dir := filepath.Base(filepath.Dir(rel)); dir != "." {
+			return dir
+		}
+	case nameFrom == "file":
+		base := filepath.Base(rel)
+		return strings.TrimSuffix(base, filepath.Ext(base))
+	case strings.HasPrefix(nameFrom, "segment:"):
+		i, _ := strconv.Atoi(strings.TrimPrefix(nameFrom, "segment:"))
+		if segments := strings.Split(rel, "/"); i >= 0 && i < len(segments)-1 {
+			return segments[i]
+		}
+	}
+
+	if s.Component.Name != "" {
+		return s.Component.Name
+	}
+	return s.Name
+}
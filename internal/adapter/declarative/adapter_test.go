@@ -0,0 +1,205 @@
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func TestAdapterImplementsInterface(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter(".cache", &Spec{})
+}
+
+func TestAdapterExtractLocalPath(t *testing.T) {
+	repo := t.TempDir()
+	files := map[string]string{
+		"exporter/collector.go": `package exporter
+
+var (
+	up       = prometheus.NewDesc("up", "Whether the last scrape succeeded", nil, nil)
+	commands = prometheus.NewDesc("commands_total", "Commands processed", []string{"cmd"}, nil)
+)
+`,
+		"exporter/collector_test.go": `package exporter
+
+var fake = prometheus.NewDesc("fake_total", "Test only", nil, nil)
+`,
+		"cmd/main.go": `package main
+
+var outside = prometheus.NewDesc("outside", "Not included", nil, nil)
+`,
+	}
+	for path, content := range files {
+		full := filepath.Join(repo, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(full), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content := strings.Replace(redisSpec, "repo_url: https://github.com/acme/redis-exporter", "local_path: "+repo, 1)
+	content += "name_prefix: acme_\n"
+	specDir := t.TempDir()
+	writeSpec(t, specDir, "redis.yaml", content)
+
+	adapters, err := LoadAdapters(specDir, t.TempDir())
+	if err != nil {
+		t.Fatalf("LoadAdapters failed: %v", err)
+	}
+	if len(adapters) != 1 {
+		t.Fatalf("expected 1 adapter, got %d", len(adapters))
+	}
+	a := adapters[0]
+
+	if a.Name() != "internal-redis" || a.SourceCategory() != domain.SourcePrometheus || a.Confidence() != domain.ConfidenceDerived {
+		t.Errorf("unexpected adapter properties: %s %s %s", a.Name(), a.SourceCategory(), a.Confidence())
+	}
+
+	ctx := context.Background()
+	result, err := a.Fetch(ctx, adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	if result.RepoPath != repo || result.Commit != "local" {
+		t.Errorf("unexpected fetch result %+v", result)
+	}
+
+	metrics, err := a.Extract(ctx, result)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+
+	byName := make(map[string]*adapter.RawMetric)
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+
+	commands := byName["acme_commands_total"]
+	if commands == nil || commands.InstrumentType != "counter" || len(commands.Attributes) != 1 {
+		t.Errorf("unexpected acme_commands_total: %+v", commands)
+	}
+	if commands != nil && len(commands.Attributes) == 1 && commands.Attributes[0].Type != "" {
+		t.Errorf("expected an unknown attribute type, got %q", commands.Attributes[0].Type)
+	}
+	if commands != nil && (commands.ComponentName != "redis" || commands.ComponentType != "platform" || commands.Path != "exporter/collector.go") {
+		t.Errorf("unexpected component or path: %+v", commands)
+	}
+	if up := byName["acme_up"]; up == nil || up.InstrumentType != "gauge" {
+		t.Errorf("unexpected acme_up: %+v", up)
+	}
+}
+
+func TestAdapterExtractLogsUnparsableFiles(t *testing.T) {
+	repo := t.TempDir()
+	files := map[string]string{
+		"exporter/collector.go": `package exporter
+
+var up = prometheus.NewDesc("up", "Whether the last scrape succeeded", nil, nil)
+`,
+		"exporter/broken.go": `package exporter
+
+var up = prometheus.NewDesc(`,
+	}
+	for path, content := range files {
+		full := filepath.Join(repo, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(full), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	spec, err := LoadSpec(writeSpec(t, t.TempDir(), "redis.yaml", redisSpec))
+	if err != nil {
+		t.Fatalf("LoadSpec failed: %v", err)
+	}
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	metrics, err := NewAdapter(t.TempDir(), spec).Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(metrics) != 1 {
+		t.Errorf("expected the parsable file's metric, got %d", len(metrics))
+	}
+	if !strings.Contains(logs.String(), "internal-redis: skipping exporter/broken.go") {
+		t.Errorf("expected the skipped file to be logged, got %q", logs.String())
+	}
+}
+
+func TestRegister(t *testing.T) {
+	adapter.Register(adapter.Registration{
+		Name: "register-test-compiled",
+		New:  func(adapter.Options) (adapter.Adapter, error) { return NewAdapter("", &Spec{}), nil },
+	})
+
+	dir := t.TempDir()
+	writeSpec(t, dir, "a.yaml", strings.Replace(redisSpec, "internal-redis", "register-test-spec", 1))
+	writeSpec(t, dir, "b.yaml", strings.Replace(redisSpec, "internal-redis", "register-test-compiled", 1))
+
+	if err := Register(dir); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	r, ok := adapter.Lookup("register-test-spec")
+	if !ok {
+		t.Fatal("expected the spec to be registered")
+	}
+	if r.Category != domain.SourcePrometheus || len(r.Tags) != 1 || r.Tags[0] != "declarative" {
+		t.Errorf("unexpected registration %+v", r)
+	}
+	a, err := adapter.New("register-test-spec", adapter.Options{CacheDir: t.TempDir()})
+	if err != nil || a.Name() != "register-test-spec" {
+		t.Errorf("expected the declarative adapter, got %v, %v", a, err)
+	}
+
+	if r, _ := adapter.Lookup("register-test-compiled"); r.Category != "" {
+		t.Errorf("expected the compiled adapter to win, got %+v", r)
+	}
+}
+
+func TestInstrumentTypeAndComponentName(t *testing.T) {
+	spec := mustValidate(t, &Spec{
+		Extractor: ExtractorSpec{Type: ExtractorCSV},
+		Fields:    map[string]string{"name": "metric"},
+		TypeMap:   map[string]string{"count": "counter", "rate": "gauge"},
+		TypeRules: []TypeRule{{Match: `_seconds$`, Type: "histogram"}},
+		Component: ComponentSpec{NameFrom: "segment:1"},
+	})
+
+	if got := spec.instrumentType("x", "Count"); got != "counter" {
+		t.Errorf("expected type_map to apply, got %s", got)
+	}
+	if got := spec.instrumentType("latency_seconds", ""); got != "histogram" {
+		t.Errorf("expected type rule to apply, got %s", got)
+	}
+	if got := spec.instrumentType("x", "bogus"); got != "gauge" {
+		t.Errorf("expected default type, got %s", got)
+	}
+
+	if got := spec.componentName("services/billing/metrics.csv", ""); got != "billing" {
+		t.Errorf("expected segment component, got %s", got)
+	}
+	if got := spec.componentName("metrics.csv", ""); got != "test" {
+		t.Errorf("expected spec name fallback, got %s", got)
+	}
+	if got := spec.componentName("services/billing/metrics.csv", "payments"); got != "payments" {
+		t.Errorf("expected extracted component to win, got %s", got)
+	}
+}
@@ -0,0 +1,331 @@
+package declarative
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+)
+
+// record is one metric as found in a file, before type inference and
+// component naming.
+type record struct {
+	name        string
+	description string
+	typ         string
+	unit        string
+	attributes  []string
+	component   string
+}
+
+var listSeparator = regexp.MustCompile(`[,\s]+`)
+
+func (s *Spec) extractFile(filename string, content []byte) ([]record, error) {
+	switch s.Extractor.Type {
+	case ExtractorRegex:
+		return s.extractRegex(content), nil
+	case ExtractorGoAST:
+		return s.extractGoAST(filename, content)
+	case ExtractorYAML:
+		var doc any
+		if err := yaml.Unmarshal(content, &doc); err != nil {
+			return nil, err
+		}
+		return s.extractStructured(doc), nil
+	case ExtractorJSON:
+		var doc any
+		if err := json.Unmarshal(content, &doc); err != nil {
+			return nil, err
+		}
+		return s.extractStructured(doc), nil
+	case ExtractorCSV:
+		return s.extractCSV(content)
+	}
+	return nil, fmt.Errorf("unknown extractor type %q", s.Extractor.Type)
+}
+
+func (s *Spec) extractRegex(content []byte) []record {
+	re := s.Extractor.re
+	var records []record
+	for _, m := range re.FindAllSubmatch(content, -1) {
+		group := func(field string) string {
+			name := s.Fields[field]
+			if name == "" {
+				return ""
+			}
+			if i := re.SubexpIndex(name); i >= 0 && m[i] != nil {
+				return strings.TrimSpace(string(m[i]))
+			}
+			return ""
+		}
+		records = append(records, record{
+			name:        group("name"),
+			description: group("description"),
+			typ:         group("type"),
+			unit:        group("unit"),
+			attributes:  splitList(group("attributes")),
+			component:   group("component"),
+		})
+	}
+	return records
+}
+
+// extractGoAST matches calls to the configured function and reads the
+// mapped arguments as strings, or []string / map literals for attributes.
+func (s *Spec) extractGoAST(filename string, content []byte) ([]record, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filename, content, 0)
+	if err != nil {
+		return nil, err
+	}
+	constants := astparser.Constants(f)
+
+	arg := func(call *ast.CallExpr, field string) ast.Expr {
+		index, err := strconv.Atoi(s.Fields[field])
+		if err != nil || index < 0 || index >= len(call.Args) {
+			return nil
+		}
+		return call.Args[index]
+	}
+	str := func(call *ast.CallExpr, field string) string {
+		if expr := arg(call, field); expr != nil {
+			return astparser.ResolveString(expr, constants)
+		}
+		return ""
+	}
+
+	var records []record
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !s.matchesFunction(call.Fun) {
+			return true
+		}
+		records = append(records, record{
+			name:        str(call, "name"),
+			description: str(call, "description"),
+			typ:         str(call, "type"),
+			unit:        str(call, "unit"),
+			attributes:  literalStrings(arg(call, "attributes"), constants),
+		})
+		return true
+	})
+	return records, nil
+}
+
+// matchesFunction compares a call's function with the spec's, which may be
+// qualified (metrics.NewCounter) or bare (NewDesc, matching any package).
+func (s *Spec) matchesFunction(fun ast.Expr) bool {
+	want := s.Extractor.Function
+	switch f := fun.(type) {
+	case *ast.Ident:
+		return f.Name == want
+	case *ast.SelectorExpr:
+		if f.Sel.Name == want {
+			return true
+		}
+		if pkg, ok := f.X.(*ast.Ident); ok {
+			return pkg.Name+"."+f.Sel.Name == want
+		}
+	}
+	return false
+}
+
+func literalStrings(expr ast.Expr, constants map[string]string) []string {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	var values []string
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			elt = kv.Key
+		}
+		if v := astparser.ResolveString(elt, constants); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// extractStructured walks to Extractor.Path in a decoded YAML or JSON
+// document. A list yields one record per item; a map yields one per key,
+// with the key stored under Extractor.KeyField.
+func (s *Spec) extractStructured(doc any) []record {
+	node := lookup(doc, s.Extractor.Path)
+
+	var items []map[string]any
+	switch n := node.(type) {
+	case []any:
+		for _, item := range n {
+			if m, ok := item.(map[string]any); ok {
+				items = append(items, m)
+			}
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			item, ok := n[k].(map[string]any)
+			if !ok {
+				item = map[string]any{}
+			}
+			if s.Extractor.KeyField != "" {
+				copied := make(map[string]any, len(item)+1)
+				for ik, iv := range item {
+					copied[ik] = iv
+				}
+				copied[s.Extractor.KeyField] = k
+				item = copied
+			}
+			items = append(items, item)
+		}
+	}
+
+	field := func(item map[string]any, name string) any {
+		key := s.Fields[name]
+		if key == "" && name == s.Extractor.KeyField {
+			key = name
+		}
+		if key == "" {
+			return nil
+		}
+		return lookup(item, key)
+	}
+
+	records := make([]record, 0, len(items))
+	for _, item := range items {
+		records = append(records, record{
+			name:        scalar(field(item, "name")),
+			description: scalar(field(item, "description")),
+			typ:         scalar(field(item, "type")),
+			unit:        scalar(field(item, "unit")),
+			attributes:  names(field(item, "attributes")),
+			component:   scalar(field(item, "component")),
+		})
+	}
+	return records
+}
+
+func (s *Spec) extractCSV(content []byte) ([]record, error) {
+	content = bytes.TrimPrefix(content, []byte("\ufeff"))
+	r := csv.NewReader(bytes.NewReader(content))
+	r.FieldsPerRecord = -1
+	if s.Extractor.Delimiter != "" {
+		r.Comma = rune(s.Extractor.Delimiter[0])
+	}
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, err
+	}
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+
+	var records []record
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		col := func(field string) string {
+			i, ok := columns[s.Fields[field]]
+			if !ok || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+		records = append(records, record{
+			name:        col("name"),
+			description: col("description"),
+			typ:         col("type"),
+			unit:        col("unit"),
+			attributes:  splitList(col("attributes")),
+			component:   col("component"),
+		})
+	}
+	return records, nil
+}
+
+// lookup follows a dotted path through nested maps; "" is the document.
+func lookup(node any, dotted string) any {
+	if dotted == "" {
+		return node
+	}
+	for _, key := range strings.Split(dotted, ".") {
+		m, ok := node.(map[string]any)
+		if !ok {
+			return nil
+		}
+		node = m[key]
+	}
+	return node
+}
+
+func scalar(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(x)
+	case map[string]any, []any:
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+// names reads attribute names from a list of strings, a list of maps with a
+// name key, a map keyed by name, or a comma-separated string.
+func names(v any) []string {
+	switch x := v.(type) {
+	case string:
+		return splitList(x)
+	case []any:
+		var out []string
+		for _, item := range x {
+			if m, ok := item.(map[string]any); ok {
+				item = m["name"]
+			}
+			if name := scalar(item); name != "" {
+				out = append(out, name)
+			}
+		}
+		return out
+	case map[string]any:
+		out := make([]string, 0, len(x))
+		for k := range x {
+			out = append(out, k)
+		}
+		sort.Strings(out)
+		return out
+	}
+	return nil
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, part := range listSeparator.Split(s, -1) {
+		if part = strings.Trim(part, `"'[]`); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
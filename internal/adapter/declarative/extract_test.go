@@ -0,0 +1,139 @@
+package declarative
+
+import (
+	"testing"
+)
+
+func mustValidate(t *testing.T, spec *Spec) *Spec {
+	t.Helper()
+	spec.Name = "test"
+	spec.SourceCategory = "vendor"
+	spec.LocalPath = "."
+	if err := spec.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	return spec
+}
+
+func TestExtractRegex(t *testing.T) {
+	spec := mustValidate(t, &Spec{
+		Extractor: ExtractorSpec{
+			Type:    ExtractorRegex,
+			Pattern: `stats\.(?P<kind>counter|gauge)\("(?P<metric>[^"]+)",\s*"(?P<help>[^"]*)"(?:,\s*tags=\[(?P<tags>[^\]]*)\])?`,
+		},
+		Fields: map[string]string{"name": "metric", "description": "help", "type": "kind", "attributes": "tags"},
+	})
+
+	src := `stats.counter("jobs_processed", "Jobs processed", tags=["queue", "status"])
+stats.gauge("workers_busy", "Busy workers")`
+
+	records, err := spec.extractFile("worker.py", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if r := records[0]; r.name != "jobs_processed" || r.typ != "counter" || len(r.attributes) != 2 || r.attributes[1] != "status" {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if r := records[1]; r.description != "Busy workers" || len(r.attributes) != 0 {
+		t.Errorf("unexpected record %+v", r)
+	}
+}
+
+func TestExtractGoAST(t *testing.T) {
+	spec := mustValidate(t, &Spec{
+		Extractor: ExtractorSpec{Type: ExtractorGoAST, Function: "metrics.NewCounter"},
+		Fields:    map[string]string{"name": "0", "description": "1", "attributes": "2"},
+	})
+
+	src := `package billing
+
+const invoicesName = "billing_invoices_total"
+
+var (
+	invoices = metrics.NewCounter(invoicesName, "Invoices issued", []string{"currency", "plan"})
+	ignored  = other.NewCounter("other_total", "Not matched", nil)
+)
+`
+	records, err := spec.extractFile("billing.go", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d: %+v", len(records), records)
+	}
+	if r := records[0]; r.name != "billing_invoices_total" || r.description != "Invoices issued" || len(r.attributes) != 2 {
+		t.Errorf("unexpected record %+v", r)
+	}
+}
+
+func TestExtractYAMLMap(t *testing.T) {
+	spec := mustValidate(t, &Spec{
+		Extractor: ExtractorSpec{Type: ExtractorYAML, Path: "telemetry.metrics", KeyField: "name"},
+		Fields:    map[string]string{"description": "help", "type": "kind", "unit": "unit", "attributes": "labels"},
+	})
+
+	src := `telemetry:
+  metrics:
+    queue_depth:
+      help: Messages waiting
+      kind: gauge
+      unit: "{message}"
+      labels:
+        - name: queue
+        - name: tenant
+    bytes_sent:
+      help: Bytes sent
+      kind: sum
+`
+	records, err := spec.extractFile("metrics.yaml", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+	if r := records[1]; r.name != "queue_depth" || r.unit != "{message}" || len(r.attributes) != 2 || r.attributes[0] != "queue" {
+		t.Errorf("unexpected record %+v", r)
+	}
+}
+
+func TestExtractJSONList(t *testing.T) {
+	spec := mustValidate(t, &Spec{
+		Extractor: ExtractorSpec{Type: ExtractorJSON, Path: "metrics"},
+		Fields:    map[string]string{"name": "id", "description": "meta.description", "attributes": "dimensions"},
+	})
+
+	src := `{"metrics": [{"id": "api.latency", "meta": {"description": "API latency"}, "dimensions": {"route": {}, "method": {}}}]}`
+	records, err := spec.extractFile("metrics.json", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if r := records[0]; r.name != "api.latency" || r.description != "API latency" || len(r.attributes) != 2 || r.attributes[0] != "method" {
+		t.Errorf("unexpected record %+v", r)
+	}
+}
+
+func TestExtractCSV(t *testing.T) {
+	spec := mustValidate(t, &Spec{
+		Extractor: ExtractorSpec{Type: ExtractorCSV, Delimiter: ";"},
+		Fields:    map[string]string{"name": "metric", "type": "metric_type", "attributes": "tags", "component": "service"},
+	})
+
+	src := "metric;metric_type;tags;service\npayments.count;count;region,currency;payments\n"
+	records, err := spec.extractFile("metrics.csv", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if r := records[0]; r.name != "payments.count" || r.typ != "count" || len(r.attributes) != 2 || r.component != "payments" {
+		t.Errorf("unexpected record %+v", r)
+	}
+}
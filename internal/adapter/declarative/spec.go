@@ -0,0 +1,294 @@
+package declarative
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Extractor strategies a spec can name
+const (
+	ExtractorRegex = "regex"
+	ExtractorGoAST = "go_ast"
+	ExtractorYAML  = "yaml"
+	ExtractorJSON  = "json"
+	ExtractorCSV   = "csv"
+)
+
+// Fields a spec maps from the extracted data onto RawMetric
+var mappableFields = map[string]bool{
+	"name":        true,
+	"description": true,
+	"type":        true,
+	"unit":        true,
+	"attributes":  true,
+	"component":   true,
+}
+
+// Spec describes an adapter in YAML rather than Go: where the sources live,
+// which files to read, how to pull metrics out of them and how to map what
+// was found onto catalog fields.
+type Spec struct {
+	Name             string `yaml:"name"`
+	SourceCategory   string `yaml:"source_category"`
+	Confidence       string `yaml:"confidence"`
+	ExtractionMethod string `yaml:"extraction_method"`
+	RepoURL          string `yaml:"repo_url"`
+	// LocalPath reads an existing checkout instead of cloning RepoURL
+	LocalPath string   `yaml:"local_path"`
+	Include   []string `yaml:"include"`
+	Exclude   []string `yaml:"exclude"`
+
+	Component ComponentSpec `yaml:"component"`
+	Extractor ExtractorSpec `yaml:"extractor"`
+	// Fields maps catalog fields to regex groups, YAML/JSON keys, CSV
+	// columns or, for go_ast, call argument indexes
+	Fields map[string]string `yaml:"fields"`
+
+	NamePrefix  string            `yaml:"name_prefix"`
+	TypeMap     map[string]string `yaml:"type_map"`
+	TypeRules   []TypeRule        `yaml:"type_rules"`
+	DefaultType string            `yaml:"default_type"`
+
+	// File is the spec's own path, for error messages
+	File string `yaml:"-"`
+}
+
+type ComponentSpec struct {
+	Type string `yaml:"type"`
+	Name string `yaml:"name"`
+	// NameFrom derives the component from the file: dir, file or segment:N
+	NameFrom string `yaml:"name_from"`
+}
+
+type ExtractorSpec struct {
+	Type string `yaml:"type"`
+
+	// regex
+	Pattern string `yaml:"pattern"`
+
+	// go_ast: the called function's name, e.g. NewDesc or metrics.NewCounter
+	Function string `yaml:"function"`
+
+	// yaml and json: dotted path to a list or map of metrics. For a map,
+	// each key is stored under KeyField
+	Path     string `yaml:"path"`
+	KeyField string `yaml:"key_field"`
+
+	// csv
+	Delimiter string `yaml:"delimiter"`
+
+	re *regexp.Regexp
+}
+
+// TypeRule assigns an instrument type to names matching a pattern when the
+// source does not state one.
+type TypeRule struct {
+	Match string `yaml:"match"`
+	Type  string `yaml:"type"`
+
+	re *regexp.Regexp
+}
+
+// LoadSpec reads and validates one spec file.
+func LoadSpec(path string) (*Spec, error) {
+	data, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var spec Spec
+	if err := yaml.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	spec.File = path
+
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &spec, nil
+}
+
+// LoadDir loads every *.yaml and *.yml spec in dir, sorted by file name. A
+// missing directory holds no specs.
+func LoadDir(dir string) ([]*Spec, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if !entry.IsDir() && (ext == ".yaml" || ext == ".yml") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	specs := make([]*Spec, 0, len(names))
+	seen := make(map[string]string)
+	for _, name := range names {
+		spec, err := LoadSpec(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := seen[spec.Name]; ok {
+			return nil, fmt.Errorf("%s: adapter %q already defined in %s", spec.File, spec.Name, other)
+		}
+		seen[spec.Name] = spec.File
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// Validate checks the spec and fills in defaults.
+func (s *Spec) Validate() error {
+	if s.Name == "" {
+		return errors.New("name is required")
+	}
+	if s.RepoURL == "" && s.LocalPath == "" {
+		return errors.New("one of repo_url or local_path is required")
+	}
+
+	if s.Confidence == "" {
+		s.Confidence = string(domain.ConfidenceDerived)
+	}
+	if s.Component.Type == "" {
+		s.Component.Type = string(domain.ComponentPlatform)
+	}
+	if s.DefaultType == "" {
+		s.DefaultType = string(domain.InstrumentGauge)
+	}
+	if s.ExtractionMethod == "" {
+		s.ExtractionMethod = defaultExtractionMethod(s.Extractor.Type)
+	}
+
+	if !domain.SourceCategory(s.SourceCategory).IsValid() {
+		return fmt.Errorf("invalid source_category %q", s.SourceCategory)
+	}
+	if !domain.ConfidenceLevel(s.Confidence).IsValid() {
+		return fmt.Errorf("invalid confidence %q", s.Confidence)
+	}
+	if !domain.ExtractionMethod(s.ExtractionMethod).IsValid() {
+		return fmt.Errorf("invalid extraction_method %q", s.ExtractionMethod)
+	}
+	if !domain.ComponentType(s.Component.Type).IsValid() {
+		return fmt.Errorf("invalid component type %q", s.Component.Type)
+	}
+	if !domain.InstrumentType(s.DefaultType).IsValid() {
+		return fmt.Errorf("invalid default_type %q", s.DefaultType)
+	}
+	if err := validateNameFrom(s.Component.NameFrom); err != nil {
+		return err
+	}
+
+	for field := range s.Fields {
+		if !mappableFields[field] {
+			return fmt.Errorf("unknown field %q", field)
+		}
+	}
+	if s.Fields["name"] == "" && s.Extractor.KeyField != "name" {
+		return errors.New("fields.name is required")
+	}
+
+	for i := range s.TypeRules {
+		rule := &s.TypeRules[i]
+		re, err := regexp.Compile(rule.Match)
+		if err != nil {
+			return fmt.Errorf("type_rules[%d]: %w", i, err)
+		}
+		if !domain.InstrumentType(rule.Type).IsValid() {
+			return fmt.Errorf("type_rules[%d]: invalid type %q", i, rule.Type)
+		}
+		rule.re = re
+	}
+	for from, to := range s.TypeMap {
+		if !domain.InstrumentType(to).IsValid() {
+			return fmt.Errorf("type_map[%s]: invalid type %q", from, to)
+		}
+	}
+
+	for _, pattern := range append(append([]string{}, s.Include...), s.Exclude...) {
+		if _, err := filepath.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+			return fmt.Errorf("invalid glob %q: %w", pattern, err)
+		}
+	}
+
+	return s.Extractor.validate(s.Fields)
+}
+
+func (e *ExtractorSpec) validate(fields map[string]string) error {
+	switch e.Type {
+	case ExtractorRegex:
+		re, err := regexp.Compile(e.Pattern)
+		if err != nil {
+			return fmt.Errorf("extractor pattern: %w", err)
+		}
+		e.re = re
+		groups := make(map[string]bool)
+		for _, g := range re.SubexpNames() {
+			groups[g] = g != ""
+		}
+		for field, group := range fields {
+			if !groups[group] {
+				return fmt.Errorf("fields.%s: pattern has no group named %q", field, group)
+			}
+		}
+	case ExtractorGoAST:
+		if e.Function == "" {
+			return errors.New("go_ast extractor requires function")
+		}
+		for field, arg := range fields {
+			if field == "component" {
+				return errors.New("go_ast extractor cannot map component")
+			}
+			if _, err := strconv.Atoi(arg); err != nil {
+				return fmt.Errorf("fields.%s: go_ast fields are argument indexes, got %q", field, arg)
+			}
+		}
+	case ExtractorYAML, ExtractorJSON:
+	case ExtractorCSV:
+		if len(e.Delimiter) > 1 {
+			return fmt.Errorf("csv delimiter must be one character, got %q", e.Delimiter)
+		}
+	case "":
+		return errors.New("extractor.type is required")
+	default:
+		return fmt.Errorf("unknown extractor type %q", e.Type)
+	}
+	return nil
+}
+
+func defaultExtractionMethod(extractor string) string {
+	switch extractor {
+	case ExtractorGoAST, ExtractorRegex:
+		return string(domain.ExtractionAST)
+	}
+	return string(domain.ExtractionMetadata)
+}
+
+func validateNameFrom(nameFrom string) error {
+	switch {
+	case nameFrom == "", nameFrom == "dir", nameFrom == "file":
+		return nil
+	case strings.HasPrefix(nameFrom, "segment:"):
+		if _, err := strconv.Atoi(strings.TrimPrefix(nameFrom, "segment:")); err != nil {
+			return fmt.Errorf("invalid component name_from %q", nameFrom)
+		}
+		return nil
+	}
+	return fmt.Errorf("invalid component name_from %q", nameFrom)
+}
@@ -0,0 +1,114 @@
+package declarative
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const redisSpec = `name: internal-redis
+source_category: prometheus
+repo_url: https://github.com/acme/redis-exporter
+include: ["exporter/**/*.go"]
+exclude: ["**/*_test.go"]
+component:
+  name: redis
+extractor:
+  type: go_ast
+  function: NewDesc
+fields:
+  name: "0"
+  description: "1"
+  attributes: "2"
+type_rules:
+  - match: "_total$"
+    type: counter
+`
+
+func writeSpec(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadSpecDefaults(t *testing.T) {
+	spec, err := LoadSpec(writeSpec(t, t.TempDir(), "redis.yaml", redisSpec))
+	if err != nil {
+		t.Fatalf("LoadSpec failed: %v", err)
+	}
+
+	if spec.Confidence != "derived" || spec.ExtractionMethod != "ast" {
+		t.Errorf("unexpected defaults: confidence %s, extraction %s", spec.Confidence, spec.ExtractionMethod)
+	}
+	if spec.Component.Type != "platform" || spec.DefaultType != "gauge" {
+		t.Errorf("unexpected defaults: component %s, default type %s", spec.Component.Type, spec.DefaultType)
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace [2]string
+		want    string
+	}{
+		{"missing name", [2]string{"name: internal-redis", ""}, "name is required"},
+		{"bad category", [2]string{"source_category: prometheus", "source_category: wiki"}, "invalid source_category"},
+		{"no repo", [2]string{"repo_url: https://github.com/acme/redis-exporter", ""}, "repo_url or local_path"},
+		{"bad extractor", [2]string{"type: go_ast", "type: xpath"}, "unknown extractor type"},
+		{"non-index ast field", [2]string{`name: "0"`, "name: first"}, "argument indexes"},
+		{"bad rule type", [2]string{"type: counter", "type: timer"}, "invalid type"},
+		{"unknown field", [2]string{`attributes: "2"`, `labels: "2"`}, "unknown field"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := strings.Replace(redisSpec, tt.replace[0], tt.replace[1], 1)
+			_, err := LoadSpec(writeSpec(t, t.TempDir(), "spec.yaml", content))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegexGroups(t *testing.T) {
+	spec := &Spec{
+		Name:           "regex",
+		SourceCategory: "vendor",
+		LocalPath:      ".",
+		Extractor:      ExtractorSpec{Type: ExtractorRegex, Pattern: `metric\("(?P<metric>[^"]+)"`},
+		Fields:         map[string]string{"name": "metric", "description": "help"},
+	}
+	if err := spec.Validate(); err == nil || !strings.Contains(err.Error(), `no group named "help"`) {
+		t.Errorf("expected missing group error, got %v", err)
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	writeSpec(t, dir, "b.yml", strings.Replace(redisSpec, "internal-redis", "b-redis", 1))
+	writeSpec(t, dir, "a.yaml", redisSpec)
+	writeSpec(t, dir, "notes.md", "not a spec")
+
+	specs, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir failed: %v", err)
+	}
+	if len(specs) != 2 || specs[0].Name != "internal-redis" || specs[1].Name != "b-redis" {
+		t.Errorf("unexpected specs %v", specs)
+	}
+
+	writeSpec(t, dir, "c.yaml", redisSpec)
+	if _, err := LoadDir(dir); err == nil || !strings.Contains(err.Error(), "already defined") {
+		t.Errorf("expected duplicate name error, got %v", err)
+	}
+
+	specs, err = LoadDir(filepath.Join(dir, "missing"))
+	if err != nil || specs != nil {
+		t.Errorf("expected no specs for missing dir, got %v, %v", specs, err)
+	}
+}
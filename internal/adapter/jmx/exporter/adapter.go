@@ -0,0 +1,177 @@
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/prometheus/jmx_exporter"
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "jmx-exporter",
+		Category:    domain.SourcePrometheus,
+		Description: "Prometheus JMX exporter example rules",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "java", "jmx"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "jmx-exporter"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMetadata
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	examplesDir := filepath.Join(result.RepoPath, "examples")
+
+	entries, err := os.ReadDir(examplesDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []*adapter.RawMetric
+
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".yml" && ext != ".yaml") {
+			continue
+		}
+
+		filePath := filepath.Join(examplesDir, entry.Name())
+		defs, err := ParseFile(filePath)
+		if err != nil {
+			continue
+		}
+
+		relPath, _ := filepath.Rel(result.RepoPath, filePath)
+		componentName := strings.TrimSuffix(entry.Name(), ext)
+		seen := make(map[string]*adapter.RawMetric)
+
+		for _, def := range defs {
+			attrs := make([]domain.Attribute, 0, len(def.Labels))
+			for _, label := range def.Labels {
+				attrs = append(attrs, domain.Attribute{
+					Name: label.Name,
+					Type: "string",
+					Enum: label.Values,
+				})
+			}
+
+			// Several rules often feed one metric with different labels
+			if existing, ok := seen[def.Name]; ok {
+				existing.Attributes = mergeAttributes(existing.Attributes, attrs)
+				continue
+			}
+
+			rawMetric := &adapter.RawMetric{
+				Name:             def.Name,
+				Description:      def.Help,
+				InstrumentType:   def.Type,
+				Attributes:       attrs,
+				EnabledByDefault: true,
+				ComponentType:    string(domain.ComponentPlatform),
+				ComponentName:    componentName,
+				SourceLocation:   def.Pattern,
+				Path:             relPath,
+			}
+
+			seen[def.Name] = rawMetric
+			metrics = append(metrics, rawMetric)
+		}
+	}
+
+	return metrics, nil
+}
+
+func mergeAttributes(existing, extra []domain.Attribute) []domain.Attribute {
+	for _, attr := range extra {
+		found := false
+		for i := range existing {
+			if existing[i].Name == attr.Name {
+				existing[i].Enum = mergeValues(existing[i].Enum, attr.Enum)
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, attr)
+		}
+	}
+	return existing
+}
+
+func mergeValues(a, b []string) []string {
+	// An open value set on either side stays open
+	if a == nil || b == nil {
+		return nil
+	}
+	for _, v := range b {
+		found := false
+		for _, x := range a {
+			if x == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			a = append(a, v)
+		}
+	}
+	return a
+}
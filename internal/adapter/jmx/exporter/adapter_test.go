@@ -0,0 +1,172 @@
+package exporter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const tomcatExample = `rules:
+- pattern: 'Catalina<type=GlobalRequestProcessor, name=\"(\w+-\w+)-(\d+)\"><>(\w+):'
+  name: tomcat_$3_total
+  labels:
+    port: "$2"
+    protocol: "$1"
+  type: COUNTER
+- pattern: 'Catalina<type=ThreadPool, name="(\w+-\w+)-(\d+)"><>(currentThreadCount|maxThreads)'
+  name: tomcat_threadpool_$3
+  labels:
+    port: "$2"
+`
+
+func writeExample(t *testing.T, repo, name, content string) {
+	t.Helper()
+
+	dir := filepath.Join(repo, "examples")
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func extract(t *testing.T, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestJMXExporterAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "jmx-exporter" {
+		t.Errorf("expected name 'jmx-exporter', got %q", a.Name())
+	}
+}
+
+func TestJMXExporterAdapter_RepoURL(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.RepoURL() != "https://github.com/prometheus/jmx_exporter" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestJMXExporterAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestJMXExporterAdapter_Extract_ComponentFromFileName(t *testing.T) {
+	repo := t.TempDir()
+	writeExample(t, repo, "tomcat.yml", tomcatExample)
+
+	metrics := extract(t, repo)
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d", len(metrics))
+	}
+
+	for name, m := range metrics {
+		if m.ComponentName != "tomcat" || m.ComponentType != string(domain.ComponentPlatform) {
+			t.Errorf("unexpected component for %s: %s/%s", name, m.ComponentType, m.ComponentName)
+		}
+		if m.Path != filepath.Join("examples", "tomcat.yml") {
+			t.Errorf("expected a repo-relative path for %s, got %q", name, m.Path)
+		}
+	}
+}
+
+func TestJMXExporterAdapter_Extract_TemplatedAndExpandedNames(t *testing.T) {
+	repo := t.TempDir()
+	writeExample(t, repo, "tomcat.yml", tomcatExample)
+
+	metrics := extract(t, repo)
+
+	total := metrics["tomcat_<attribute>_total"]
+	if total == nil || total.InstrumentType != "counter" || len(total.Attributes) != 2 {
+		t.Errorf("expected a templated counter with port and protocol, got %+v", total)
+	}
+
+	threads := metrics["tomcat_threadpool_maxThreads"]
+	if threads == nil || threads.InstrumentType != "gauge" {
+		t.Errorf("expected an expanded gauge, got %+v", threads)
+	}
+}
+
+func TestJMXExporterAdapter_Extract_MergesRuleLabels(t *testing.T) {
+	repo := t.TempDir()
+	writeExample(t, repo, "kafka.yml", `rules:
+- pattern: 'kafka.server<type=(.+), name=(.+)PerSec\w*, topic=(.+)><>Count'
+  name: kafka_server_$1_$2_total
+  labels:
+    topic: "$3"
+  type: COUNTER
+- pattern: 'kafka.server<type=(.+), name=(.+)PerSec\w*, clientId=(.+)><>Count'
+  name: kafka_server_$1_$2_total
+  labels:
+    clientId: "$3"
+  type: COUNTER
+`)
+
+	metrics := extract(t, repo)
+	if len(metrics) != 1 {
+		t.Fatalf("expected rules to share one metric, got %d", len(metrics))
+	}
+
+	m := metrics["kafka_server_<type>_<name>_total"]
+	if m == nil {
+		t.Fatalf("expected the shared metric, got %v", metrics)
+	}
+	if len(m.Attributes) != 2 {
+		t.Errorf("expected labels from both rules, got %+v", m.Attributes)
+	}
+}
+
+func TestJMXExporterAdapter_Extract_SkipsNonYAML(t *testing.T) {
+	repo := t.TempDir()
+	writeExample(t, repo, "README.md", "# examples")
+	writeExample(t, repo, "tomcat.yml", tomcatExample)
+
+	for name, m := range extract(t, repo) {
+		if m.ComponentName != "tomcat" {
+			t.Errorf("unexpected component %q for %s", m.ComponentName, name)
+		}
+	}
+}
+
+func TestMergeValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []string
+		expected []string
+	}{
+		{"union", []string{"a"}, []string{"a", "b"}, []string{"a", "b"}},
+		{"open left", nil, []string{"a"}, nil},
+		{"open right", []string{"a"}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mergeValues(tt.a, tt.b)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("expected %v, got %v", tt.expected, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package exporter
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxExpansions caps how many concrete names one rule may fan out to before
+// its alternations are kept as placeholders instead.
+const maxExpansions = 64
+
+// Config is a jmx_exporter configuration file.
+type Config struct {
+	LowercaseOutputName       bool   `yaml:"lowercaseOutputName"`
+	LowercaseOutputLabelNames bool   `yaml:"lowercaseOutputLabelNames"`
+	Rules                     []Rule `yaml:"rules"`
+}
+
+type Rule struct {
+	Pattern string            `yaml:"pattern"`
+	Name    string            `yaml:"name"`
+	Type    string            `yaml:"type"`
+	Help    string            `yaml:"help"`
+	Labels  map[string]string `yaml:"labels"`
+}
+
+// MetricDef is a metric a rule produces. Names and label keys taken from
+// free-form captures keep a <key> placeholder, as semconv does for template
+// attributes.
+type MetricDef struct {
+	Name    string
+	Type    string
+	Help    string
+	Labels  []LabelDef
+	Pattern string
+}
+
+type LabelDef struct {
+	Name   string
+	Values []string
+}
+
+// group is one capturing group of a rule pattern.
+type group struct {
+	key          string
+	alternatives []string // nil when the group isn't a literal alternation
+}
+
+var (
+	groupRefRe   = regexp.MustCompile(`\$\{?(\d+)\}?`)
+	literalRe    = regexp.MustCompile(`^[A-Za-z0-9_\-. ]+$`)
+	invalidRe    = regexp.MustCompile(`[^a-zA-Z0-9:_]`)
+	underscoreRe = regexp.MustCompile(`__+`)
+	keyRe        = regexp.MustCompile(`([A-Za-z0-9_.\-]+)=$`)
+)
+
+func ParseFile(path string) ([]MetricDef, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Reading config from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) ([]MetricDef, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse jmx_exporter config: %w", err)
+	}
+
+	var defs []MetricDef
+	for _, rule := range cfg.Rules {
+		// Catch-all rules without a name use the default MBean naming
+		if rule.Name == "" {
+			continue
+		}
+		defs = append(defs, expandRule(rule, cfg)...)
+	}
+	return defs, nil
+}
+
+func expandRule(rule Rule, cfg Config) []MetricDef {
+	groups := parseGroups(rule.Pattern)
+
+	// Only alternations referenced by the name multiply it out
+	var expand []int
+	combinations := 1
+	for _, ref := range groupRefRe.FindAllStringSubmatch(rule.Name, -1) {
+		n, _ := strconv.Atoi(ref[1])
+		if n < 1 || n > len(groups) || groups[n-1].alternatives == nil || containsInt(expand, n) {
+			continue
+		}
+		expand = append(expand, n)
+		combinations *= len(groups[n-1].alternatives)
+	}
+	if combinations > maxExpansions {
+		expand = nil
+	}
+
+	var defs []MetricDef
+	for _, bound := range bindings(expand, groups) {
+		name := render(rule.Name, groups, bound)
+		if cfg.LowercaseOutputName {
+			name = strings.ToLower(name)
+		}
+		if strings.EqualFold(rule.Type, "COUNTER") && !strings.HasSuffix(name, "_total") {
+			name += "_total"
+		}
+
+		defs = append(defs, MetricDef{
+			Name:    name,
+			Type:    instrumentType(rule.Type),
+			Help:    rule.Help,
+			Labels:  labels(rule, cfg, groups, bound),
+			Pattern: rule.Pattern,
+		})
+	}
+	return defs
+}
+
+func labels(rule Rule, cfg Config, groups []group, bound map[int]string) []LabelDef {
+	keys := make([]string, 0, len(rule.Labels))
+	for k := range rule.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	result := make([]LabelDef, 0, len(keys))
+	for _, k := range keys {
+		name := render(k, groups, bound)
+		if cfg.LowercaseOutputLabelNames {
+			name = strings.ToLower(name)
+		}
+
+		label := LabelDef{Name: name}
+		value := rule.Labels[k]
+		switch ref := groupRefRe.FindStringSubmatch(value); {
+		case ref == nil:
+			label.Values = []string{value}
+		case ref[0] == value:
+			n, _ := strconv.Atoi(ref[1])
+			if v, ok := bound[n]; ok {
+				label.Values = []string{v}
+			} else if n >= 1 && n <= len(groups) {
+				label.Values = groups[n-1].alternatives
+			}
+		}
+		result = append(result, label)
+	}
+	return result
+}
+
+// bindings returns every assignment of the expanded groups to one of their
+// alternatives.
+func bindings(expand []int, groups []group) []map[int]string {
+	result := []map[int]string{{}}
+	for _, n := range expand {
+		var next []map[int]string
+		for _, b := range result {
+			for _, alt := range groups[n-1].alternatives {
+				c := make(map[int]string, len(b)+1)
+				for k, v := range b {
+					c[k] = v
+				}
+				c[n] = alt
+				next = append(next, c)
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+// render substitutes $N references, sanitizing the way jmx_exporter does
+// while leaving placeholders readable.
+func render(template string, groups []group, bound map[int]string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range groupRefRe.FindAllStringSubmatchIndex(template, -1) {
+		b.WriteString(sanitize(template[last:loc[0]]))
+		n, _ := strconv.Atoi(template[loc[2]:loc[3]])
+		switch v, ok := bound[n]; {
+		case ok:
+			b.WriteString(sanitize(v))
+		case n >= 1 && n <= len(groups):
+			b.WriteString("<" + groups[n-1].key + ">")
+		default:
+			b.WriteString("<" + strconv.Itoa(n) + ">")
+		}
+		last = loc[1]
+	}
+	b.WriteString(sanitize(template[last:]))
+
+	return underscoreRe.ReplaceAllString(b.String(), "_")
+}
+
+func sanitize(s string) string {
+	return invalidRe.ReplaceAllString(s, "_")
+}
+
+// parseGroups lists the capturing groups of a pattern in numbering order,
+// naming each after the MBean key it captures.
+func parseGroups(pattern string) []group {
+	type openGroup struct {
+		index int // -1 for non-capturing groups
+		start int
+	}
+
+	var groups []group
+	var open []openGroup
+
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '\\':
+			i++
+		case '(':
+			if strings.HasPrefix(pattern[i:], "(?") {
+				open = append(open, openGroup{index: -1})
+				continue
+			}
+			groups = append(groups, group{key: groupKey(pattern[:i], len(groups)+1)})
+			open = append(open, openGroup{index: len(groups) - 1, start: i + 1})
+		case ')':
+			if len(open) == 0 {
+				continue
+			}
+			g := open[len(open)-1]
+			open = open[:len(open)-1]
+			if g.index >= 0 {
+				groups[g.index].alternatives = literalAlternatives(pattern[g.start:i])
+			}
+		}
+	}
+
+	return groups
+}
+
+func groupKey(before string, n int) string {
+	if m := keyRe.FindStringSubmatch(before); m != nil {
+		return m[1]
+	}
+	// Captures after the key properties match the attribute name
+	if strings.HasSuffix(before, ">") || strings.HasSuffix(before, "><") {
+		return "attribute"
+	}
+	return "group" + strconv.Itoa(n)
+}
+
+func literalAlternatives(body string) []string {
+	body = strings.NewReplacer(`\.`, ".", `\-`, "-", `\ `, " ").Replace(body)
+	alts := strings.Split(body, "|")
+	for _, alt := range alts {
+		if !literalRe.MatchString(alt) {
+			return nil
+		}
+	}
+	return alts
+}
+
+func instrumentType(t string) string {
+	switch strings.ToUpper(t) {
+	case "COUNTER":
+		return "counter"
+	default:
+		// GAUGE and UNTYPED
+		return "gauge"
+	}
+}
+
+func containsInt(s []int, v int) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package exporter
+
+import (
+	"reflect"
+	"testing"
+)
+
+const kafkaRules = `
+lowercaseOutputName: true
+rules:
+- pattern : kafka.server<type=(.+), name=(.+), clientId=(.+)><>Value
+  name: kafka_server_$1_$2
+  type: GAUGE
+  labels:
+    clientId: "$3"
+- pattern : kafka.server<type=BrokerTopicMetrics, name=(BytesIn|BytesOut)PerSec, topic=(.+)><>Count
+  name: kafka_server_brokertopicmetrics_$1
+  type: COUNTER
+  help: Bytes in and out per topic
+  labels:
+    topic: "$2"
+    direction: "$1"
+    source: broker
+- pattern: ".*"
+`
+
+func TestParse(t *testing.T) {
+	defs, err := Parse([]byte(kafkaRules))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	names := make([]string, 0, len(defs))
+	for _, d := range defs {
+		names = append(names, d.Name)
+	}
+	expected := []string{
+		"kafka_server_<type>_<name>",
+		"kafka_server_brokertopicmetrics_bytesin_total",
+		"kafka_server_brokertopicmetrics_bytesout_total",
+	}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected %v, got %v", expected, names)
+	}
+
+	bytesIn := defs[1]
+	if bytesIn.Type != "counter" || bytesIn.Help != "Bytes in and out per topic" {
+		t.Errorf("unexpected definition: %+v", bytesIn)
+	}
+
+	labels := make(map[string][]string)
+	for _, l := range bytesIn.Labels {
+		labels[l.Name] = l.Values
+	}
+	if !reflect.DeepEqual(labels["direction"], []string{"BytesIn"}) {
+		t.Errorf("expected direction bound to BytesIn, got %v", labels["direction"])
+	}
+	if !reflect.DeepEqual(labels["source"], []string{"broker"}) {
+		t.Errorf("expected constant source label, got %v", labels["source"])
+	}
+	if v, ok := labels["topic"]; !ok || v != nil {
+		t.Errorf("expected open topic label, got %v", v)
+	}
+}
+
+func TestParseGroups(t *testing.T) {
+	groups := parseGroups(`java.lang<type=GarbageCollector, name=(.+)><>(CollectionCount|CollectionTime)`)
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(groups))
+	}
+	if groups[0].key != "name" || groups[0].alternatives != nil {
+		t.Errorf("unexpected first group: %+v", groups[0])
+	}
+	if groups[1].key != "attribute" || len(groups[1].alternatives) != 2 {
+		t.Errorf("unexpected second group: %+v", groups[1])
+	}
+
+	// Non-capturing and escaped parentheses don't count
+	groups = parseGroups(`a(?:b|c)\(d\)(e\.f)`)
+	if len(groups) != 1 || !reflect.DeepEqual(groups[0].alternatives, []string{"e.f"}) {
+		t.Errorf("unexpected groups: %+v", groups)
+	}
+}
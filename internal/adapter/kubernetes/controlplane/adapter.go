@@ -0,0 +1,255 @@
+package controlplane
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/kubernetes/kubernetes"
+
+// component describes one control-plane binary and where its metrics live.
+type component struct {
+	adapterName string
+	name        string
+	dirs        []string
+}
+
+var (
+	apiServer = component{
+		adapterName: "kubernetes-apiserver",
+		name:        "kube-apiserver",
+		dirs: []string{
+			"staging/src/k8s.io/apiserver/pkg",
+			"staging/src/k8s.io/apiextensions-apiserver/pkg",
+			"staging/src/k8s.io/kube-aggregator/pkg",
+			"pkg/controlplane",
+			"pkg/kubeapiserver",
+		},
+	}
+	scheduler = component{
+		adapterName: "kubernetes-scheduler",
+		name:        "kube-scheduler",
+		dirs:        []string{"pkg/scheduler"},
+	}
+	controllerManager = component{
+		adapterName: "kubernetes-controller-manager",
+		name:        "kube-controller-manager",
+		dirs: []string{
+			"pkg/controller",
+			"staging/src/k8s.io/controller-manager",
+		},
+	}
+	kubelet = component{
+		adapterName: "kubernetes-kubelet",
+		name:        "kubelet",
+		dirs:        []string{"pkg/kubelet"},
+	}
+)
+
+// Adapter extracts the component-base metrics of one Kubernetes control-plane
+// component. All of them read the same kubernetes/kubernetes checkout.
+type Adapter struct {
+	fetcher   *fetcher.GitFetcher
+	component component
+}
+
+func newAdapter(cacheDir string, c component) *Adapter {
+	return &Adapter{
+		fetcher:   fetcher.NewGitFetcher(cacheDir),
+		component: c,
+	}
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "kubernetes-apiserver",
+		Category:    domain.SourceKubernetes,
+		Description: "kube-apiserver component-base metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"kubernetes", "control-plane"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAPIServerAdapter(opts.CacheDir), nil
+		},
+	})
+	adapter.Register(adapter.Registration{
+		Name:        "kubernetes-scheduler",
+		Category:    domain.SourceKubernetes,
+		Description: "kube-scheduler component-base metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"kubernetes", "control-plane"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewSchedulerAdapter(opts.CacheDir), nil
+		},
+	})
+	adapter.Register(adapter.Registration{
+		Name:        "kubernetes-controller-manager",
+		Category:    domain.SourceKubernetes,
+		Description: "kube-controller-manager component-base metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"kubernetes", "control-plane"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewControllerManagerAdapter(opts.CacheDir), nil
+		},
+	})
+	adapter.Register(adapter.Registration{
+		Name:        "kubernetes-kubelet",
+		Category:    domain.SourceKubernetes,
+		Description: "kubelet component-base metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"kubernetes", "node"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewKubeletAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAPIServerAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, apiServer)
+}
+
+func NewSchedulerAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, scheduler)
+}
+
+func NewControllerManagerAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, controllerManager)
+}
+
+func NewKubeletAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, kubelet)
+}
+
+func (a *Adapter) Name() string {
+	return a.component.adapterName
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceKubernetes
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	var metrics []*adapter.RawMetric
+	seen := make(map[string]bool)
+
+	for _, dir := range a.component.dirs {
+		root := filepath.Join(result.RepoPath, filepath.FromSlash(dir))
+		if _, err := os.Stat(root); os.IsNotExist(err) {
+			continue
+		}
+
+		files, err := astparser.ParseTree(root, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			for _, def := range file.Metrics {
+				// Only component-base metrics carry the stability contract
+				if def.StabilityLevel == "" || seen[def.Name] {
+					continue
+				}
+				seen[def.Name] = true
+
+				metrics = append(metrics, a.toRawMetric(def, file.Path))
+			}
+		}
+	}
+
+	return metrics, nil
+}
+
+func (a *Adapter) toRawMetric(def astparser.MetricDef, path string) *adapter.RawMetric {
+	attrs := make([]domain.Attribute, 0, len(def.Labels))
+	for _, label := range def.Labels {
+		attrs = append(attrs, domain.Attribute{
+			Name: label,
+			Type: "string",
+		})
+	}
+
+	instrumentType := def.Type
+	if instrumentType == "" {
+		instrumentType = inferInstrumentType(def.Name)
+	}
+
+	return &adapter.RawMetric{
+		Name:             def.Name,
+		Description:      def.Help,
+		InstrumentType:   instrumentType,
+		Attributes:       attrs,
+		BucketBoundaries: def.Buckets,
+		EnabledByDefault: true,
+		ComponentType:    string(domain.ComponentPlatform),
+		ComponentName:    a.component.name,
+		SourceLocation:   path,
+		Path:             path,
+		Stability:        string(stability(def)),
+	}
+}
+
+// stability maps component-base levels onto the catalog's; a metric with a
+// deprecated version is on its way out whatever class it had.
+func stability(def astparser.MetricDef) domain.StabilityLevel {
+	if def.DeprecatedVersion != "" {
+		return domain.StabilityDeprecated
+	}
+
+	switch def.StabilityLevel {
+	case "STABLE":
+		return domain.StabilityStable
+	case "BETA":
+		return domain.StabilityBeta
+	case "ALPHA":
+		return domain.StabilityAlpha
+	case "INTERNAL":
+		return domain.StabilityDevelopment
+	}
+	return ""
+}
+
+func inferInstrumentType(metricName string) string {
+	if strings.HasSuffix(metricName, "_total") {
+		return string(domain.InstrumentCounter)
+	}
+	return string(domain.InstrumentGauge)
+}
@@ -0,0 +1,151 @@
+package controlplane
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const schedulerMetrics = `package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/component-base/metrics"
+)
+
+const SchedulerSubsystem = "scheduler"
+
+var (
+	scheduleAttempts = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Subsystem:      SchedulerSubsystem,
+			Name:           "schedule_attempts_total",
+			Help:           "Number of attempts to schedule pods, by the result.",
+			StabilityLevel: metrics.STABLE,
+		}, []string{"result", "profile"})
+
+	pendingPods = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem: SchedulerSubsystem,
+			Name:      "pending_pods",
+			Help:      "Number of pending pods, by the queue type.",
+		}, []string{"queue"})
+
+	legacy = prometheus.NewGauge(prometheus.GaugeOpts{Name: "not_component_base", Help: "Skipped."})
+)
+`
+
+func writeSource(t *testing.T, repo, path, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(repo, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write source: %v", err)
+	}
+}
+
+func TestControlPlaneAdapter_Names(t *testing.T) {
+	tests := []struct {
+		adapter *Adapter
+		want    string
+	}{
+		{NewAPIServerAdapter("/tmp/cache"), "kubernetes-apiserver"},
+		{NewSchedulerAdapter("/tmp/cache"), "kubernetes-scheduler"},
+		{NewControllerManagerAdapter("/tmp/cache"), "kubernetes-controller-manager"},
+		{NewKubeletAdapter("/tmp/cache"), "kubernetes-kubelet"},
+	}
+
+	for _, tt := range tests {
+		if tt.adapter.Name() != tt.want {
+			t.Errorf("expected name %q, got %q", tt.want, tt.adapter.Name())
+		}
+	}
+}
+
+func TestControlPlaneAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewKubeletAdapter("/tmp/cache")
+}
+
+func TestControlPlaneAdapter_Extract_OnlyComponentBaseMetrics(t *testing.T) {
+	repo := t.TempDir()
+	writeSource(t, repo, "pkg/scheduler/metrics/metrics.go", schedulerMetrics)
+
+	metrics, err := NewSchedulerAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected the plain client_golang gauge to be skipped, got %d metrics", len(metrics))
+	}
+	for _, m := range metrics {
+		if m.Name == "not_component_base" {
+			t.Error("expected not_component_base to be skipped")
+		}
+		if m.ComponentName != "kube-scheduler" {
+			t.Errorf("expected component 'kube-scheduler', got %q", m.ComponentName)
+		}
+	}
+}
+
+func TestControlPlaneAdapter_Extract_StabilityDefaultsToAlpha(t *testing.T) {
+	repo := t.TempDir()
+	writeSource(t, repo, "pkg/scheduler/metrics/metrics.go", schedulerMetrics)
+
+	metrics, err := NewSchedulerAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	stabilities := make(map[string]string)
+	for _, m := range metrics {
+		stabilities[m.Name] = m.Stability
+	}
+	if stabilities["scheduler_schedule_attempts_total"] != "stable" {
+		t.Errorf("expected the declared STABLE level, got %q", stabilities["scheduler_schedule_attempts_total"])
+	}
+	if stabilities["scheduler_pending_pods"] != "alpha" {
+		t.Errorf("expected metrics without a level to be alpha, got %q", stabilities["scheduler_pending_pods"])
+	}
+}
+
+func TestControlPlaneAdapter_Extract_OnlyComponentDirs(t *testing.T) {
+	repo := t.TempDir()
+	writeSource(t, repo, "pkg/scheduler/metrics/metrics.go", schedulerMetrics)
+
+	metrics, err := NewKubeletAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if len(metrics) != 0 {
+		t.Errorf("expected the kubelet adapter to ignore scheduler metrics, got %d", len(metrics))
+	}
+}
+
+func TestStability(t *testing.T) {
+	tests := []struct {
+		def  astparser.MetricDef
+		want domain.StabilityLevel
+	}{
+		{astparser.MetricDef{StabilityLevel: "STABLE"}, domain.StabilityStable},
+		{astparser.MetricDef{StabilityLevel: "BETA"}, domain.StabilityBeta},
+		{astparser.MetricDef{StabilityLevel: "ALPHA"}, domain.StabilityAlpha},
+		{astparser.MetricDef{StabilityLevel: "INTERNAL"}, domain.StabilityDevelopment},
+		{astparser.MetricDef{StabilityLevel: "STABLE", DeprecatedVersion: "1.29.0"}, domain.StabilityDeprecated},
+	}
+
+	for _, tt := range tests {
+		if got := stability(tt.def); got != tt.want {
+			t.Errorf("stability(%+v) = %q, want %q", tt.def, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package coredns
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/coredns/coredns"
+
+// Plugins share the namespace through plugin.Namespace rather than literals
+var externalConstants = map[string]string{
+	"plugin.Namespace": "coredns",
+}
+
+// Directories holding metric definitions, relative to the repo root
+var metricDirs = []string{"plugin", "core"}
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "kubernetes-coredns",
+		Category:    domain.SourceKubernetes,
+		Description: "CoreDNS server and plugin metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"kubernetes", "dns"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "kubernetes-coredns"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceKubernetes
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	var files []astparser.FileMetrics
+	for _, dir := range metricDirs {
+		root := filepath.Join(result.RepoPath, dir)
+		if _, err := os.Stat(root); os.IsNotExist(err) {
+			continue
+		}
+
+		found, err := astparser.ParseTree(root, externalConstants)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, found...)
+	}
+
+	var metrics []*adapter.RawMetric
+	seen := make(map[string]bool)
+
+	for _, file := range files {
+		componentName := deriveComponentName(result.RepoPath, file.Path)
+
+		for _, def := range file.Metrics {
+			if seen[def.Name] {
+				continue
+			}
+			seen[def.Name] = true
+
+			instrumentType := def.Type
+			if instrumentType == "" {
+				instrumentType = inferInstrumentType(def.Name)
+			}
+
+			metrics = append(metrics, &adapter.RawMetric{
+				Name:             def.Name,
+				Description:      def.Help,
+				InstrumentType:   instrumentType,
+				Attributes:       labelsToAttributes(def.Labels),
+				BucketBoundaries: def.Buckets,
+				EnabledByDefault: true,
+				ComponentType:    string(domain.ComponentPlatform),
+				ComponentName:    componentName,
+				SourceLocation:   file.Path,
+				Path:             file.Path,
+			})
+		}
+	}
+
+	return metrics, nil
+}
+
+// deriveComponentName names metrics after the plugin that defines them, so
+// plugin/forward/metrics.go belongs to "forward"; core code uses its package.
+func deriveComponentName(repoPath, path string) string {
+	rel, err := filepath.Rel(repoPath, path)
+	if err != nil {
+		return filepath.Base(filepath.Dir(path))
+	}
+
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	if len(parts) >= 3 && parts[0] == "plugin" && parts[1] != "pkg" {
+		return parts[1]
+	}
+	return filepath.Base(filepath.Dir(path))
+}
+
+func inferInstrumentType(metricName string) string {
+	switch {
+	case strings.HasSuffix(metricName, "_total"):
+		return string(domain.InstrumentCounter)
+	default:
+		return string(domain.InstrumentGauge)
+	}
+}
+
+func labelsToAttributes(labels []string) []domain.Attribute {
+	attrs := make([]domain.Attribute, 0, len(labels))
+	for _, label := range labels {
+		attrs = append(attrs, domain.Attribute{
+			Name: label,
+			Type: "string",
+		})
+	}
+	return attrs
+}
@@ -0,0 +1,119 @@
+package coredns
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+func writeSource(t *testing.T, repo, path, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(repo, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write source: %v", err)
+	}
+}
+
+func TestCoreDNSAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "kubernetes-coredns" {
+		t.Errorf("expected name 'kubernetes-coredns', got %q", a.Name())
+	}
+}
+
+func TestCoreDNSAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestCoreDNSAdapter_Extract_ResolvesPluginNamespace(t *testing.T) {
+	repo := t.TempDir()
+	writeSource(t, repo, "plugin/cache/metrics.go", `package cache
+
+import (
+	"github.com/coredns/coredns/plugin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var cacheSize = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: plugin.Namespace,
+	Subsystem: "cache",
+	Name:      "entries",
+	Help:      "The number of elements in the cache.",
+}, []string{"server", "type", "zones", "view"})
+`)
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	m := metrics[0]
+	if m.Name != "coredns_cache_entries" {
+		t.Errorf("expected plugin.Namespace to resolve to coredns, got %q", m.Name)
+	}
+	if m.InstrumentType != "gauge" || len(m.Attributes) != 4 {
+		t.Errorf("expected a gauge with 4 labels, got %s with %v", m.InstrumentType, m.Attributes)
+	}
+}
+
+func TestCoreDNSAdapter_Extract_CoreDirectory(t *testing.T) {
+	repo := t.TempDir()
+	writeSource(t, repo, "core/dnsserver/metrics.go", `package dnsserver
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var requests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "coredns",
+	Subsystem: "dns",
+	Name:      "requests_total",
+	Help:      "Counter of DNS requests made per zone, protocol and family.",
+}, []string{"server", "zone"})
+`)
+	writeSource(t, repo, "test/metrics.go", `package test
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var ignored = prometheus.NewCounter(prometheus.CounterOpts{Name: "ignored_total", Help: "Outside plugin and core."})
+`)
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected only the core metric, got %d", len(metrics))
+	}
+	if metrics[0].ComponentName != "dnsserver" || metrics[0].InstrumentType != "counter" {
+		t.Errorf("expected a dnsserver counter, got %s (%s)", metrics[0].ComponentName, metrics[0].InstrumentType)
+	}
+}
+
+func TestDeriveComponentName(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/repo/plugin/forward/metrics.go", "forward"},
+		{"/repo/plugin/metrics/vars/vars.go", "metrics"},
+		{"/repo/plugin/pkg/proxy/metrics.go", "proxy"},
+		{"/repo/core/dnsserver/server.go", "dnsserver"},
+	}
+
+	for _, tt := range tests {
+		if got := deriveComponentName("/repo", tt.path); got != tt.want {
+			t.Errorf("deriveComponentName(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+func writeSource(t *testing.T, repo, path, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(repo, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write source: %v", err)
+	}
+}
+
+func TestEtcdAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "kubernetes-etcd" {
+		t.Errorf("expected name 'kubernetes-etcd', got %q", a.Name())
+	}
+}
+
+func TestEtcdAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestEtcdAdapter_Extract_SubsystemComponentAndBuckets(t *testing.T) {
+	repo := t.TempDir()
+	writeSource(t, repo, "server/storage/wal/metrics.go", `package wal
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var walFsyncSec = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Namespace: "etcd",
+	Subsystem: "disk",
+	Name:      "wal_fsync_duration_seconds",
+	Help:      "The latency distributions of fsync called by WAL.",
+	Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+})
+`)
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	m := metrics[0]
+	if m.Name != "etcd_disk_wal_fsync_duration_seconds" || m.InstrumentType != "histogram" {
+		t.Errorf("unexpected metric: %s (%s)", m.Name, m.InstrumentType)
+	}
+	if m.ComponentName != "wal" {
+		t.Errorf("expected component 'wal' from the package directory, got %q", m.ComponentName)
+	}
+	if len(m.BucketBoundaries) != 14 || m.BucketBoundaries[0] != 0.001 {
+		t.Errorf("expected 14 exponential buckets from 0.001, got %v", m.BucketBoundaries)
+	}
+}
+
+func TestEtcdAdapter_Extract_KeepsFirstDefinition(t *testing.T) {
+	repo := t.TempDir()
+	src := `package %s
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var hasLeader = prometheus.NewGauge(prometheus.GaugeOpts{
+	Namespace: "etcd",
+	Subsystem: "server",
+	Name:      "has_leader",
+	Help:      "Whether or not a leader exists.",
+})
+`
+	writeSource(t, repo, "server/etcdserver/metrics.go", fmt.Sprintf(src, "etcdserver"))
+	writeSource(t, repo, "server/proxy/metrics.go", fmt.Sprintf(src, "proxy"))
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected a metric declared twice to be cataloged once, got %d", len(metrics))
+	}
+}
+
+func TestEtcdAdapter_Extract_SkipsTestFiles(t *testing.T) {
+	repo := t.TempDir()
+	writeSource(t, repo, "server/mvcc/kvstore_test.go", `package mvcc
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var testCounter = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "etcd_test_total",
+	Help: "Only used in tests.",
+})
+`)
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if len(metrics) != 0 {
+		t.Errorf("expected 0 metrics (test files should be skipped), got %d", len(metrics))
+	}
+}
+
+func TestInferInstrumentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"etcd_server_proposals_failed_total", "counter"},
+		{"etcd_server_has_leader", "gauge"},
+	}
+
+	for _, tt := range tests {
+		if got := inferInstrumentType(tt.name); got != tt.expected {
+			t.Errorf("inferInstrumentType(%q) = %q, want %q", tt.name, got, tt.expected)
+		}
+	}
+}
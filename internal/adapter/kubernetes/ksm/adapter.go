@@ -6,9 +6,9 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const repoURL = "https://github.com/kubernetes/kube-state-metrics"
@@ -17,6 +17,19 @@ type Adapter struct {
 	fetcher *fetcher.GitFetcher
 }
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "kubernetes-ksm",
+		Category:    domain.SourceKubernetes,
+		Description: "kube-state-metrics object metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"kubernetes"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
 		fetcher: fetcher.NewGitFetcher(cacheDir),
@@ -4,8 +4,8 @@ import (
 	"context"
 	"testing"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 func TestAdapterName(t *testing.T) {
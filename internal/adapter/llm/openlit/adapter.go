@@ -7,9 +7,9 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const repoURL = "https://github.com/openlit/openlit"
@@ -18,6 +18,19 @@ type Adapter struct {
 	fetcher *fetcher.GitFetcher
 }
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "openlit",
+		Category:    domain.SourceVendor,
+		Description: "OpenLIT LLM instrumentation metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"llm", "python"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
 		fetcher: fetcher.NewGitFetcher(cacheDir),
@@ -0,0 +1,272 @@
+package envoy
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/envoyproxy/envoy"
+
+// scope says where a stats macro list is rooted in the stats tree. Dynamic
+// segments of the prefix (cluster name, listener address...) are removed by
+// Envoy's tag extraction rules and exported as labels instead.
+type scope struct {
+	component string
+	prefix    string
+	tags      []domain.Attribute
+	// Fixed segments that repeat the list, e.g. circuit breaker priorities
+	variants []string
+}
+
+var (
+	clusterName = domain.Attribute{
+		Name:        "envoy_cluster_name",
+		Type:        "string",
+		Description: "Upstream cluster name, extracted from cluster.<name>.",
+	}
+	listenerAddress = domain.Attribute{
+		Name:        "envoy_listener_address",
+		Type:        "string",
+		Description: "Listener address, extracted from listener.<address>.",
+	}
+	httpConnManagerPrefix = domain.Attribute{
+		Name:        "envoy_http_conn_manager_prefix",
+		Type:        "string",
+		Description: "HTTP connection manager stat_prefix, extracted from http.<stat_prefix>.",
+	}
+	tcpPrefix = domain.Attribute{
+		Name:        "envoy_tcp_prefix",
+		Type:        "string",
+		Description: "TCP proxy stat_prefix, extracted from tcp.<stat_prefix>.",
+	}
+)
+
+var scopes = map[string]scope{
+	"ALL_CLUSTER_TRAFFIC_STATS":               {component: "cluster", prefix: "cluster", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_CONFIG_UPDATE_STATS":         {component: "cluster", prefix: "cluster", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_LB_STATS":                    {component: "cluster", prefix: "cluster", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_ENDPOINT_STATS":              {component: "cluster", prefix: "cluster", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_LOAD_REPORT_STATS":           {component: "cluster", prefix: "cluster", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_REQUEST_RESPONSE_SIZE_STATS": {component: "cluster", prefix: "cluster", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_TIMEOUT_BUDGET_STATS":        {component: "cluster", prefix: "cluster", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_CIRCUIT_BREAKERS_STATS": {
+		component: "cluster", prefix: "cluster.circuit_breakers", tags: []domain.Attribute{clusterName},
+		variants: []string{"default", "high"},
+	},
+	"ALL_HEALTH_CHECKER_STATS":       {component: "cluster", prefix: "cluster.health_check", tags: []domain.Attribute{clusterName}},
+	"ALL_OUTLIER_DETECTION_STATS":    {component: "cluster", prefix: "cluster.outlier_detection", tags: []domain.Attribute{clusterName}},
+	"ALL_CLUSTER_MANAGER_STATS":      {component: "cluster_manager", prefix: "cluster_manager"},
+	"ALL_HTTP_CONN_MAN_STATS":        {component: "http_connection_manager", prefix: "http", tags: []domain.Attribute{httpConnManagerPrefix}},
+	"CONN_MAN_LISTENER_STATS":        {component: "http_connection_manager", prefix: "listener.http", tags: []domain.Attribute{listenerAddress, httpConnManagerPrefix}},
+	"ALL_LISTENER_STATS":             {component: "listener", prefix: "listener", tags: []domain.Attribute{listenerAddress}},
+	"ALL_LISTENER_MANAGER_STATS":     {component: "listener_manager", prefix: "listener_manager"},
+	"ALL_TCP_PROXY_STATS":            {component: "tcp_proxy", prefix: "tcp", tags: []domain.Attribute{tcpPrefix}},
+	"ALL_SERVER_STATS":               {component: "server", prefix: "server"},
+	"ALL_RUNTIME_STATS":              {component: "runtime", prefix: "runtime"},
+	"ALL_CONTROL_PLANE_STATS":        {component: "control_plane", prefix: "control_plane"},
+	"ALL_FILESYSTEM_STATS":           {component: "filesystem", prefix: "filesystem"},
+	"ALL_MAIN_THREAD_WATCHDOG_STATS": {component: "server", prefix: "main_thread.watchdog"},
+}
+
+// Envoy histogram units as exported
+var histogramUnits = map[string]string{
+	"Milliseconds": "ms",
+	"Microseconds": "us",
+	"Bytes":        "By",
+	"Percent":      "%",
+}
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "mesh-envoy",
+		Category:    domain.SourcePrometheus,
+		Description: "Envoy proxy stats macros",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"mesh", "proxy"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "mesh-envoy"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	macros := make(map[string]StatsMacro)
+	locations := make(map[string]string)
+
+	for _, dir := range []string{"envoy", "source"} {
+		err := walkFiles(filepath.Join(result.RepoPath, dir), ".h", func(path string) {
+			parsed, err := ParseHeader(path)
+			if err != nil {
+				return
+			}
+			for _, m := range parsed {
+				if _, ok := macros[m.Name]; !ok {
+					macros[m.Name] = m
+					locations[m.Name] = path
+				}
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	descriptions := make(map[string]string)
+	_ = walkFiles(filepath.Join(result.RepoPath, "docs", "root", "configuration"), ".rst", func(path string) {
+		_ = ParseStatsDocs(path, descriptions)
+	})
+
+	var metrics []*adapter.RawMetric
+	seen := make(map[string]bool)
+
+	macroNames := make([]string, 0, len(scopes))
+	for name := range scopes {
+		macroNames = append(macroNames, name)
+	}
+	sort.Strings(macroNames)
+
+	for _, macroName := range macroNames {
+		sc := scopes[macroName]
+		if _, ok := macros[macroName]; !ok {
+			continue
+		}
+		path := locations[macroName]
+
+		prefixes := []string{sc.prefix}
+		if len(sc.variants) > 0 {
+			prefixes = prefixes[:0]
+			for _, v := range sc.variants {
+				prefixes = append(prefixes, sc.prefix+"."+v)
+			}
+		}
+
+		for _, stat := range Expand(macroName, macros) {
+			for _, prefix := range prefixes {
+				name := PrometheusName(prefix, stat.Name)
+				if seen[name] {
+					continue
+				}
+				seen[name] = true
+
+				metrics = append(metrics, &adapter.RawMetric{
+					Name:             name,
+					Description:      descriptions[StatKey(sc.prefix, stat.Name)],
+					InstrumentType:   instrumentType(stat.Kind),
+					Unit:             statUnit(stat),
+					Attributes:       append([]domain.Attribute(nil), sc.tags...),
+					EnabledByDefault: true,
+					ComponentType:    string(domain.ComponentPlatform),
+					ComponentName:    sc.component,
+					SourceLocation:   macroName,
+					Path:             path,
+				})
+			}
+		}
+	}
+
+	return metrics, nil
+}
+
+// PrometheusName renders a stat the way Envoy's /stats/prometheus does once
+// tag extraction has stripped the dynamic segments.
+func PrometheusName(prefix, stat string) string {
+	name := "envoy_" + prefix + "_" + stat
+	return strings.NewReplacer(".", "_", "-", "_").Replace(name)
+}
+
+func instrumentType(kind string) string {
+	switch kind {
+	case "COUNTER":
+		return string(domain.InstrumentCounter)
+	case "HISTOGRAM":
+		return string(domain.InstrumentHistogram)
+	default:
+		return string(domain.InstrumentGauge)
+	}
+}
+
+func statUnit(stat StatDef) string {
+	if stat.Kind != "HISTOGRAM" {
+		return ""
+	}
+	return histogramUnits[stat.Qualifier]
+}
+
+func walkFiles(root, ext string, fn func(path string)) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == root {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if path != root && (d.Name() == "test" || strings.HasPrefix(d.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if strings.HasSuffix(path, ext) {
+			fn(path)
+		}
+		return nil
+	})
+}
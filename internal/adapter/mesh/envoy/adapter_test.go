@@ -0,0 +1,177 @@
+package envoy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+func writeRepoFile(t *testing.T, repo, path, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(repo, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func extract(t *testing.T, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestEnvoyAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "mesh-envoy" {
+		t.Errorf("expected name 'mesh-envoy', got %q", a.Name())
+	}
+}
+
+func TestEnvoyAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestEnvoyAdapter_Extract_ScopeTags(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "envoy/upstream/upstream.h", statsHeader)
+
+	metrics := extract(t, repo)
+
+	total := metrics["envoy_cluster_upstream_cx_total"]
+	if total == nil {
+		t.Fatal("expected envoy_cluster_upstream_cx_total")
+	}
+	if len(total.Attributes) != 1 || total.Attributes[0].Name != "envoy_cluster_name" {
+		t.Errorf("expected the cluster name tag, got %+v", total.Attributes)
+	}
+	if total.ComponentName != "cluster" {
+		t.Errorf("expected component 'cluster', got %q", total.ComponentName)
+	}
+
+	manager := metrics["envoy_cluster_manager_active_clusters"]
+	if manager == nil || len(manager.Attributes) != 0 {
+		t.Errorf("expected an untagged cluster manager gauge, got %+v", manager)
+	}
+}
+
+func TestEnvoyAdapter_Extract_NestedMacros(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "envoy/upstream/upstream.h", statsHeader)
+
+	metrics := extract(t, repo)
+
+	if retry := metrics["envoy_cluster_upstream_rq_retry"]; retry == nil || retry.InstrumentType != "counter" {
+		t.Errorf("expected the included macro's counter, got %+v", retry)
+	}
+}
+
+func TestEnvoyAdapter_Extract_HistogramUnits(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "envoy/upstream/upstream.h", statsHeader)
+
+	metrics := extract(t, repo)
+
+	connect := metrics["envoy_cluster_upstream_cx_connect_ms"]
+	if connect == nil || connect.InstrumentType != "histogram" || connect.Unit != "ms" {
+		t.Errorf("expected a ms histogram, got %+v", connect)
+	}
+	if active := metrics["envoy_cluster_upstream_cx_active"]; active == nil || active.Unit != "" {
+		t.Errorf("expected gauges to carry no unit, got %+v", active)
+	}
+}
+
+func TestEnvoyAdapter_Extract_PriorityVariants(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "envoy/upstream/upstream.h", `#define ALL_CLUSTER_CIRCUIT_BREAKERS_STATS(COUNTER, GAUGE, HISTOGRAM, TEXT_READOUT, STATNAME) \
+  GAUGE(cx_open, Accumulate)
+`)
+
+	metrics := extract(t, repo)
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected one gauge per priority, got %d", len(metrics))
+	}
+	for _, name := range []string{"envoy_cluster_circuit_breakers_default_cx_open", "envoy_cluster_circuit_breakers_high_cx_open"} {
+		if metrics[name] == nil {
+			t.Errorf("expected %s", name)
+		}
+	}
+}
+
+func TestEnvoyAdapter_Extract_DocsDescriptions(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "envoy/upstream/upstream.h", statsHeader)
+	writeRepoFile(t, repo, "docs/root/configuration/upstream/cluster_manager/cluster_stats.rst", `Every cluster has a statistics tree rooted at *cluster.<name>.*:
+
+.. csv-table::
+  :header: Name, Type, Description
+
+  upstream_cx_total, Counter, Total connections
+`)
+
+	metrics := extract(t, repo)
+
+	if got := metrics["envoy_cluster_upstream_cx_total"].Description; got != "Total connections" {
+		t.Errorf("expected the documented description, got %q", got)
+	}
+}
+
+func TestEnvoyAdapter_Extract_DocsDescriptionsByScope(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "envoy/upstream/upstream.h", statsHeader)
+	writeRepoFile(t, repo, "docs/root/configuration/upstream/cluster_manager/cluster_manager_stats.rst", `The cluster manager has a statistics tree rooted at *cluster_manager.*:
+
+.. csv-table::
+  :header: Name, Type, Description
+
+  active_clusters, Gauge, Number of currently active clusters
+`)
+	writeRepoFile(t, repo, "docs/root/configuration/listeners/stats.rst", `Listeners have a statistics tree rooted at *listener.<address>.*:
+
+.. csv-table::
+  :header: Name, Type, Description
+
+  active_clusters, Gauge, Not a cluster manager stat
+  upstream_cx_total, Counter, Not a cluster stat
+`)
+
+	metrics := extract(t, repo)
+
+	if got := metrics["envoy_cluster_manager_active_clusters"].Description; got != "Number of currently active clusters" {
+		t.Errorf("expected the cluster manager's description, got %q", got)
+	}
+	if got := metrics["envoy_cluster_upstream_cx_total"].Description; got != "" {
+		t.Errorf("expected no description from another scope, got %q", got)
+	}
+}
+
+func TestEnvoyAdapter_Extract_SkipsTestDirectories(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "envoy/upstream/test/upstream.h", statsHeader)
+
+	if metrics := extract(t, repo); len(metrics) != 0 {
+		t.Errorf("expected 0 metrics (test directories should be skipped), got %d", len(metrics))
+	}
+}
+
+func TestPrometheusName(t *testing.T) {
+	if got := PrometheusName("cluster.circuit_breakers.default", "cx_open"); got != "envoy_cluster_circuit_breakers_default_cx_open" {
+		t.Errorf("unexpected name %s", got)
+	}
+}
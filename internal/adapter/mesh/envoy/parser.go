@@ -0,0 +1,156 @@
+package envoy
+
+import (
+	"encoding/csv"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// StatDef is one entry in an Envoy stats macro list.
+type StatDef struct {
+	Kind string // COUNTER, GAUGE, HISTOGRAM
+	Name string
+	// Unit for histograms (Milliseconds, Bytes...), import mode for gauges
+	Qualifier string
+}
+
+// StatsMacro is a `#define ALL_X_STATS(COUNTER, GAUGE, HISTOGRAM, ...)` list.
+type StatsMacro struct {
+	Name     string
+	Stats    []StatDef
+	Includes []string
+}
+
+var (
+	defineRe  = regexp.MustCompile(`^#define\s+([A-Z][A-Z0-9_]*)\(([^)]*)\)\s*(.*)$`)
+	statRe    = regexp.MustCompile(`\b(COUNTER|GAUGE|HISTOGRAM)\(\s*(\w+)\s*(?:,\s*(\w+)\s*)?\)`)
+	includeRe = regexp.MustCompile(`\b([A-Z][A-Z0-9_]*)\(\s*COUNTER\b`)
+	rootedRe  = regexp.MustCompile(`rooted at \*([^*]+)\*`)
+)
+
+// ParseHeader returns the stats macro lists defined in a C++ header.
+func ParseHeader(path string) ([]StatsMacro, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Reading headers from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+	return ParseSource(string(data)), nil
+}
+
+func ParseSource(src string) []StatsMacro {
+	// Macro bodies span lines joined by trailing backslashes
+	src = strings.ReplaceAll(src, "\\\r\n", " ")
+	src = strings.ReplaceAll(src, "\\\n", " ")
+
+	var macros []StatsMacro
+	for _, line := range strings.Split(src, "\n") {
+		m := defineRe.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil || !strings.Contains(m[2], "COUNTER") {
+			continue
+		}
+
+		macro := StatsMacro{Name: m[1]}
+		body := m[3]
+		for _, s := range statRe.FindAllStringSubmatch(body, -1) {
+			macro.Stats = append(macro.Stats, StatDef{Kind: s[1], Name: s[2], Qualifier: s[3]})
+		}
+		for _, inc := range includeRe.FindAllStringSubmatch(body, -1) {
+			macro.Includes = append(macro.Includes, inc[1])
+		}
+
+		macros = append(macros, macro)
+	}
+
+	return macros
+}
+
+// Expand returns a macro's stats including those of the lists it pulls in.
+func Expand(name string, macros map[string]StatsMacro) []StatDef {
+	return expand(name, macros, make(map[string]bool))
+}
+
+func expand(name string, macros map[string]StatsMacro, visiting map[string]bool) []StatDef {
+	macro, ok := macros[name]
+	if !ok || visiting[name] {
+		return nil
+	}
+	visiting[name] = true
+
+	stats := append([]StatDef(nil), macro.Stats...)
+	for _, inc := range macro.Includes {
+		stats = append(stats, expand(inc, macros, visiting)...)
+	}
+	return stats
+}
+
+// ParseStatsDocs collects stat descriptions from the csv-tables in Envoy's
+// reStructuredText docs, whose rows read "name, Type, Description". Stat
+// names repeat across scopes, so each table is keyed by the tree the text
+// before it says the stats are "rooted at", e.g. *cluster.<name>.*, and
+// rows of a table with no stated root are skipped.
+func ParseStatsDocs(path string, into map[string]string) error {
+	data, err := os.ReadFile(path) //nolint:gosec // Reading docs from cloned repos is intentional
+	if err != nil {
+		return err
+	}
+
+	root := ""
+	inTable := false
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if m := rootedRe.FindStringSubmatch(line); m != nil {
+			root = DocScope(m[1])
+		}
+		if strings.HasPrefix(trimmed, ".. csv-table::") {
+			inTable = true
+			continue
+		}
+		if !inTable {
+			continue
+		}
+		// Tables end at the first unindented line
+		if trimmed != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			inTable = false
+			continue
+		}
+		if trimmed == "" || strings.HasPrefix(trimmed, ":") || root == "" {
+			continue
+		}
+
+		r := csv.NewReader(strings.NewReader(trimmed))
+		r.LazyQuotes = true
+		r.TrimLeadingSpace = true
+		record, err := r.Read()
+		if err != nil || len(record) < 3 {
+			continue
+		}
+
+		name := strings.TrimSpace(record[0])
+		key := StatKey(root, name)
+		if _, ok := into[key]; !ok && name != "" {
+			into[key] = strings.TrimSpace(strings.Join(record[2:], ", "))
+		}
+	}
+
+	return nil
+}
+
+// DocScope reduces a documented stats root such as cluster.<name>.outlier_detection.
+// to the prefix left once tag extraction strips its dynamic segments.
+func DocScope(root string) string {
+	var parts []string
+	for _, part := range strings.Split(root, ".") {
+		if part == "" || strings.HasPrefix(part, "<") {
+			continue
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// StatKey identifies a stat within its scope, e.g. cluster.upstream_cx_total.
+func StatKey(scope, stat string) string {
+	return scope + "." + stat
+}
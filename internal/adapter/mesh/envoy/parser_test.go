@@ -0,0 +1,158 @@
+package envoy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const statsHeader = `#pragma once
+
+/**
+ * All cluster manager stats. @see stats_macros.h
+ */
+#define ALL_CLUSTER_MANAGER_STATS(COUNTER, GAUGE)                                                  \
+  COUNTER(cluster_added)                                                                           \
+  COUNTER(cluster_modified)                                                                        \
+  GAUGE(active_clusters, NeverImport)
+
+#define ALL_CLUSTER_TRAFFIC_STATS(COUNTER, GAUGE, HISTOGRAM, TEXT_READOUT, STATNAME)               \
+  COUNTER(upstream_cx_total)                                                                       \
+  GAUGE(upstream_cx_active, Accumulate)                                                            \
+  HISTOGRAM(upstream_cx_connect_ms, Milliseconds)                                                  \
+  ALL_CLUSTER_EXTRA_STATS(COUNTER, GAUGE)
+
+#define ALL_CLUSTER_EXTRA_STATS(COUNTER, GAUGE) COUNTER(upstream_rq_retry)
+
+#define MAX_RETRIES(x) (x + 1)
+`
+
+func TestParseSource(t *testing.T) {
+	macros := ParseSource(statsHeader)
+	if len(macros) != 3 {
+		t.Fatalf("expected 3 stats macros, got %d", len(macros))
+	}
+
+	byName := make(map[string]StatsMacro)
+	for _, m := range macros {
+		byName[m.Name] = m
+	}
+
+	cm := byName["ALL_CLUSTER_MANAGER_STATS"]
+	if len(cm.Stats) != 3 {
+		t.Fatalf("expected 3 cluster manager stats, got %+v", cm.Stats)
+	}
+	if cm.Stats[2] != (StatDef{Kind: "GAUGE", Name: "active_clusters", Qualifier: "NeverImport"}) {
+		t.Errorf("unexpected gauge: %+v", cm.Stats[2])
+	}
+
+	traffic := byName["ALL_CLUSTER_TRAFFIC_STATS"]
+	if len(traffic.Includes) != 1 || traffic.Includes[0] != "ALL_CLUSTER_EXTRA_STATS" {
+		t.Errorf("expected nested stats list, got %v", traffic.Includes)
+	}
+}
+
+func TestExpand(t *testing.T) {
+	macros := make(map[string]StatsMacro)
+	for _, m := range ParseSource(statsHeader) {
+		macros[m.Name] = m
+	}
+
+	stats := Expand("ALL_CLUSTER_TRAFFIC_STATS", macros)
+	if len(stats) != 4 {
+		t.Fatalf("expected 4 stats, got %+v", stats)
+	}
+	if stats[3].Name != "upstream_rq_retry" {
+		t.Errorf("expected nested stat last, got %s", stats[3].Name)
+	}
+
+	// Self-referencing lists must not recurse forever
+	macros["LOOP"] = StatsMacro{Name: "LOOP", Stats: []StatDef{{Kind: "COUNTER", Name: "x"}}, Includes: []string{"LOOP"}}
+	if got := Expand("LOOP", macros); len(got) != 1 {
+		t.Errorf("expected 1 stat from cyclic list, got %d", len(got))
+	}
+}
+
+func TestParseStatsDocs(t *testing.T) {
+	doc := `Statistics
+----------
+
+Every cluster has a statistics tree rooted at *cluster.<name>.* with the following statistics:
+
+.. csv-table::
+  :header: Name, Type, Description
+  :widths: 1, 1, 2
+
+  upstream_cx_total, Counter, Total connections
+  upstream_cx_connect_ms, Histogram, "Connection establishment milliseconds, including TLS"
+
+Other text
+
+The health checker has statistics rooted at *cluster.<name>.health_check.*:
+
+.. csv-table::
+  :header: Name, Type, Description
+
+  attempt, Counter, Number of health checks
+`
+	path := filepath.Join(t.TempDir(), "stats.rst")
+	if err := os.WriteFile(path, []byte(doc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	descriptions := make(map[string]string)
+	if err := ParseStatsDocs(path, descriptions); err != nil {
+		t.Fatal(err)
+	}
+
+	if descriptions["cluster.upstream_cx_total"] != "Total connections" {
+		t.Errorf("unexpected description: %q", descriptions["cluster.upstream_cx_total"])
+	}
+	if descriptions["cluster.upstream_cx_connect_ms"] != "Connection establishment milliseconds, including TLS" {
+		t.Errorf("unexpected quoted description: %q", descriptions["cluster.upstream_cx_connect_ms"])
+	}
+	if descriptions["cluster.health_check.attempt"] != "Number of health checks" {
+		t.Errorf("expected the health check scope, got %v", descriptions)
+	}
+	if _, ok := descriptions["cluster.Name"]; ok {
+		t.Error("header row should be skipped")
+	}
+}
+
+func TestParseStatsDocs_SkipsTablesWithoutRoot(t *testing.T) {
+	doc := `.. csv-table::
+  :header: Name, Type, Description
+
+  upstream_cx_total, Counter, Total connections
+`
+	path := filepath.Join(t.TempDir(), "stats.rst")
+	if err := os.WriteFile(path, []byte(doc), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	descriptions := make(map[string]string)
+	if err := ParseStatsDocs(path, descriptions); err != nil {
+		t.Fatal(err)
+	}
+	if len(descriptions) != 0 {
+		t.Errorf("expected rows without a stats root to be skipped, got %v", descriptions)
+	}
+}
+
+func TestDocScope(t *testing.T) {
+	tests := []struct {
+		root     string
+		expected string
+	}{
+		{"cluster.<name>.", "cluster"},
+		{"cluster.<name>.circuit_breakers.<priority>.", "cluster.circuit_breakers"},
+		{"listener.<address>.http.<stat_prefix>.", "listener.http"},
+		{"cluster_manager.", "cluster_manager"},
+	}
+
+	for _, tt := range tests {
+		if got := DocScope(tt.root); got != tt.expected {
+			t.Errorf("DocScope(%q) = %q, expected %q", tt.root, got, tt.expected)
+		}
+	}
+}
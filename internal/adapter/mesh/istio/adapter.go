@@ -0,0 +1,99 @@
+package istio
+
+import (
+	"context"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Adapter serves the embedded metrics.yaml. Its version is reported as the
+// commit, so re-extracting an unchanged table records the same snapshot.
+type Adapter struct {
+	table *Table
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "mesh-istio",
+		Category:    domain.SourcePrometheus,
+		Description: "Istio standard metrics documentation",
+		Schedule:    adapter.ScheduleWeekly,
+		Tags:        []string{"mesh"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+// NewAdapter returns the adapter for the embedded table. It panics if the
+// table is invalid, which the package tests rule out.
+func NewAdapter(_ string) *Adapter {
+	t, err := Load()
+	if err != nil {
+		panic(err)
+	}
+	return &Adapter{table: t}
+}
+
+func (a *Adapter) Name() string {
+	return "mesh-istio"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceDocumented
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionScrape
+}
+
+func (a *Adapter) RepoURL() string {
+	return a.table.Source
+}
+
+func (a *Adapter) Fetch(_ context.Context, _ adapter.FetchOptions) (*adapter.FetchResult, error) {
+	ts, err := time.Parse(VersionLayout, a.table.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &adapter.FetchResult{
+		Commit:    a.table.Version,
+		Timestamp: ts,
+	}, nil
+}
+
+func (a *Adapter) Extract(_ context.Context, _ *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	metrics := make([]*adapter.RawMetric, 0, len(a.table.Metrics))
+	for _, m := range a.table.Metrics {
+		var attrs []domain.Attribute
+		for _, group := range m.Labels {
+			for _, l := range a.table.Labels[group] {
+				attrs = append(attrs, domain.Attribute{
+					Name:        l.Name,
+					Type:        "string",
+					Description: l.Description,
+					Enum:        l.Enum,
+				})
+			}
+		}
+
+		metrics = append(metrics, &adapter.RawMetric{
+			Name:             m.Name,
+			Description:      m.Description,
+			Unit:             m.Unit,
+			InstrumentType:   m.Type,
+			Attributes:       attrs,
+			EnabledByDefault: true,
+			ComponentType:    string(domain.ComponentPlatform),
+			ComponentName:    "istio",
+			SourceLocation:   "istio-proxy",
+		})
+	}
+	return metrics, nil
+}
@@ -0,0 +1,127 @@
+package istio
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func extract(t *testing.T) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	a := NewAdapter("/tmp/cache")
+	fetchResult, err := a.Fetch(context.Background(), adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	metrics, err := a.Extract(context.Background(), fetchResult)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func hasLabel(m *adapter.RawMetric, name string) bool {
+	for _, attr := range m.Attributes {
+		if attr.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestIstioAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "mesh-istio" {
+		t.Errorf("expected name 'mesh-istio', got %q", a.Name())
+	}
+}
+
+func TestIstioAdapter_Confidence(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Confidence() != domain.ConfidenceDocumented {
+		t.Errorf("expected confidence 'documented', got %q", a.Confidence())
+	}
+}
+
+func TestIstioAdapter_RepoURL(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.RepoURL() != "https://istio.io/latest/docs/reference/config/metrics/" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestIstioAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestIstioAdapter_Fetch_ReportsTableVersion(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+
+	first, err := a.Fetch(context.Background(), adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	if first.Commit != a.table.Version {
+		t.Errorf("expected the table version as commit, got %q", first.Commit)
+	}
+	if first.Timestamp.Format(time.DateOnly) != a.table.Version {
+		t.Errorf("expected the version date as timestamp, got %v", first.Timestamp)
+	}
+
+	second, err := a.Fetch(context.Background(), adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	if second.Commit != first.Commit || !second.Timestamp.Equal(first.Timestamp) {
+		t.Error("expected an unchanged table to report the same snapshot")
+	}
+}
+
+func TestIstioAdapter_Extract_StandardMetrics(t *testing.T) {
+	metrics := extract(t)
+	if len(metrics) != 10 {
+		t.Errorf("expected 10 metrics, got %d", len(metrics))
+	}
+
+	for name, m := range metrics {
+		if m.ComponentType != string(domain.ComponentPlatform) || m.ComponentName != "istio" {
+			t.Errorf("unexpected component for %s: %s/%s", name, m.ComponentType, m.ComponentName)
+		}
+	}
+}
+
+func TestIstioAdapter_Extract_RequestLabelsOnlyOnHTTP(t *testing.T) {
+	metrics := extract(t)
+
+	requests := metrics["istio_requests_total"]
+	if requests == nil || !hasLabel(requests, "response_code") || !hasLabel(requests, "destination_service") {
+		t.Errorf("expected request labels on istio_requests_total, got %+v", requests)
+	}
+
+	opened := metrics["istio_tcp_connections_opened_total"]
+	if opened == nil || hasLabel(opened, "response_code") || !hasLabel(opened, "reporter") {
+		t.Errorf("expected only standard labels on TCP metrics, got %+v", opened)
+	}
+}
+
+func TestIstioAdapter_Extract_LabelEnums(t *testing.T) {
+	requests := extract(t)["istio_requests_total"]
+	if requests == nil {
+		t.Fatal("expected istio_requests_total")
+	}
+
+	for _, attr := range requests.Attributes {
+		if attr.Name == "reporter" && len(attr.Enum) != 3 {
+			t.Errorf("expected three reporter values, got %v", attr.Enum)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package istio
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+//go:embed metrics.yaml
+var metricsYAML []byte
+
+// VersionLayout is the date format of the table's version.
+const VersionLayout = "2006-01-02"
+
+// Table is the standard metrics reference on istio.io. Version is the date
+// the page was captured and stands in for a commit.
+type Table struct {
+	Version string `yaml:"version"`
+	Source  string `yaml:"source"`
+	// Labels groups the label sets metrics refer to by name, e.g. the
+	// standard labels every metric carries
+	Labels  map[string][]Label `yaml:"labels"`
+	Metrics []Metric           `yaml:"metrics"`
+}
+
+// Label is a metric label the proxy attaches.
+type Label struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description,omitempty"`
+	Enum        []string `yaml:"enum,omitempty"`
+}
+
+// Metric is one row of the table. Labels names the label groups it carries.
+type Metric struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description,omitempty"`
+	Unit        string   `yaml:"unit,omitempty"`
+	Type        string   `yaml:"type"`
+	Labels      []string `yaml:"labels,omitempty"`
+}
+
+// Load reads the embedded table.
+func Load() (*Table, error) {
+	t, err := Parse(metricsYAML)
+	if err != nil {
+		return nil, fmt.Errorf("istio metrics table: %w", err)
+	}
+	return t, nil
+}
+
+// Parse decodes and validates a table.
+func Parse(content []byte) (*Table, error) {
+	var t Table
+	if err := yaml.Unmarshal(content, &t); err != nil {
+		return nil, err
+	}
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Validate checks the version and that every metric has a name, a known
+// instrument type and only label groups the table defines.
+func (t *Table) Validate() error {
+	if _, err := time.Parse(VersionLayout, t.Version); err != nil {
+		return fmt.Errorf("version %q is not a YYYY-MM-DD date", t.Version)
+	}
+	if t.Source == "" {
+		return errors.New("source is required")
+	}
+
+	seen := make(map[string]bool, len(t.Metrics))
+	for i, m := range t.Metrics {
+		if m.Name == "" {
+			return fmt.Errorf("metric %d has no name", i)
+		}
+		if seen[m.Name] {
+			return fmt.Errorf("duplicate metric %s", m.Name)
+		}
+		seen[m.Name] = true
+		if !domain.InstrumentType(m.Type).IsValid() {
+			return fmt.Errorf("metric %s: invalid type %q", m.Name, m.Type)
+		}
+		for _, group := range m.Labels {
+			if _, ok := t.Labels[group]; !ok {
+				return fmt.Errorf("metric %s: unknown label group %q", m.Name, group)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package istio
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	table, err := Load()
+	if err != nil {
+		t.Fatalf("embedded table is invalid: %v", err)
+	}
+	if len(table.Labels["standard"]) == 0 {
+		t.Error("expected the standard label group")
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		err     string
+	}{
+		{
+			name:    "version",
+			content: "version: latest\nsource: x\n",
+			err:     "YYYY-MM-DD",
+		},
+		{
+			name:    "type",
+			content: "version: \"2026-10-18\"\nsource: x\nmetrics:\n  - name: a\n    type: meter\n",
+			err:     "invalid type",
+		},
+		{
+			name:    "label group",
+			content: "version: \"2026-10-18\"\nsource: x\nmetrics:\n  - name: a\n    type: counter\n    labels: [missing]\n",
+			err:     "unknown label group",
+		},
+		{
+			name:    "duplicate",
+			content: "version: \"2026-10-18\"\nsource: x\nmetrics:\n  - name: a\n    type: counter\n  - name: a\n    type: counter\n",
+			err:     "duplicate metric",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("expected error containing %q, got %v", tt.err, err)
+			}
+		})
+	}
+}
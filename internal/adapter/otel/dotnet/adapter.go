@@ -6,9 +6,9 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const repoURL = "https://github.com/open-telemetry/opentelemetry-dotnet-contrib"
@@ -17,6 +17,19 @@ type Adapter struct {
 	fetcher *fetcher.GitFetcher
 }
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "otel-dotnet",
+		Category:    domain.SourceOTEL,
+		Description: "OpenTelemetry .NET contrib instrumentations",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"otel", "dotnet", "instrumentation"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
 		fetcher: fetcher.NewGitFetcher(cacheDir),
@@ -106,6 +119,9 @@ func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*
 				Description:      def.Description,
 				Unit:             def.Unit,
 				InstrumentType:   def.InstrumentType,
+				Attributes:       adapter.InferredAttributes(def.Attributes, nil),
+				BucketBoundaries: def.Buckets,
+				ValueType:        def.ValueType,
 				EnabledByDefault: true,
 				ComponentType:    string(domain.ComponentInstrumentation),
 				ComponentName:    componentName,
@@ -163,7 +179,10 @@ func deduplicateMetrics(metrics []*adapter.RawMetric) []*adapter.RawMetric {
 		if existing, ok := seen[key]; ok {
 			// Keep the one with more complete info
 			if m.Description != "" && existing.Description == "" {
+				m.Attributes = adapter.MergeAttributes(m.Attributes, existing.Attributes)
 				seen[key] = m
+			} else {
+				existing.Attributes = adapter.MergeAttributes(existing.Attributes, m.Attributes)
 			}
 		} else {
 			seen[key] = m
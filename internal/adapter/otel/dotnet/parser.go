@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/base-14/metric-library/pkg/adapter"
 )
 
 type MetricDef struct {
@@ -12,6 +14,9 @@ type MetricDef struct {
 	InstrumentType string
 	Unit           string
 	Description    string
+	Attributes     []string
+	ValueType      string
+	Buckets        []float64
 }
 
 var methodToType = map[string]string{
@@ -37,6 +42,22 @@ var (
 
 	// Match constant definitions for metric names
 	constPattern = regexp.MustCompile(`(?:const|static\s+readonly)\s+string\s+(\w+)\s*=\s*"([^"]+)"`)
+
+	// Match tag keys: new KeyValuePair<string, object?>("key", ...), new("key", ...),
+	// tags.Add("key", ...) and { "key", ... } collection initializers (TagList)
+	keyValuePairPattern   = regexp.MustCompile(`KeyValuePair\s*<\s*string\s*,\s*object\??\s*>\s*\(\s*("[^"]+"|[\w.]+)\s*,`)
+	targetTypedNewPattern = regexp.MustCompile(`\bnew\s*\(\s*("[^"]+"|[\w.]+)\s*,`)
+	tagAddPattern         = regexp.MustCompile(`\.Add\s*\(\s*("[^"]+"|[A-Za-z_][\w.]*)\s*,`)
+	initializerPattern    = regexp.MustCompile(`\{\s*("[^"]+"|[A-Za-z_][\w.]*)\s*,`)
+
+	// Match the generic argument right after the method name: <long>, <double>
+	genericTypePattern = regexp.MustCompile(`^<\s*(\w+)\??\s*>`)
+
+	// Match InstrumentAdvice { HistogramBucketBoundaries = [ ... ] } (array or collection expression)
+	bucketBoundariesPattern = regexp.MustCompile(`HistogramBucketBoundaries\s*=\s*(?:new\s*(?:double)?\s*\[\s*\]\s*)?[\[{]([^\]}]*)[\]}]`)
+
+	// Match the field or variable an instrument is assigned to
+	assignmentPattern = regexp.MustCompile(`(\w+)\s*=\s*$`)
 )
 
 func ParseFile(path string) ([]*MetricDef, error) {
@@ -90,11 +111,24 @@ func parseContent(content string) ([]*MetricDef, error) {
 		// Extract unit
 		unit := extractStringFromPattern(callContent, unitPattern)
 
+		var attributes []string
+		if variable := adapter.AssignedVariable(assignmentPattern, content, match[0]); variable != "" {
+			attributes = usageAttributeKeys(content, variable, constants)
+		}
+
+		var buckets []float64
+		if boundaries := bucketBoundariesPattern.FindStringSubmatch(callContent); boundaries != nil {
+			buckets = adapter.ParseNumberList(boundaries[1], trimNumberSuffix)
+		}
+
 		metrics = append(metrics, &MetricDef{
 			Name:           metricName,
 			InstrumentType: instrumentType,
 			Unit:           unit,
 			Description:    description,
+			Attributes:     attributes,
+			ValueType:      genericValueType(content[match[3]:]),
+			Buckets:        buckets,
 		})
 	}
 
@@ -168,3 +202,117 @@ func extractStringFromPattern(content string, pattern *regexp.Regexp) string {
 	}
 	return ""
 }
+
+// usageAttributeKeys collects tag keys passed to Add()/Record() calls on the
+// instrument, following TagList locals passed by name.
+func usageAttributeKeys(content, variable string, constants map[string]string) []string {
+	usagePattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(variable) + `\s*\.\s*(?:Add|Record)\s*\(`)
+
+	var keys []string
+	for _, loc := range usagePattern.FindAllStringIndex(content, -1) {
+		end := findCallEnd(content, loc[1])
+		if end == -1 {
+			continue
+		}
+		args := content[loc[1]:end]
+		keys = adapter.MergeKeys(keys, extractTagKeys(args, constants))
+
+		for _, ident := range identifierPattern.FindAllString(args, -1) {
+			keys = adapter.MergeKeys(keys, tagListKeys(content, ident, constants))
+		}
+	}
+	return keys
+}
+
+var identifierPattern = regexp.MustCompile(`\b[a-z_]\w*\b`)
+
+func tagListKeys(content, ident string, constants map[string]string) []string {
+	var keys []string
+
+	declPattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(ident) + `\s*=\s*new\s*(?:TagList)?\s*(?:\(\s*\))?\s*\{`)
+	for _, loc := range declPattern.FindAllStringIndex(content, -1) {
+		end := findBraceEnd(content, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		keys = adapter.MergeKeys(keys, extractTagKeys(content[loc[1]-1:end], constants))
+	}
+
+	addPattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(ident) + `\s*\.\s*Add\s*\(\s*("[^"]+"|[A-Za-z_][\w.]*)\s*,`)
+	for _, match := range addPattern.FindAllStringSubmatch(content, -1) {
+		if key := resolveTagKey(match[1], constants); key != "" {
+			keys = adapter.MergeKeys(keys, []string{key})
+		}
+	}
+
+	return keys
+}
+
+func extractTagKeys(content string, constants map[string]string) []string {
+	var keys []string
+	for _, pattern := range []*regexp.Regexp{keyValuePairPattern, targetTypedNewPattern, tagAddPattern, initializerPattern} {
+		for _, match := range pattern.FindAllStringSubmatch(content, -1) {
+			if key := resolveTagKey(match[1], constants); key != "" {
+				keys = adapter.MergeKeys(keys, []string{key})
+			}
+		}
+	}
+	return keys
+}
+
+func resolveTagKey(expr string, constants map[string]string) string {
+	if strings.HasPrefix(expr, "\"") {
+		return strings.Trim(expr, "\"")
+	}
+
+	name := expr
+	if idx := strings.LastIndex(expr, "."); idx != -1 {
+		name = expr[idx+1:]
+	}
+	return constants[name]
+}
+
+func findBraceEnd(content string, start int) int {
+	depth := 0
+	inString := false
+
+	for i := start; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return -1
+}
+
+func genericValueType(afterMethod string) string {
+	match := genericTypePattern.FindStringSubmatch(afterMethod)
+	if match == nil {
+		return ""
+	}
+
+	switch match[1] {
+	case "long", "int", "short", "byte":
+		return "int"
+	case "double", "float", "decimal":
+		return "double"
+	}
+	return ""
+}
+
+// trimNumberSuffix drops C#'s double, float and decimal literal suffixes.
+func trimNumberSuffix(part string) string {
+	return strings.TrimRight(part, "dDfFmM")
+}
@@ -240,3 +240,66 @@ private static readonly string OtherMetric = "other.metric";
 		t.Errorf("expected 'other.metric', got '%s'", constants["OtherMetric"])
 	}
 }
+
+func TestParseContent_AttributesFromTagList(t *testing.T) {
+	content := `
+private const string AttributeServerAddress = "server.address";
+
+private static readonly Counter<long> Requests = Meter.CreateCounter<long>("http.client.requests");
+
+public static void OnRequest(string method, string host)
+{
+    var tags = new TagList
+    {
+        { "http.request.method", method },
+    };
+    tags.Add(AttributeServerAddress, host);
+    Requests.Add(1, tags);
+    Requests.Add(1, new KeyValuePair<string, object?>("error.type", "timeout"));
+}
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	attrs := metrics[0].Attributes
+	expected := []string{"http.request.method", "server.address", "error.type"}
+	if len(attrs) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, attrs)
+	}
+	for i, name := range expected {
+		if attrs[i] != name {
+			t.Errorf("expected attribute %d to be '%s', got '%s'", i, name, attrs[i])
+		}
+	}
+}
+
+func TestParseContent_ValueTypeAndBuckets(t *testing.T) {
+	content := `
+meter.CreateHistogram<double>(
+    "http.client.request.duration",
+    unit: "s",
+    advice: new InstrumentAdvice<double> { HistogramBucketBoundaries = [0.005, 0.01, 0.025, 0.05] });
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	m := metrics[0]
+	if m.ValueType != "double" {
+		t.Errorf("expected value type 'double', got '%s'", m.ValueType)
+	}
+	if len(m.Buckets) != 4 || m.Buckets[0] != 0.005 || m.Buckets[3] != 0.05 {
+		t.Errorf("unexpected buckets: %v", m.Buckets)
+	}
+}
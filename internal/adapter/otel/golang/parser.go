@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"unicode"
+
+	"github.com/base-14/metric-library/pkg/adapter"
 )
 
 type MetricDef struct {
@@ -12,6 +15,12 @@ type MetricDef struct {
 	InstrumentType string
 	Unit           string
 	Description    string
+	Attributes     []string
+	// AttributeTypes holds the value type of the attributes set through a
+	// typed constructor such as attribute.Int64
+	AttributeTypes map[string]string
+	ValueType      string
+	Buckets        []float64
 }
 
 var methodToType = map[string]string{
@@ -41,6 +50,21 @@ var (
 
 	// Match metric.WithUnit("...")
 	unitPattern = regexp.MustCompile(`metric\.WithUnit\s*\(\s*"([^"]+)"`)
+
+	// Match attribute.String("key", ...), attribute.Key("key") and friends
+	attributeKeyPattern = regexp.MustCompile(`attribute\.(?:Key|String|Int|Int64|Float64|Bool|StringSlice|IntSlice|Int64Slice|Float64Slice|BoolSlice)\s*\(\s*"([^"]+)"`)
+
+	// Match the typed constructors, capturing the type and the key
+	attributeTypePattern = regexp.MustCompile(`attribute\.(String|Int|Int64|Float64|Bool)(Slice)?\s*\(\s*"([^"]+)"`)
+
+	// Match semconv.HTTPRequestMethodKey or semconv.HTTPRoute(...)
+	semconvKeyPattern = regexp.MustCompile(`semconv\.([A-Z]\w*?)(?:Key\b|\s*\()`)
+
+	// Match metric.WithExplicitBucketBoundaries(0.005, 0.01, ...)
+	bucketBoundariesPattern = regexp.MustCompile(`metric\.WithExplicitBucketBoundaries\s*\(([^)]*)\)`)
+
+	// Match the variable or field an instrument is assigned to: x, err := / s.x, err =
+	assignmentPattern = regexp.MustCompile(`(?:[\w.]*\.)?(\w+)\s*(?:,\s*\w+)?\s*:?=\s*$`)
 )
 
 func ParseFile(path string) ([]*MetricDef, error) {
@@ -56,6 +80,8 @@ func ParseFile(path string) ([]*MetricDef, error) {
 func parseContent(content string) ([]*MetricDef, error) {
 	var metrics []*MetricDef
 
+	types := attributeTypes(content)
+
 	// Find all meter.Create* calls
 	matches := meterCreatePattern.FindAllStringSubmatchIndex(content, -1)
 
@@ -86,11 +112,30 @@ func parseContent(content string) ([]*MetricDef, error) {
 		// Extract unit
 		unit := extractStringFromPattern(callContent, unitPattern)
 
+		var attributes []string
+		if variable := adapter.AssignedVariable(assignmentPattern, content, match[0]); variable != "" {
+			attributes = usageAttributeKeys(content, variable)
+		}
+
+		var buckets []float64
+		if boundaries := bucketBoundariesPattern.FindStringSubmatch(callContent); boundaries != nil {
+			buckets = adapter.ParseNumberList(boundaries[1], nil)
+		}
+
+		valueType := "double"
+		if strings.HasPrefix(methodName, "Int64") {
+			valueType = "int"
+		}
+
 		metrics = append(metrics, &MetricDef{
 			Name:           metricName,
 			InstrumentType: instrumentType,
 			Unit:           unit,
 			Description:    description,
+			Attributes:     attributes,
+			AttributeTypes: typesOf(attributes, types),
+			ValueType:      valueType,
+			Buckets:        buckets,
 		})
 	}
 
@@ -148,3 +193,77 @@ func extractStringFromPattern(content string, pattern *regexp.Regexp) string {
 	}
 	return ""
 }
+
+// usageAttributeKeys gathers attribute keys from the Add/Record calls made on
+// the instrument, usually wrapped in metric.WithAttributes(...).
+func usageAttributeKeys(content, variable string) []string {
+	usagePattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(variable) + `\s*\.\s*(?:Add|Record)\s*\(`)
+
+	var keys []string
+	for _, loc := range usagePattern.FindAllStringIndex(content, -1) {
+		end := findCallEnd(content, loc[1])
+		if end == -1 {
+			continue
+		}
+		args := content[loc[1]:end]
+
+		for _, match := range attributeKeyPattern.FindAllStringSubmatch(args, -1) {
+			keys = adapter.AppendKey(keys, match[1])
+		}
+		for _, match := range semconvKeyPattern.FindAllStringSubmatch(args, -1) {
+			keys = adapter.AppendKey(keys, semconvKeyName(match[1]))
+		}
+	}
+	return keys
+}
+
+// attributeTypes maps the keys the file sets through typed constructors to
+// their semconv value types, e.g. attribute.Int64("k", v) -> int.
+func attributeTypes(content string) map[string]string {
+	types := make(map[string]string)
+	for _, match := range attributeTypePattern.FindAllStringSubmatch(content, -1) {
+		valueType := map[string]string{"String": "string", "Int": "int", "Int64": "int", "Float64": "double", "Bool": "boolean"}[match[1]]
+		if match[2] != "" {
+			valueType += "[]"
+		}
+		types[match[3]] = valueType
+	}
+	return types
+}
+
+func typesOf(keys []string, types map[string]string) map[string]string {
+	var result map[string]string
+	for _, key := range keys {
+		if t, ok := types[key]; ok {
+			if result == nil {
+				result = make(map[string]string)
+			}
+			result[key] = t
+		}
+	}
+	return result
+}
+
+// semconvKeyName converts a Go semconv identifier back to its attribute key,
+// e.g. HTTPRequestMethod -> http.request.method.
+func semconvKeyName(ident string) string {
+	runes := []rune(ident)
+	var parts []string
+	start := 0
+
+	for i := 1; i < len(runes); i++ {
+		if !unicode.IsUpper(runes[i]) {
+			continue
+		}
+		// Split before an upper case letter that starts a new word: "aB" or
+		// the "B" in "ABc". Digits stay with the capitals around them, so
+		// K8SPodName is k8s.pod.name.
+		if unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			parts = append(parts, strings.ToLower(string(runes[start:i])))
+			start = i
+		}
+	}
+	parts = append(parts, strings.ToLower(string(runes[start:])))
+
+	return strings.Join(parts, ".")
+}
@@ -201,3 +201,92 @@ bytesCounter, err := meter.Float64Counter(
 		t.Errorf("expected type 'counter', got '%s'", m.InstrumentType)
 	}
 }
+
+func TestParseContent_AttributesFromUsage(t *testing.T) {
+	content := `
+func newMetrics(meter metric.Meter) (*metrics, error) {
+	m := &metrics{}
+	var err error
+	m.duration, err = meter.Float64Histogram("rpc.server.duration",
+		metric.WithUnit("ms"))
+	return m, err
+}
+
+func (m *metrics) record(ctx context.Context, elapsed float64, code int) {
+	m.duration.Record(ctx, elapsed, metric.WithAttributes(
+		semconv.RPCSystemKey.String("grpc"),
+		attribute.Int64("rpc.grpc.status_code", int64(code)),
+	))
+}
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	attrs := metrics[0].Attributes
+	expected := []string{"rpc.grpc.status_code", "rpc.system"}
+	if len(attrs) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, attrs)
+	}
+	for i, name := range expected {
+		if attrs[i] != name {
+			t.Errorf("expected attribute %d to be '%s', got '%s'", i, name, attrs[i])
+		}
+	}
+
+	if types := metrics[0].AttributeTypes; len(types) != 1 || types["rpc.grpc.status_code"] != "int" {
+		t.Errorf("expected only rpc.grpc.status_code typed as int, got %v", types)
+	}
+}
+
+func TestSemconvKeyName(t *testing.T) {
+	tests := map[string]string{
+		"HTTPRequestMethod": "http.request.method",
+		"ServerAddress":     "server.address",
+		"RPCSystem":         "rpc.system",
+		"NetworkPeerPort":   "network.peer.port",
+		"K8SPodName":        "k8s.pod.name",
+		"K8SNamespaceName":  "k8s.namespace.name",
+		"OSType":            "os.type",
+	}
+
+	for ident, expected := range tests {
+		if got := semconvKeyName(ident); got != expected {
+			t.Errorf("semconvKeyName(%q) = %q, want %q", ident, got, expected)
+		}
+	}
+}
+
+func TestParseContent_ValueTypeAndBuckets(t *testing.T) {
+	content := `
+duration, err := meter.Float64Histogram(
+	"http.server.request.duration",
+	metric.WithUnit("s"),
+	metric.WithExplicitBucketBoundaries(0.005, 0.01, 0.025, 0.05),
+)
+active, err := meter.Int64UpDownCounter("http.server.active_requests")
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+
+	if metrics[0].ValueType != "double" {
+		t.Errorf("expected value type 'double', got '%s'", metrics[0].ValueType)
+	}
+	if len(metrics[0].Buckets) != 4 || metrics[0].Buckets[3] != 0.05 {
+		t.Errorf("unexpected buckets: %v", metrics[0].Buckets)
+	}
+	if metrics[1].ValueType != "int" {
+		t.Errorf("expected value type 'int', got '%s'", metrics[1].ValueType)
+	}
+}
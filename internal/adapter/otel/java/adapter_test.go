@@ -3,8 +3,8 @@ package java
 import (
 	"testing"
 
-	adpt "github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
+	adpt "github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 func TestAdapter_Name(t *testing.T) {
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/base-14/metric-library/pkg/adapter"
 )
 
 type MetricDef struct {
@@ -12,6 +14,12 @@ type MetricDef struct {
 	InstrumentType string
 	Unit           string
 	Description    string
+	Attributes     []string
+	// AttributeTypes holds the value type of the attributes whose
+	// AttributeKey declaration the file contains
+	AttributeTypes map[string]string
+	ValueType      string
+	Buckets        []float64
 }
 
 var builderToType = map[string]string{
@@ -21,6 +29,15 @@ var builderToType = map[string]string{
 	"upDownCounterBuilder": "updowncounter",
 }
 
+// Builders default to long counters and double gauges/histograms until
+// .ofDoubles() or .ofLongs() switches them
+var builderValueType = map[string]string{
+	"counter":       "int",
+	"upDownCounter": "int",
+	"gauge":         "double",
+	"histogram":     "double",
+}
+
 var (
 	// Match meter.xxxBuilder("name") patterns
 	meterBuilderPattern = regexp.MustCompile(`meter\s*\.\s*(counter|histogram|gauge|upDownCounter)Builder\s*\(\s*"([^"]+)"`)
@@ -28,6 +45,23 @@ var (
 	descriptionPattern = regexp.MustCompile(`\.setDescription\s*\(\s*"([^"]+)"`)
 	// Match .setUnit("...") in method chain
 	unitPattern = regexp.MustCompile(`\.setUnit\s*\(\s*"([^"]+)"`)
+	// Match .ofDoubles() / .ofLongs() value type switches
+	valueTypePattern = regexp.MustCompile(`\.of(Doubles|Longs)\s*\(\s*\)`)
+	// Match .setExplicitBucketBoundariesAdvice(Arrays.asList(...)) or List.of(...)
+	bucketAdvicePattern = regexp.MustCompile(`\.setExplicitBucketBoundariesAdvice\s*\(\s*(?:Arrays\s*\.\s*)?(?:asList|List\s*\.\s*of)\s*\(([^)]*)\)`)
+	// Match AttributeKey.stringKey("...") and friends, with or without static import
+	attributeKeyPattern = regexp.MustCompile(`(?:AttributeKey\s*\.\s*)?(?:string|long|double|boolean)(?:Array)?Key\s*\(\s*"([^"]+)"\s*\)`)
+	// Match the same calls, capturing the key's type
+	attributeTypePattern = regexp.MustCompile(`(string|long|double|boolean)(Array)?Key\s*\(\s*"([^"]+)"\s*\)`)
+	// Match FOO = AttributeKey.stringKey("foo") constant declarations
+	attributeConstPattern = regexp.MustCompile(`(\w+)\s*=\s*(?:AttributeKey\s*\.\s*)?(?:string|long|double|boolean)(?:Array)?Key\s*\(\s*"([^"]+)"\s*\)`)
+	// Match Attributes.of( and builder .put( calls whose arguments hold attribute keys
+	attributesOfPattern = regexp.MustCompile(`Attributes\s*\.\s*of\s*\(`)
+	attributePutPattern = regexp.MustCompile(`\.put\s*\(\s*("[^"]+"|[\w.]+)\s*,`)
+	// Match the variable an instrument is assigned to: counter = meter...
+	assignmentPattern = regexp.MustCompile(`(\w+)\s*=\s*$`)
+	// Match semconv-style constants such as HttpAttributes.HTTP_REQUEST_METHOD
+	semconvConstPattern = regexp.MustCompile(`^(?:[\w]+\.)*([A-Z][A-Z0-9]*(?:_[A-Z0-9]+)+)$`)
 )
 
 func ParseFile(path string) ([]*MetricDef, error) {
@@ -43,6 +77,9 @@ func ParseFile(path string) ([]*MetricDef, error) {
 func parseContent(content string) ([]*MetricDef, error) {
 	var metrics []*MetricDef
 
+	attrConstants := extractAttributeConstants(content)
+	attrTypes := attributeTypes(content)
+
 	// Find all meter builder calls
 	matches := meterBuilderPattern.FindAllStringSubmatchIndex(content, -1)
 
@@ -74,11 +111,31 @@ func parseContent(content string) ([]*MetricDef, error) {
 		// Extract unit
 		unit := extractStringFromPattern(chainContent, unitPattern)
 
+		// Attributes recorded in callbacks or at add()/record() call sites
+		attributes := extractAttributeKeys(chainContent, attrConstants)
+		if variable := adapter.AssignedVariable(assignmentPattern, content, chainStart); variable != "" {
+			attributes = adapter.MergeKeys(attributes, usageAttributeKeys(content, variable, attrConstants))
+		}
+
+		valueType := builderValueType[builderType]
+		if vt := valueTypePattern.FindStringSubmatch(chainContent); vt != nil {
+			valueType = map[string]string{"Doubles": "double", "Longs": "int"}[vt[1]]
+		}
+
+		var buckets []float64
+		if advice := bucketAdvicePattern.FindStringSubmatch(chainContent); advice != nil {
+			buckets = adapter.ParseNumberList(advice[1], trimNumberSuffix)
+		}
+
 		metrics = append(metrics, &MetricDef{
 			Name:           metricName,
 			InstrumentType: instrumentType,
 			Unit:           unit,
 			Description:    description,
+			Attributes:     attributes,
+			AttributeTypes: typesOf(attributes, attrTypes),
+			ValueType:      valueType,
+			Buckets:        buckets,
 		})
 	}
 
@@ -172,3 +229,145 @@ func extractStringFromPattern(content string, pattern *regexp.Regexp) string {
 	}
 	return ""
 }
+
+func extractAttributeConstants(content string) map[string]string {
+	constants := make(map[string]string)
+	for _, match := range attributeConstPattern.FindAllStringSubmatch(content, -1) {
+		constants[match[1]] = match[2]
+	}
+	return constants
+}
+
+// attributeTypes maps the keys declared in the file to their semconv value
+// types, e.g. longKey("k") -> int.
+func attributeTypes(content string) map[string]string {
+	types := make(map[string]string)
+	for _, match := range attributeTypePattern.FindAllStringSubmatch(content, -1) {
+		valueType := map[string]string{"string": "string", "long": "int", "double": "double", "boolean": "boolean"}[match[1]]
+		if match[2] != "" {
+			valueType += "[]"
+		}
+		types[match[3]] = valueType
+	}
+	return types
+}
+
+func typesOf(keys []string, types map[string]string) map[string]string {
+	var result map[string]string
+	for _, key := range keys {
+		if t, ok := types[key]; ok {
+			if result == nil {
+				result = make(map[string]string)
+			}
+			result[key] = t
+		}
+	}
+	return result
+}
+
+func usageAttributeKeys(content, variable string, constants map[string]string) []string {
+	usagePattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(variable) + `\s*\.\s*(?:add|record|set)\s*\(`)
+
+	var keys []string
+	for _, loc := range usagePattern.FindAllStringIndex(content, -1) {
+		end := findMatchingParen(content, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		keys = adapter.MergeKeys(keys, extractAttributeKeys(content[loc[0]:end], constants))
+	}
+	return keys
+}
+
+func extractAttributeKeys(content string, constants map[string]string) []string {
+	var keys []string
+
+	for _, match := range attributeKeyPattern.FindAllStringSubmatch(content, -1) {
+		keys = adapter.MergeKeys(keys, []string{match[1]})
+	}
+
+	for _, loc := range attributesOfPattern.FindAllStringIndex(content, -1) {
+		end := findMatchingParen(content, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		// Attributes.of(key1, value1, key2, value2, ...)
+		args := splitArgs(content[loc[1] : end-1])
+		for i := 0; i < len(args); i += 2 {
+			if key := resolveAttributeKey(args[i], constants); key != "" {
+				keys = adapter.MergeKeys(keys, []string{key})
+			}
+		}
+	}
+
+	for _, match := range attributePutPattern.FindAllStringSubmatch(content, -1) {
+		if key := resolveAttributeKey(match[1], constants); key != "" {
+			keys = adapter.MergeKeys(keys, []string{key})
+		}
+	}
+
+	return keys
+}
+
+func resolveAttributeKey(expr string, constants map[string]string) string {
+	expr = strings.TrimSpace(expr)
+
+	if strings.HasPrefix(expr, "\"") {
+		return strings.Trim(expr, "\"")
+	}
+
+	if match := attributeKeyPattern.FindStringSubmatch(expr); len(match) > 1 {
+		return match[1]
+	}
+
+	name := expr
+	if idx := strings.LastIndex(expr, "."); idx != -1 {
+		name = expr[idx+1:]
+	}
+	if resolved, ok := constants[name]; ok {
+		return resolved
+	}
+
+	// HTTP_REQUEST_METHOD -> http.request.method
+	if match := semconvConstPattern.FindStringSubmatch(expr); len(match) > 1 {
+		return strings.ToLower(strings.ReplaceAll(match[1], "_", "."))
+	}
+
+	return ""
+}
+
+func splitArgs(content string) []string {
+	var args []string
+	depth := 0
+	inString := false
+	start := 0
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '(' || c == '{' || c == '[':
+			depth++
+		case c == ')' || c == '}' || c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(content[start:i]))
+			start = i + 1
+		}
+	}
+
+	if rest := strings.TrimSpace(content[start:]); rest != "" {
+		args = append(args, rest)
+	}
+
+	return args
+}
+
+// trimNumberSuffix drops Java's double, float and long literal suffixes.
+func trimNumberSuffix(part string) string {
+	return strings.TrimRight(part, "dDfFlL")
+}
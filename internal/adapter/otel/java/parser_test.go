@@ -209,3 +209,101 @@ meter.counterBuilder("simple.counter")
 		t.Errorf("expected unit '1', got '%s'", metrics[0].Unit)
 	}
 }
+
+func TestParseContent_AttributesFromUsage(t *testing.T) {
+	content := `
+class ServerMetrics {
+    private static final AttributeKey<String> ROUTE = AttributeKey.stringKey("http.route");
+
+    private final LongCounter requests;
+
+    ServerMetrics(Meter meter) {
+        requests = meter.counterBuilder("http.server.requests")
+            .setUnit("{request}")
+            .build();
+    }
+
+    void onEnd(String method, String route) {
+        requests.add(1, Attributes.of(HttpAttributes.HTTP_REQUEST_METHOD, method, ROUTE, route));
+    }
+}
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	attrs := metrics[0].Attributes
+	if len(attrs) != 2 {
+		t.Fatalf("expected 2 attributes, got %v", attrs)
+	}
+	if attrs[0] != "http.request.method" || attrs[1] != "http.route" {
+		t.Errorf("unexpected attributes: %v", attrs)
+	}
+	if types := metrics[0].AttributeTypes; len(types) != 1 || types["http.route"] != "string" {
+		t.Errorf("expected only http.route typed as string, got %v", types)
+	}
+}
+
+func TestParseContent_AttributesFromCallback(t *testing.T) {
+	content := `
+meter.gaugeBuilder("jvm.memory.used")
+    .setUnit("By")
+    .buildWithCallback(m -> m.record(used, Attributes.builder().put(stringKey("jvm.memory.pool.name"), pool).build()));
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	if len(metrics[0].Attributes) != 1 || metrics[0].Attributes[0] != "jvm.memory.pool.name" {
+		t.Errorf("unexpected attributes: %v", metrics[0].Attributes)
+	}
+}
+
+func TestParseContent_ValueTypeAndBuckets(t *testing.T) {
+	content := `
+meter.histogramBuilder("http.server.request.duration")
+    .setUnit("s")
+    .setExplicitBucketBoundariesAdvice(Arrays.asList(0.005d, 0.01, 0.025, 1.0))
+    .build();
+
+meter.gaugeBuilder("jvm.thread.count")
+    .ofLongs()
+    .buildWithCallback(m -> m.record(threads.get()));
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+
+	histogram := metrics[0]
+	if histogram.ValueType != "double" {
+		t.Errorf("expected value type 'double', got '%s'", histogram.ValueType)
+	}
+	expected := []float64{0.005, 0.01, 0.025, 1.0}
+	if len(histogram.Buckets) != len(expected) {
+		t.Fatalf("expected buckets %v, got %v", expected, histogram.Buckets)
+	}
+	for i, b := range expected {
+		if histogram.Buckets[i] != b {
+			t.Errorf("expected bucket %d to be %v, got %v", i, b, histogram.Buckets[i])
+		}
+	}
+
+	if metrics[1].ValueType != "int" {
+		t.Errorf("expected value type 'int', got '%s'", metrics[1].ValueType)
+	}
+}
@@ -0,0 +1,137 @@
+package jmx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/open-telemetry/opentelemetry-java-instrumentation"
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "otel-jmx",
+		Category:    domain.SourceOTEL,
+		Description: "OpenTelemetry Java agent JMX metric rules",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"otel", "java", "jmx"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "otel-jmx"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceOTEL
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMetadata
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	var metrics []*adapter.RawMetric
+
+	// Target-system rules live in jmx/rules resource directories
+	jmxDir := filepath.Join(result.RepoPath, "instrumentation", "jmx-metrics")
+	err := filepath.Walk(jmxDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return nil
+		}
+
+		ext := filepath.Ext(path)
+		if ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+		if strings.Contains(path, "/test/") || !strings.HasSuffix(filepath.Dir(path), filepath.Join("jmx", "rules")) {
+			return nil
+		}
+
+		defs, err := ParseFile(path)
+		if err != nil {
+			return nil
+		}
+
+		relPath, _ := filepath.Rel(result.RepoPath, path)
+		componentName := strings.TrimSuffix(filepath.Base(path), ext)
+
+		for _, def := range defs {
+			attrs := make([]domain.Attribute, 0, len(def.Attributes))
+			for _, attr := range def.Attributes {
+				attrs = append(attrs, domain.Attribute{
+					Name: attr.Name,
+					Type: "string",
+					Enum: attr.Values,
+				})
+			}
+
+			metrics = append(metrics, &adapter.RawMetric{
+				Name:             def.Name,
+				Description:      def.Description,
+				Unit:             def.Unit,
+				InstrumentType:   def.InstrumentType,
+				Attributes:       attrs,
+				EnabledByDefault: true,
+				ComponentType:    string(domain.ComponentPlatform),
+				ComponentName:    componentName,
+				SourceLocation:   def.Bean,
+				Path:             relPath,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,105 @@
+package jmx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+var rulesDir = filepath.Join("instrumentation", "jmx-metrics", "library", "src", "main", "resources", "jmx", "rules")
+
+func writeRepoFile(t *testing.T, repo, path, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(repo, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func extract(t *testing.T, repo string) []*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	return metrics
+}
+
+func TestJMXAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "otel-jmx" {
+		t.Errorf("expected name 'otel-jmx', got %q", a.Name())
+	}
+}
+
+func TestJMXAdapter_SourceCategory(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.SourceCategory() != domain.SourceOTEL {
+		t.Errorf("expected source category 'otel', got %q", a.SourceCategory())
+	}
+}
+
+func TestJMXAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestJMXAdapter_Extract_ComponentFromRulesFile(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join(rulesDir, "tomcat.yaml"), tomcatRules)
+
+	metrics := extract(t, repo)
+	if len(metrics) != 6 {
+		t.Fatalf("expected 6 metrics, got %d", len(metrics))
+	}
+
+	for _, m := range metrics {
+		if m.ComponentName != "tomcat" || m.ComponentType != string(domain.ComponentPlatform) {
+			t.Errorf("unexpected component for %s: %s/%s", m.Name, m.ComponentType, m.ComponentName)
+		}
+		if m.Path != filepath.Join(rulesDir, "tomcat.yaml") {
+			t.Errorf("expected a repo-relative path for %s, got %q", m.Name, m.Path)
+		}
+	}
+}
+
+func TestJMXAdapter_Extract_BeanAsSourceLocation(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join(rulesDir, "tomcat.yaml"), tomcatRules)
+
+	for _, m := range extract(t, repo) {
+		if m.Name == "tomcat.error.count" && m.SourceLocation != "Catalina:type=GlobalRequestProcessor,name=*" {
+			t.Errorf("expected the bean as source location, got %q", m.SourceLocation)
+		}
+		if m.SourceLocation == "" {
+			t.Errorf("expected a source location for %s", m.Name)
+		}
+	}
+}
+
+func TestJMXAdapter_Extract_SkipsTestResources(t *testing.T) {
+	repo := t.TempDir()
+	testRules := filepath.Join("instrumentation", "jmx-metrics", "library", "src", "test", "resources", "jmx", "rules")
+	writeRepoFile(t, repo, filepath.Join(testRules, "fake.yaml"), tomcatRules)
+
+	if metrics := extract(t, repo); len(metrics) != 0 {
+		t.Errorf("expected test rules to be skipped, got %d metrics", len(metrics))
+	}
+}
+
+func TestJMXAdapter_Extract_OnlyRulesDirectories(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join("instrumentation", "jmx-metrics", "library", "config.yaml"), tomcatRules)
+
+	if metrics := extract(t, repo); len(metrics) != 0 {
+		t.Errorf("expected files outside jmx/rules to be skipped, got %d metrics", len(metrics))
+	}
+}
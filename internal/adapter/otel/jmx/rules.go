@@ -0,0 +1,162 @@
+package jmx
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is a JMX metrics target-system rules file.
+type Config struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+type Rule struct {
+	Bean            string              `yaml:"bean"`
+	Beans           []string            `yaml:"beans"`
+	Prefix          string              `yaml:"prefix"`
+	Type            string              `yaml:"type"`
+	Unit            string              `yaml:"unit"`
+	Desc            string              `yaml:"desc"`
+	MetricAttribute map[string]any      `yaml:"metricAttribute"`
+	Mapping         map[string]*Mapping `yaml:"mapping"`
+}
+
+// Mapping turns one MBean attribute into a metric. A bare attribute name
+// maps to a gauge named after it.
+type Mapping struct {
+	Metric          string         `yaml:"metric"`
+	Type            string         `yaml:"type"`
+	Unit            string         `yaml:"unit"`
+	Desc            string         `yaml:"desc"`
+	MetricAttribute map[string]any `yaml:"metricAttribute"`
+}
+
+type MetricDef struct {
+	Name           string
+	Description    string
+	Unit           string
+	InstrumentType string
+	Attributes     []AttributeDef
+	Bean           string
+}
+
+type AttributeDef struct {
+	Name   string
+	Values []string
+}
+
+var constRe = regexp.MustCompile(`^const\((.*)\)$`)
+
+func ParseFile(path string) ([]MetricDef, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // Reading rules from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+func Parse(data []byte) ([]MetricDef, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse JMX rules: %w", err)
+	}
+
+	var defs []MetricDef
+	for _, rule := range cfg.Rules {
+		bean := rule.Bean
+		if bean == "" && len(rule.Beans) > 0 {
+			bean = rule.Beans[0]
+		}
+
+		attrNames := make([]string, 0, len(rule.Mapping))
+		for name := range rule.Mapping {
+			attrNames = append(attrNames, name)
+		}
+		sort.Strings(attrNames)
+
+		for _, attrName := range attrNames {
+			m := rule.Mapping[attrName]
+			if m == nil {
+				m = &Mapping{}
+			}
+
+			metric := m.Metric
+			if metric == "" {
+				metric = attrName
+			}
+
+			def := MetricDef{
+				Name:           rule.Prefix + metric,
+				Description:    firstNonEmpty(m.Desc, rule.Desc),
+				Unit:           firstNonEmpty(m.Unit, rule.Unit),
+				InstrumentType: instrumentType(firstNonEmpty(m.Type, rule.Type)),
+				Attributes:     attributes(rule.MetricAttribute, m.MetricAttribute),
+				Bean:           bean,
+			}
+			defs = append(defs, def)
+		}
+	}
+
+	return defs, nil
+}
+
+// attributes merges rule and mapping level metric attributes. Values are
+// extractors like param(name) or const(x); state metrics use a map from
+// state to the attribute values that select it.
+func attributes(ruleAttrs, mappingAttrs map[string]any) []AttributeDef {
+	merged := make(map[string]any, len(ruleAttrs)+len(mappingAttrs))
+	for k, v := range ruleAttrs {
+		merged[k] = v
+	}
+	for k, v := range mappingAttrs {
+		merged[k] = v
+	}
+
+	names := make([]string, 0, len(merged))
+	for name := range merged {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attrs := make([]AttributeDef, 0, len(names))
+	for _, name := range names {
+		attr := AttributeDef{Name: name}
+		switch v := merged[name].(type) {
+		case string:
+			if m := constRe.FindStringSubmatch(v); m != nil {
+				attr.Values = []string{m[1]}
+			}
+		case map[string]any:
+			for state := range v {
+				attr.Values = append(attr.Values, state)
+			}
+			sort.Strings(attr.Values)
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs
+}
+
+func instrumentType(t string) string {
+	switch t {
+	case "counter":
+		return "counter"
+	case "updowncounter", "state":
+		return "updowncounter"
+	default:
+		return "gauge"
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
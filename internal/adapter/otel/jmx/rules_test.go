@@ -0,0 +1,100 @@
+package jmx
+
+import (
+	"reflect"
+	"testing"
+)
+
+const tomcatRules = `---
+rules:
+  - bean: Catalina:type=GlobalRequestProcessor,name=*
+    prefix: tomcat.
+    metricAttribute:
+      tomcat.request_processor.name: param(name)
+    mapping:
+      errorCount:
+        metric: error.count
+        type: counter
+        unit: "{error}"
+        desc: The number of errors.
+      bytesReceived:
+        metric: &metric network.io
+        type: &type counter
+        unit: &unit By
+        desc: &desc The number of bytes transmitted.
+        metricAttribute:
+          network.io.direction: const(receive)
+      bytesSent:
+        metric: *metric
+        type: *type
+        unit: *unit
+        desc: *desc
+        metricAttribute:
+          network.io.direction: const(transmit)
+  - beans:
+      - Catalina:type=Manager,host=localhost,context=*
+    prefix: tomcat.session.
+    type: updowncounter
+    unit: "{session}"
+    mapping:
+      activeSessions:
+        metric: active.count
+      maxActive:
+  - bean: Catalina:type=Connector,port=*
+    prefix: tomcat.
+    mapping:
+      stateName:
+        metric: connector.state
+        type: state
+        metricAttribute:
+          state:
+            started: STARTED
+            stopped: [STOPPED, STOPPING]
+`
+
+func TestParse(t *testing.T) {
+	defs, err := Parse([]byte(tomcatRules))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if len(defs) != 6 {
+		t.Fatalf("expected 6 metrics, got %d", len(defs))
+	}
+
+	byName := make(map[string][]MetricDef)
+	for _, d := range defs {
+		byName[d.Name] = append(byName[d.Name], d)
+	}
+
+	errors := byName["tomcat.error.count"]
+	if len(errors) != 1 || errors[0].InstrumentType != "counter" || errors[0].Unit != "{error}" {
+		t.Fatalf("unexpected error.count: %+v", errors)
+	}
+	if len(errors[0].Attributes) != 1 || errors[0].Attributes[0].Name != "tomcat.request_processor.name" {
+		t.Errorf("expected rule-level attribute, got %+v", errors[0].Attributes)
+	}
+
+	io := byName["tomcat.network.io"]
+	if len(io) != 2 || io[0].Unit != "By" || io[0].Description != "The number of bytes transmitted." {
+		t.Fatalf("expected aliased network.io mappings, got %+v", io)
+	}
+	if !reflect.DeepEqual(io[0].Attributes[0].Values, []string{"receive"}) {
+		t.Errorf("expected const direction, got %+v", io[0].Attributes)
+	}
+
+	maxActive := byName["tomcat.session.maxActive"]
+	if len(maxActive) != 1 || maxActive[0].InstrumentType != "updowncounter" || maxActive[0].Unit != "{session}" {
+		t.Errorf("expected rule defaults on bare mapping, got %+v", maxActive)
+	}
+	if maxActive[0].Bean != "Catalina:type=Manager,host=localhost,context=*" {
+		t.Errorf("unexpected bean %s", maxActive[0].Bean)
+	}
+
+	state := byName["tomcat.connector.state"]
+	if len(state) != 1 || state[0].InstrumentType != "updowncounter" {
+		t.Fatalf("unexpected state metric: %+v", state)
+	}
+	if !reflect.DeepEqual(state[0].Attributes[0].Values, []string{"started", "stopped"}) {
+		t.Errorf("expected state values, got %+v", state[0].Attributes)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/base-14/metric-library/pkg/adapter"
 )
 
 type MetricDef struct {
@@ -12,6 +14,9 @@ type MetricDef struct {
 	InstrumentType string
 	Unit           string
 	Description    string
+	Attributes     []string
+	ValueType      string
+	Buckets        []float64
 }
 
 var (
@@ -27,6 +32,20 @@ var (
 	// Match descriptions in options objects
 	descriptionPattern = regexp.MustCompile(`description:\s*['"]([^'"]+)['"]`)
 	unitPattern        = regexp.MustCompile(`unit:\s*['"]([^'"]+)['"]`)
+
+	// Match attribute keys in object literals: { 'http.route': ..., state: ..., [ATTR_HTTP_ROUTE]: ... }
+	quotedKeyPattern   = regexp.MustCompile(`[{,]\s*['"]([^'"]+)['"]\s*:`)
+	bareKeyPattern     = regexp.MustCompile(`[{,]\s*([A-Za-z_$][\w$]*)\s*:`)
+	computedKeyPattern = regexp.MustCompile(`[{,]\s*\[\s*(?:\w+\.)?(?:ATTR|SEMATTRS|SEMRESATTRS)_(\w+)\s*\]\s*:`)
+
+	// Match valueType: ValueType.INT / ValueType.DOUBLE in instrument options
+	valueTypePattern = regexp.MustCompile(`valueType:\s*(?:\w+\.)?ValueType\.(INT|DOUBLE)`)
+
+	// Match advice: { explicitBucketBoundaries: [ ... ] }
+	bucketBoundariesPattern = regexp.MustCompile(`explicitBucketBoundaries:\s*\[([^\]]*)\]`)
+
+	// Match the property or variable an instrument is assigned to
+	assignmentPattern = regexp.MustCompile(`(?:this\.)?([\w$]+)\s*=\s*$`)
 )
 
 var methodToType = map[string]string{
@@ -189,11 +208,29 @@ func parseInstrumentationContent(content string, semconvContent string) ([]*Metr
 			unit = unitMatch[1]
 		}
 
+		var attributes []string
+		if variable := adapter.AssignedVariable(assignmentPattern, content, callStart); variable != "" {
+			attributes = usageAttributeKeys(content, variable)
+		}
+
+		valueType := ""
+		if vt := valueTypePattern.FindStringSubmatch(callContent); len(vt) > 1 {
+			valueType = strings.ToLower(vt[1])
+		}
+
+		var buckets []float64
+		if boundaries := bucketBoundariesPattern.FindStringSubmatch(callContent); len(boundaries) > 1 {
+			buckets = adapter.ParseNumberList(boundaries[1], nil)
+		}
+
 		metrics = append(metrics, &MetricDef{
 			Name:           name,
 			InstrumentType: instrumentType,
 			Unit:           unit,
 			Description:    description,
+			Attributes:     attributes,
+			ValueType:      valueType,
+			Buckets:        buckets,
 		})
 	}
 
@@ -285,6 +322,32 @@ func inferUnit(metricName string) string {
 	}
 }
 
+// usageAttributeKeys reads the attributes object passed to add()/record()
+// calls on the instrument.
+func usageAttributeKeys(content, variable string) []string {
+	usagePattern := regexp.MustCompile(`(?:^|[^\w$])` + regexp.QuoteMeta(variable) + `\s*\.\s*(?:add|record)\s*\(`)
+
+	var keys []string
+	for _, loc := range usagePattern.FindAllStringIndex(content, -1) {
+		end := findMatchingParen(content, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		args := content[loc[1]:end]
+
+		for _, match := range quotedKeyPattern.FindAllStringSubmatch(args, -1) {
+			keys = adapter.AppendKey(keys, match[1])
+		}
+		for _, match := range bareKeyPattern.FindAllStringSubmatch(args, -1) {
+			keys = adapter.AppendKey(keys, match[1])
+		}
+		for _, match := range computedKeyPattern.FindAllStringSubmatch(args, -1) {
+			keys = adapter.AppendKey(keys, strings.ReplaceAll(strings.ToLower(match[1]), "_", "."))
+		}
+	}
+	return keys
+}
+
 func findMatchingParen(content string, start int) int {
 	depth := 1
 	inString := false
@@ -210,3 +210,40 @@ func TestInferUnit(t *testing.T) {
 		})
 	}
 }
+
+func TestParseInstrumentationContent_AttributesFromUsage(t *testing.T) {
+	content := `
+this._duration = this._meter.createHistogram('http.server.request.duration', {
+  unit: 's',
+});
+
+this._duration.record(elapsed, {
+  [ATTR_HTTP_REQUEST_METHOD]: method,
+  'http.route': route,
+  status: code,
+});
+`
+
+	metrics, err := parseInstrumentationContent(content, "")
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+
+	assert.Equal(t, []string{"http.route", "status", "http.request.method"}, metrics[0].Attributes)
+}
+
+func TestParseInstrumentationContent_ValueTypeAndBuckets(t *testing.T) {
+	content := `
+this._meter.createHistogram('db.client.operation.duration', {
+  unit: 's',
+  valueType: ValueType.DOUBLE,
+  advice: { explicitBucketBoundaries: [0.001, 0.005, 0.01, 0.05] },
+});
+`
+
+	metrics, err := parseInstrumentationContent(content, "")
+	require.NoError(t, err)
+	require.Len(t, metrics, 1)
+
+	assert.Equal(t, "double", metrics[0].ValueType)
+	assert.Equal(t, []float64{0.001, 0.005, 0.01, 0.05}, metrics[0].Buckets)
+}
@@ -5,6 +5,8 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/base-14/metric-library/pkg/adapter"
 )
 
 type MetricDef struct {
@@ -12,6 +14,8 @@ type MetricDef struct {
 	InstrumentType string
 	Unit           string
 	Description    string
+	Attributes     []string
+	Buckets        []float64
 }
 
 var methodToType = map[string]string{
@@ -30,6 +34,13 @@ var (
 	nameConstPattern   = regexp.MustCompile(`name\s*=\s*(\w+(?:\.\w+)*)`)
 	unitPattern        = regexp.MustCompile(`unit\s*=\s*["']([^"']+)["']`)
 	descPattern        = regexp.MustCompile(`description\s*=\s*["']([^"']+)["']`)
+	bucketsPattern     = regexp.MustCompile(`explicit_bucket_boundaries_advisory\s*=\s*[\[(]([^\])]*)[\])]`)
+
+	// Attribute dict keys: {"http.route": ..., SpanAttributes.HTTP_METHOD: ...}
+	dictKeyPattern      = regexp.MustCompile(`[{,]\s*["']([^"']+)["']\s*:`)
+	dictConstKeyPattern = regexp.MustCompile(`[{,]\s*((?:\w+\.)*[A-Z][A-Z0-9_]*)\s*:`)
+
+	assignmentPattern = regexp.MustCompile(`((?:self\.)?\w+)\s*(?::\s*[\w.\[\]]+)?\s*=\s*$`)
 )
 
 func ParseFile(path string) ([]*MetricDef, error) {
@@ -81,11 +92,23 @@ func parseContent(content string) ([]*MetricDef, error) {
 		unit := extractStringArg(callContent, unitPattern)
 		description := extractStringArg(callContent, descPattern)
 
+		var attributes []string
+		if variable := adapter.AssignedVariable(assignmentPattern, content, callStart); variable != "" {
+			attributes = usageAttributeKeys(content, variable)
+		}
+
+		var buckets []float64
+		if boundaries := bucketsPattern.FindStringSubmatch(callContent); len(boundaries) > 1 {
+			buckets = adapter.ParseNumberList(boundaries[1], nil)
+		}
+
 		metrics = append(metrics, &MetricDef{
 			Name:           name,
 			InstrumentType: instrumentType,
 			Unit:           unit,
 			Description:    description,
+			Attributes:     attributes,
+			Buckets:        buckets,
 		})
 	}
 
@@ -169,3 +192,31 @@ func resolveConstant(constName string, content string) string {
 
 	return ""
 }
+
+// usageAttributeKeys picks up the dict keys of attributes passed to
+// add()/record()/set() on the instrument.
+func usageAttributeKeys(content, variable string) []string {
+	usagePattern := regexp.MustCompile(`(?:^|[^\w.])` + regexp.QuoteMeta(variable) + `\s*\.\s*(?:add|record|set)\s*\(`)
+
+	var keys []string
+	for _, loc := range usagePattern.FindAllStringIndex(content, -1) {
+		end := findMatchingParen(content, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		args := content[loc[1]:end]
+
+		for _, match := range dictKeyPattern.FindAllStringSubmatch(args, -1) {
+			keys = adapter.AppendKey(keys, match[1])
+		}
+		for _, match := range dictConstKeyPattern.FindAllStringSubmatch(args, -1) {
+			key := resolveConstant(match[1], content)
+			if key == "" {
+				parts := strings.Split(match[1], ".")
+				key = strings.ReplaceAll(strings.ToLower(parts[len(parts)-1]), "_", ".")
+			}
+			keys = adapter.AppendKey(keys, key)
+		}
+	}
+	return keys
+}
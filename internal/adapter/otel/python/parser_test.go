@@ -279,3 +279,53 @@ func TestFindMatchingParen(t *testing.T) {
 		})
 	}
 }
+
+func TestParseContent_AttributesFromUsage(t *testing.T) {
+	content := `
+self._duration_histogram = self._meter.create_histogram(
+    name="http.server.duration",
+    unit="ms",
+)
+
+self._duration_histogram.record(
+    elapsed,
+    {"http.route": route, SpanAttributes.HTTP_METHOD: method},
+)
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	attrs := metrics[0].Attributes
+	if len(attrs) != 2 || attrs[0] != "http.route" || attrs[1] != "http.method" {
+		t.Errorf("unexpected attributes: %v", attrs)
+	}
+}
+
+func TestParseContent_BucketAdvisory(t *testing.T) {
+	content := `
+self._duration = self._meter.create_histogram(
+    name="http.server.request.duration",
+    unit="s",
+    explicit_bucket_boundaries_advisory=[0.005, 0.01, 0.025, 0.05],
+)
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	buckets := metrics[0].Buckets
+	if len(buckets) != 4 || buckets[0] != 0.005 || buckets[3] != 0.05 {
+		t.Errorf("unexpected buckets: %v", buckets)
+	}
+}
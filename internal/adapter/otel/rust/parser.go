@@ -4,7 +4,10 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+
+	"github.com/base-14/metric-library/pkg/adapter"
 )
 
 type MetricDef struct {
@@ -12,6 +15,9 @@ type MetricDef struct {
 	InstrumentType string
 	Unit           string
 	Description    string
+	Attributes     []string
+	ValueType      string
+	Buckets        []float64
 }
 
 var methodToType = map[string]string{
@@ -51,6 +57,15 @@ var (
 
 	// Match const CONSTANT_NAME: &str = "value"
 	constPattern = regexp.MustCompile(`const\s+([A-Z_][A-Z0-9_]*)\s*:\s*&str\s*=\s*"([^"]+)"`)
+
+	// Match KeyValue::new("key", ...) or KeyValue::new(semconv::attribute::HTTP_ROUTE, ...)
+	keyValuePattern = regexp.MustCompile(`KeyValue\s*::\s*new\s*\(\s*(?:"([^"]+)"|((?:\w+::)*[A-Z_][A-Z0-9_]*))`)
+
+	// Match .with_boundaries(vec![0.0, 5.0, ...])
+	boundariesPattern = regexp.MustCompile(`\.with_boundaries\s*\(\s*vec!\s*\[([^\]]*)\]`)
+
+	// Match the binding an instrument is assigned to: `let x =`, `let x: T =` or a struct field `x:`
+	bindingPattern = regexp.MustCompile(`(?:let\s+(?:mut\s+)?(\w+)\s*(?::[^=;]+)?=|(\w+)\s*:)\s*$`)
 )
 
 func ParseFile(path string) ([]*MetricDef, error) {
@@ -117,11 +132,29 @@ func parseContent(content string) ([]*MetricDef, error) {
 		// Extract unit
 		unit := extractStringFromPattern(chainContent, unitPattern)
 
+		var attributes []string
+		if binding := instrumentBinding(content, match[0]); binding != "" {
+			attributes = usageAttributeKeys(content, binding, constants)
+		}
+
+		var buckets []float64
+		if boundaries := boundariesPattern.FindStringSubmatch(chainContent); boundaries != nil {
+			buckets = adapter.ParseNumberList(boundaries[1], trimNumberSuffix)
+		}
+
+		valueType := "int"
+		if strings.HasPrefix(methodName, "f64") {
+			valueType = "double"
+		}
+
 		metrics = append(metrics, &MetricDef{
 			Name:           metricName,
 			InstrumentType: instrumentType,
 			Unit:           unit,
 			Description:    description,
+			Attributes:     attributes,
+			ValueType:      valueType,
+			Buckets:        buckets,
 		})
 	}
 
@@ -171,3 +204,81 @@ func extractStringFromPattern(content string, pattern *regexp.Regexp) string {
 	}
 	return ""
 }
+
+func instrumentBinding(content string, pos int) string {
+	match := bindingPattern.FindStringSubmatch(strings.TrimRight(content[:pos], " \t\r\n"))
+	if match == nil {
+		return ""
+	}
+	if match[1] != "" {
+		return match[1]
+	}
+	return match[2]
+}
+
+// usageAttributeKeys collects the KeyValue keys passed wherever the
+// instrument is recorded, e.g. counter.add(1, &[KeyValue::new("k", v)]).
+func usageAttributeKeys(content, binding string, constants map[string]string) []string {
+	usagePattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(binding) + `\s*\.\s*(?:add|record)\s*\(`)
+
+	var keys []string
+	for _, loc := range usagePattern.FindAllStringIndex(content, -1) {
+		end := findMatchingParen(content, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		for _, match := range keyValuePattern.FindAllStringSubmatch(content[loc[1]:end], -1) {
+			key := match[1]
+			if key == "" {
+				key = resolveKeyConstant(match[2], constants)
+			}
+			if key != "" && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+func resolveKeyConstant(path string, constants map[string]string) string {
+	name := path
+	if idx := strings.LastIndex(path, "::"); idx != -1 {
+		name = path[idx+2:]
+	}
+	if value, ok := constants[name]; ok {
+		return value
+	}
+	// semconv attribute constants mirror their key: HTTP_REQUEST_METHOD -> http.request.method
+	return strings.ReplaceAll(strings.ToLower(name), "_", ".")
+}
+
+func findMatchingParen(content string, start int) int {
+	depth := 0
+	inString := false
+
+	for i := start; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+
+	return -1
+}
+
+// trimNumberSuffix drops Rust's f64 suffix and digit separators.
+func trimNumberSuffix(part string) string {
+	part = strings.TrimSuffix(part, "f64")
+	return strings.ReplaceAll(strings.TrimSuffix(part, "_"), "_", "")
+}
@@ -199,3 +199,69 @@ const HTTP_SERVER_ACTIVE_REQUESTS: &str = "http.server.active_requests";
 		t.Errorf("expected 'http.server.active_requests', got '%s'", constants["HTTP_SERVER_ACTIVE_REQUESTS"])
 	}
 }
+
+func TestParseContent_AttributesFromUsage(t *testing.T) {
+	content := `
+const HTTP_SERVER_ACTIVE_REQUESTS: &str = "http.server.active_requests";
+const ROUTE_KEY: &str = "http.route";
+
+let active_requests = meter
+    .i64_up_down_counter(HTTP_SERVER_ACTIVE_REQUESTS)
+    .with_unit("{request}")
+    .build();
+
+active_requests.add(
+    1,
+    &[
+        KeyValue::new(semconv::attribute::HTTP_REQUEST_METHOD, method),
+        KeyValue::new(ROUTE_KEY, route),
+        KeyValue::new("url.scheme", scheme),
+    ],
+);
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	attrs := metrics[0].Attributes
+	expected := []string{"http.request.method", "http.route", "url.scheme"}
+	if len(attrs) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, attrs)
+	}
+	for i, name := range expected {
+		if attrs[i] != name {
+			t.Errorf("expected attribute %d to be '%s', got '%s'", i, name, attrs[i])
+		}
+	}
+}
+
+func TestParseContent_ValueTypeAndBuckets(t *testing.T) {
+	content := `
+let duration = meter
+    .f64_histogram("http.server.request.duration")
+    .with_unit("s")
+    .with_boundaries(vec![0.0, 0.005, 0.01, 1_000.0])
+    .build();
+`
+	metrics, err := parseContent(content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	m := metrics[0]
+	if m.ValueType != "double" {
+		t.Errorf("expected value type 'double', got '%s'", m.ValueType)
+	}
+	if len(m.Buckets) != 4 || m.Buckets[3] != 1000 {
+		t.Errorf("unexpected buckets: %v", m.Buckets)
+	}
+}
@@ -6,9 +6,9 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const repoURL = "https://github.com/open-telemetry/semantic-conventions"
@@ -17,6 +17,19 @@ type Adapter struct {
 	fetcher *fetcher.GitFetcher
 }
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "otel-semconv",
+		Category:    domain.SourceOTEL,
+		Description: "OpenTelemetry semantic conventions metric registry",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"otel", "semconv"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
 		fetcher: fetcher.NewGitFetcher(cacheDir),
@@ -102,7 +115,7 @@ func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*
 			rawMetric := &adapter.RawMetric{
 				Name:             def.Name,
 				Description:      def.Brief,
-				InstrumentType:   mapInstrumentType(def.Instrument),
+				InstrumentType:   InstrumentType(def.Instrument),
 				Unit:             def.Unit,
 				Attributes:       attrs,
 				EnabledByDefault: true,
@@ -110,6 +123,7 @@ func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*
 				ComponentName:    componentName,
 				SourceLocation:   path,
 				Path:             path,
+				Stability:        Stability(def),
 			}
 
 			metrics = append(metrics, rawMetric)
@@ -139,7 +153,9 @@ func deriveComponentName(filePath, modelDir string) string {
 	return strings.ReplaceAll(dir, string(filepath.Separator), ".")
 }
 
-func mapInstrumentType(instrument string) string {
+// InstrumentType maps a semantic convention instrument onto the catalog's
+// instrument types. Anything unknown is taken as a gauge.
+func InstrumentType(instrument string) string {
 	switch instrument {
 	case "counter":
 		return string(domain.InstrumentCounter)
@@ -153,3 +169,22 @@ func mapInstrumentType(instrument string) string {
 		return string(domain.InstrumentGauge)
 	}
 }
+
+// Stability maps a definition's stability onto the catalog's levels.
+// Older registries say experimental, which is now development, and a
+// deprecated definition is deprecated whatever level it states.
+func Stability(def MetricDefinition) string {
+	if def.Deprecated != "" {
+		return string(domain.StabilityDeprecated)
+	}
+	switch def.Stability {
+	case "experimental":
+		return string(domain.StabilityDevelopment)
+	case "release_candidate":
+		return string(domain.StabilityBeta)
+	}
+	if domain.StabilityLevel(def.Stability).IsValid() {
+		return def.Stability
+	}
+	return ""
+}
@@ -4,8 +4,8 @@ import (
 	"context"
 	"testing"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 func TestAdapterName(t *testing.T) {
@@ -94,3 +94,41 @@ func TestAdapterExtractIntegration(t *testing.T) {
 
 	t.Logf("Extracted %d metrics from semantic-conventions", len(metrics))
 }
+
+func TestInstrumentType(t *testing.T) {
+	tests := []struct {
+		instrument string
+		expected   string
+	}{
+		{"counter", "counter"},
+		{"updowncounter", "updowncounter"},
+		{"histogram", "histogram"},
+		{"gauge", "gauge"},
+		{"", "gauge"},
+	}
+
+	for _, tt := range tests {
+		if got := InstrumentType(tt.instrument); got != tt.expected {
+			t.Errorf("InstrumentType(%q) = %q, expected %q", tt.instrument, got, tt.expected)
+		}
+	}
+}
+
+func TestStability(t *testing.T) {
+	tests := []struct {
+		def      MetricDefinition
+		expected string
+	}{
+		{MetricDefinition{Stability: "stable"}, "stable"},
+		{MetricDefinition{Stability: "experimental"}, "development"},
+		{MetricDefinition{Stability: "release_candidate"}, "beta"},
+		{MetricDefinition{Stability: "stable", Deprecated: "Replaced by system.cpu.time"}, "deprecated"},
+		{MetricDefinition{Stability: "unheard_of"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := Stability(tt.def); got != tt.expected {
+			t.Errorf("Stability(%+v) = %q, expected %q", tt.def, got, tt.expected)
+		}
+	}
+}
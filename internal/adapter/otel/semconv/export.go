@@ -0,0 +1,245 @@
+package semconv
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// RegistryFile holds the attribute definitions of an exported registry.
+const RegistryFile = "registry.yaml"
+
+// Export writes metrics to dir as a Weaver registry: the manifest, every
+// attribute the metrics use in registry.yaml, and the metric groups of each
+// component in <component>/metrics.yaml. Resource attributes are left out,
+// since Weaver models them as entities rather than on metrics.
+func Export(dir string, manifest Manifest, metrics []*domain.CanonicalMetric) error {
+	if manifest.Name == "" {
+		return fmt.Errorf("registry name is required")
+	}
+
+	files, err := exportFiles(manifest, metrics)
+	if err != nil {
+		return err
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil { //nolint:gosec // registries are meant to be shared
+			return err
+		}
+	}
+	return nil
+}
+
+type exportGroup struct {
+	ID         string            `yaml:"id"`
+	Type       string            `yaml:"type"`
+	MetricName string            `yaml:"metric_name,omitempty"`
+	Brief      string            `yaml:"brief"`
+	Instrument string            `yaml:"instrument,omitempty"`
+	Unit       string            `yaml:"unit,omitempty"`
+	Stability  string            `yaml:"stability,omitempty"`
+	Deprecated map[string]string `yaml:"deprecated,omitempty"`
+	Attributes []exportAttribute `yaml:"attributes,omitempty"`
+}
+
+type exportAttribute struct {
+	ID               string      `yaml:"id,omitempty"`
+	Ref              string      `yaml:"ref,omitempty"`
+	Type             interface{} `yaml:"type,omitempty"`
+	Brief            string      `yaml:"brief,omitempty"`
+	Stability        string      `yaml:"stability,omitempty"`
+	RequirementLevel string      `yaml:"requirement_level,omitempty"`
+}
+
+type exportMember struct {
+	ID        string `yaml:"id"`
+	Value     string `yaml:"value"`
+	Stability string `yaml:"stability"`
+}
+
+func exportFiles(manifest Manifest, metrics []*domain.CanonicalMetric) (map[string][]byte, error) {
+	attributes := make(map[string]exportAttribute)
+	components := make(map[string][]exportGroup)
+	seen := make(map[string]bool)
+
+	for _, m := range metrics {
+		if seen[m.MetricName] || m.Signal == domain.SignalKindEvent {
+			continue
+		}
+		seen[m.MetricName] = true
+
+		g := exportGroup{
+			ID:         "metric." + m.MetricName,
+			Type:       "metric",
+			MetricName: m.MetricName,
+			Brief:      m.Description,
+			Instrument: exportInstrument(m.InstrumentType),
+			Unit:       m.Unit,
+			Stability:  exportStability(m.Stability),
+		}
+		if g.Unit == "" {
+			g.Unit = "1"
+		}
+		if m.Stability == domain.StabilityDeprecated {
+			g.Deprecated = map[string]string{"reason": "uncategorized"}
+		}
+
+		for _, a := range m.Attributes {
+			if a.Resource {
+				continue
+			}
+			if _, ok := attributes[a.Name]; !ok {
+				attributes[a.Name] = exportAttribute{
+					ID:        a.Name,
+					Type:      exportAttributeType(a),
+					Brief:     a.Description,
+					Stability: "development",
+				}
+			}
+			level := "recommended"
+			if a.Required {
+				level = "required"
+			}
+			g.Attributes = append(g.Attributes, exportAttribute{Ref: a.Name, RequirementLevel: level})
+		}
+
+		component := m.ComponentName
+		if component == "" {
+			component = "general"
+		}
+		components[component] = append(components[component], g)
+	}
+
+	files := make(map[string][]byte)
+
+	content, err := marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+	files[ManifestFile] = content
+
+	if len(attributes) > 0 {
+		names := make([]string, 0, len(attributes))
+		for name := range attributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		registry := exportGroup{
+			ID:    "registry." + manifest.Name,
+			Type:  "attribute_group",
+			Brief: "Attributes of the " + manifest.Name + " registry.",
+		}
+		for _, name := range names {
+			registry.Attributes = append(registry.Attributes, attributes[name])
+		}
+
+		content, err := marshal(map[string][]exportGroup{"groups": {registry}})
+		if err != nil {
+			return nil, err
+		}
+		files[RegistryFile] = content
+	}
+
+	for component, groups := range components {
+		sort.Slice(groups, func(i, j int) bool { return groups[i].MetricName < groups[j].MetricName })
+		content, err := marshal(map[string][]exportGroup{"groups": groups})
+		if err != nil {
+			return nil, err
+		}
+		files[componentDir(component)+"/metrics.yaml"] = content
+	}
+
+	return files, nil
+}
+
+// marshal indents as the upstream registry files do.
+func marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var unsafeComponent = regexp.MustCompile(`[^a-z0-9._-]+`)
+
+// componentDir turns a component name into a single directory name.
+func componentDir(component string) string {
+	dir := unsafeComponent.ReplaceAllString(strings.ToLower(component), "_")
+	if dir == "" || strings.Trim(dir, ".") == "" {
+		return "general"
+	}
+	return dir
+}
+
+// exportInstrument maps to Weaver's instruments, which have no summary; a
+// summary is closest to a histogram.
+func exportInstrument(t domain.InstrumentType) string {
+	switch t {
+	case domain.InstrumentCounter, domain.InstrumentUpDownCounter, domain.InstrumentHistogram:
+		return string(t)
+	case domain.InstrumentSummary:
+		return string(domain.InstrumentHistogram)
+	default:
+		return string(domain.InstrumentGauge)
+	}
+}
+
+// exportStability keeps the levels Weaver knows, treating everything else
+// as still in development.
+func exportStability(level domain.StabilityLevel) string {
+	switch level {
+	case domain.StabilityStable, domain.StabilityAlpha, domain.StabilityBeta:
+		return string(level)
+	default:
+		return string(domain.StabilityDevelopment)
+	}
+}
+
+var unsafeMember = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func exportAttributeType(a domain.Attribute) interface{} {
+	if len(a.Enum) > 0 {
+		members := make([]exportMember, 0, len(a.Enum))
+		for _, v := range a.Enum {
+			id := strings.Trim(unsafeMember.ReplaceAllString(strings.ToLower(v), "_"), "_")
+			if id == "" {
+				id = "value"
+			}
+			members = append(members, exportMember{ID: id, Value: v, Stability: "development"})
+		}
+		return map[string][]exportMember{"members": members}
+	}
+
+	switch a.Type {
+	case "int", "double", "boolean", "string[]", "int[]", "double[]", "boolean[]":
+		return a.Type
+	case "bool":
+		return "boolean"
+	case "int64":
+		return "int"
+	case "float", "float64":
+		return "double"
+	default:
+		return "string"
+	}
+}
@@ -0,0 +1,106 @@
+package semconv
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func TestExportRoundTrip(t *testing.T) {
+	metrics := []*domain.CanonicalMetric{
+		{
+			MetricName:     "checkout.orders",
+			InstrumentType: domain.InstrumentCounter,
+			Description:    "Orders placed.",
+			Unit:           "{order}",
+			ComponentName:  "checkout",
+			Stability:      domain.StabilityStable,
+			Attributes: []domain.Attribute{
+				{Name: "payment.method", Type: "string", Description: "How the order was paid.", Required: true, Enum: []string{"card", "Gift Card"}},
+				{Name: "service.name", Type: "string", Resource: true},
+			},
+		},
+		{
+			MetricName:     "checkout_latency_seconds",
+			InstrumentType: domain.InstrumentSummary,
+			Description:    "Checkout latency.",
+			ComponentName:  "checkout",
+			Attributes: []domain.Attribute{
+				{Name: "payment.method", Type: "string"},
+				{Name: "cached", Type: "bool"},
+			},
+		},
+		{
+			MetricName:    "checkout.failed",
+			Signal:        domain.SignalKindEvent,
+			ComponentName: "checkout",
+		},
+		{
+			MetricName:     "queue.depth",
+			InstrumentType: domain.InstrumentGauge,
+			ComponentName:  "Order Queue",
+			Stability:      domain.StabilityDeprecated,
+		},
+	}
+
+	dir := t.TempDir()
+	if err := Export(dir, Manifest{Name: "acme", SemconvVersion: "v1.0.0"}, metrics); err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	for _, name := range []string{ManifestFile, RegistryFile, "checkout/metrics.yaml", "order_queue/metrics.yaml"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err != nil {
+			t.Errorf("expected %s: %v", name, err)
+		}
+	}
+
+	reg, err := ParseRegistry(dir)
+	if err != nil {
+		t.Fatalf("ParseRegistry failed: %v", err)
+	}
+	if reg.Manifest.Name != "acme" || reg.Manifest.SemconvVersion != "v1.0.0" {
+		t.Errorf("unexpected manifest %+v", reg.Manifest)
+	}
+	if len(reg.Metrics) != 3 {
+		t.Fatalf("expected 3 metrics without the event, got %d", len(reg.Metrics))
+	}
+	if _, ok := reg.Attributes["service.name"]; ok {
+		t.Error("expected resource attributes left out")
+	}
+	if reg.Attributes["cached"].Type != "boolean" {
+		t.Errorf("expected bool exported as boolean, got %q", reg.Attributes["cached"].Type)
+	}
+
+	byName := make(map[string]MetricDefinition)
+	for _, m := range reg.Metrics {
+		byName[m.Name] = m
+	}
+
+	orders := byName["checkout.orders"]
+	if orders.Instrument != "counter" || orders.Unit != "{order}" || orders.Stability != "stable" {
+		t.Errorf("unexpected orders definition %+v", orders)
+	}
+	if len(orders.Attributes) != 1 || orders.Attributes[0].RequirementLevel != "required" {
+		t.Fatalf("unexpected orders attributes %+v", orders.Attributes)
+	}
+	if members := orders.Attributes[0].Members; len(members) != 2 || members[1] != "Gift Card" {
+		t.Errorf("expected enum values kept, got %v", members)
+	}
+
+	latency := byName["checkout_latency_seconds"]
+	if latency.Instrument != "histogram" || latency.Unit != "1" || latency.Stability != "development" {
+		t.Errorf("unexpected latency definition %+v", latency)
+	}
+
+	if byName["queue.depth"].Deprecated == "" {
+		t.Error("expected deprecated metric marked deprecated")
+	}
+}
+
+func TestExportRequiresName(t *testing.T) {
+	if err := Export(t.TempDir(), Manifest{}, nil); err == nil {
+		t.Error("expected error without a registry name")
+	}
+}
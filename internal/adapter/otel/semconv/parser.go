@@ -1,23 +1,36 @@
 package semconv
 
 import (
+	"fmt"
 	"os"
 
 	"gopkg.in/yaml.v3"
 )
 
 type MetricDefinition struct {
+	ID         string
 	Name       string
 	Brief      string
+	Note       string
 	Instrument string
 	Unit       string
 	Stability  string
+	// Deprecated explains the deprecation, empty when the metric isn't
+	Deprecated string
 	Attributes []AttributeRef
+	// File is the definition's path relative to the registry root, set by
+	// ParseRegistry
+	File string
 }
 
 type AttributeRef struct {
 	Ref              string
 	RequirementLevel string
+	// Type, Brief and Members are filled in from the attribute's definition
+	// when ParseRegistry can resolve it
+	Type    string
+	Brief   string
+	Members []string
 }
 
 type metricsFile struct {
@@ -29,14 +42,21 @@ type group struct {
 	Type       string        `yaml:"type"`
 	MetricName string        `yaml:"metric_name"`
 	Brief      string        `yaml:"brief"`
+	Note       string        `yaml:"note"`
 	Instrument string        `yaml:"instrument"`
 	Unit       string        `yaml:"unit"`
 	Stability  string        `yaml:"stability"`
+	Deprecated interface{}   `yaml:"deprecated"`
+	Extends    string        `yaml:"extends"`
 	Attributes []attrRefYAML `yaml:"attributes"`
 }
 
 type attrRefYAML struct {
 	Ref              string      `yaml:"ref"`
+	ID               string      `yaml:"id"`
+	Type             interface{} `yaml:"type"`
+	Brief            string      `yaml:"brief"`
+	Stability        string      `yaml:"stability"`
 	RequirementLevel interface{} `yaml:"requirement_level"`
 }
 
@@ -46,46 +66,113 @@ func ParseFile(filePath string) ([]MetricDefinition, error) {
 		return nil, err
 	}
 
+	groups, err := parseGroups(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var defs []MetricDefinition
+	for _, g := range groups {
+		if g.Type == "metric" {
+			defs = append(defs, g.metricDefinition())
+		}
+	}
+
+	return defs, nil
+}
+
+func parseGroups(data []byte) ([]group, error) {
 	var file metricsFile
 	if err := yaml.Unmarshal(data, &file); err != nil {
 		return nil, err
 	}
+	return file.Groups, nil
+}
 
-	var defs []MetricDefinition
+func (g group) metricDefinition() MetricDefinition {
+	def := MetricDefinition{
+		ID:         g.ID,
+		Name:       g.MetricName,
+		Brief:      g.Brief,
+		Note:       g.Note,
+		Instrument: g.Instrument,
+		Unit:       g.Unit,
+		Stability:  g.Stability,
+		Deprecated: deprecation(g.Deprecated),
+	}
 
-	for _, g := range file.Groups {
-		if g.Type != "metric" {
-			continue
-		}
+	for _, attr := range g.Attributes {
+		def.Attributes = append(def.Attributes, attr.attributeRef())
+	}
 
-		def := MetricDefinition{
-			Name:       g.MetricName,
-			Brief:      g.Brief,
-			Instrument: g.Instrument,
-			Unit:       g.Unit,
-			Stability:  g.Stability,
+	return def
+}
+
+// attributeRef reads a reference to an attribute, or an attribute defined
+// in place, which older registries allow.
+func (a attrRefYAML) attributeRef() AttributeRef {
+	ref := AttributeRef{
+		Ref:   a.Ref,
+		Brief: a.Brief,
+	}
+	if ref.Ref == "" {
+		ref.Ref = a.ID
+	}
+	ref.Type, ref.Members = attributeType(a.Type)
+
+	switch v := a.RequirementLevel.(type) {
+	case string:
+		ref.RequirementLevel = v
+	case map[string]interface{}:
+		for key := range v {
+			ref.RequirementLevel = key
+			break
 		}
+	}
 
-		for _, attr := range g.Attributes {
-			ref := AttributeRef{
-				Ref: attr.Ref,
-			}
+	return ref
+}
 
-			switch v := attr.RequirementLevel.(type) {
-			case string:
-				ref.RequirementLevel = v
-			case map[string]interface{}:
-				for key := range v {
-					ref.RequirementLevel = key
-					break
-				}
+// attributeType reads a primitive type name, or an enum's members, which
+// are reported as a string type.
+func attributeType(v interface{}) (string, []string) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case map[string]interface{}:
+		members, _ := t["members"].([]interface{})
+		var values []string
+		for _, m := range members {
+			member, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if value, ok := member["value"]; ok {
+				values = append(values, fmt.Sprint(value))
 			}
-
-			def.Attributes = append(def.Attributes, ref)
 		}
-
-		defs = append(defs, def)
+		return "string", values
 	}
+	return "", nil
+}
 
-	return defs, nil
+// deprecation reads deprecated as either the older free text or the
+// structured form with a reason and, for renames, the new name.
+func deprecation(v interface{}) string {
+	switch d := v.(type) {
+	case string:
+		return d
+	case map[string]interface{}:
+		if renamed, ok := d["renamed_to"].(string); ok && renamed != "" {
+			return "renamed to " + renamed
+		}
+		if note, ok := d["note"].(string); ok && note != "" {
+			return note
+		}
+		if reason, ok := d["reason"].(string); ok {
+			return reason
+		}
+		return "deprecated"
+	}
+	return ""
 }
@@ -0,0 +1,181 @@
+package semconv
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFile names a Weaver registry and its dependencies. It sits at the
+// registry root.
+const ManifestFile = "registry_manifest.yaml"
+
+type Manifest struct {
+	Name           string       `yaml:"name"`
+	Description    string       `yaml:"description,omitempty"`
+	SemconvVersion string       `yaml:"semconv_version,omitempty"`
+	SchemaBaseURL  string       `yaml:"schema_base_url,omitempty"`
+	Dependencies   []Dependency `yaml:"dependencies,omitempty"`
+}
+
+type Dependency struct {
+	Name         string `yaml:"name"`
+	RegistryPath string `yaml:"registry_path"`
+}
+
+type AttributeDefinition struct {
+	ID        string
+	Type      string
+	Brief     string
+	Stability string
+	Members   []string
+}
+
+// Registry is a Weaver semantic convention registry: every group in every
+// YAML file under its root, with attribute references resolved where the
+// registry defines them.
+type Registry struct {
+	Manifest   Manifest
+	Metrics    []MetricDefinition
+	Attributes map[string]AttributeDefinition
+}
+
+// ParseRegistry reads the Weaver registry rooted at root. Metrics pick up
+// the attributes of the groups they extend; references to attributes the
+// registry doesn't define, such as those of a dependency, are kept as they
+// are.
+func ParseRegistry(root string) (*Registry, error) {
+	reg := &Registry{Attributes: make(map[string]AttributeDefinition)}
+	groups := make(map[string]group)
+	var metricGroups []group
+	files := make(map[string]string)
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if path != root && strings.HasPrefix(d.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		ext := filepath.Ext(path)
+		if ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+
+		data, err := os.ReadFile(path) //nolint:gosec // path is under the registry root
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel == ManifestFile {
+			if err := yaml.Unmarshal(data, &reg.Manifest); err != nil {
+				return fmt.Errorf("%s: %w", rel, err)
+			}
+			return nil
+		}
+
+		parsed, err := parseGroups(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+		for _, g := range parsed {
+			groups[g.ID] = g
+			files[g.ID] = rel
+			if g.Type == "metric" {
+				metricGroups = append(metricGroups, g)
+			}
+			for _, a := range g.Attributes {
+				if a.ID == "" {
+					continue
+				}
+				attrType, members := attributeType(a.Type)
+				reg.Attributes[a.ID] = AttributeDefinition{
+					ID:        a.ID,
+					Type:      attrType,
+					Brief:     a.Brief,
+					Stability: a.Stability,
+					Members:   members,
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, g := range metricGroups {
+		def := g.metricDefinition()
+		def.File = files[g.ID]
+		def.Attributes = reg.resolve(inherited(g, groups, nil), def.Attributes)
+		reg.Metrics = append(reg.Metrics, def)
+	}
+	sort.Slice(reg.Metrics, func(i, j int) bool {
+		return reg.Metrics[i].Name < reg.Metrics[j].Name
+	})
+
+	return reg, nil
+}
+
+// inherited collects the attributes of the groups g extends, nearest last
+// so that they win.
+func inherited(g group, groups map[string]group, seen map[string]bool) []AttributeRef {
+	parent, ok := groups[g.Extends]
+	if g.Extends == "" || !ok || seen[g.Extends] {
+		return nil
+	}
+	if seen == nil {
+		seen = make(map[string]bool)
+	}
+	seen[g.Extends] = true
+
+	refs := inherited(parent, groups, seen)
+	for _, a := range parent.Attributes {
+		refs = append(refs, a.attributeRef())
+	}
+	return refs
+}
+
+// resolve merges inherited and own attribute references, own taking
+// precedence, and fills in what the registry defines about each.
+func (r *Registry) resolve(inherited, own []AttributeRef) []AttributeRef {
+	var refs []AttributeRef
+	index := make(map[string]int)
+	for _, ref := range append(inherited, own...) {
+		if ref.Ref == "" {
+			continue
+		}
+		if def, ok := r.Attributes[ref.Ref]; ok {
+			if ref.Type == "" {
+				ref.Type = def.Type
+			}
+			if ref.Brief == "" {
+				ref.Brief = def.Brief
+			}
+			if ref.Members == nil {
+				ref.Members = def.Members
+			}
+		}
+		if i, ok := index[ref.Ref]; ok {
+			refs[i] = ref
+			continue
+		}
+		index[ref.Ref] = len(refs)
+		refs = append(refs, ref)
+	}
+	return refs
+}
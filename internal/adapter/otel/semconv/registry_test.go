@@ -0,0 +1,140 @@
+package semconv
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const acmeManifest = `name: acme
+description: Acme semantic conventions
+semconv_version: v0.3.0
+schema_base_url: https://acme.example/schemas/
+dependencies:
+  - name: otel
+    registry_path: https://github.com/open-telemetry/semantic-conventions/archive/refs/tags/v1.34.0.zip[model]
+`
+
+const acmeAttributes = `groups:
+  - id: registry.acme.payment
+    type: attribute_group
+    brief: Payment attributes.
+    attributes:
+      - id: acme.payment.method
+        type:
+          members:
+            - id: card
+              value: "card"
+            - id: wallet
+              value: "wallet"
+        stability: development
+        brief: How the customer paid.
+      - id: acme.payment.retries
+        type: int
+        stability: development
+        brief: Attempts before the payment went through.
+  - id: attributes.acme.payment.common
+    type: attribute_group
+    brief: Attributes on every payment metric.
+    attributes:
+      - ref: acme.payment.method
+        requirement_level: required
+`
+
+const acmeMetrics = `groups:
+  - id: metric.acme.payment.duration
+    type: metric
+    metric_name: acme.payment.duration
+    brief: Time taken to authorize a payment.
+    instrument: histogram
+    unit: s
+    stability: development
+    extends: attributes.acme.payment.common
+    attributes:
+      - ref: acme.payment.retries
+        requirement_level: recommended
+      - ref: server.address
+        requirement_level: opt_in
+  - id: metric.acme.payment.count
+    type: metric
+    metric_name: acme.payment.count
+    brief: Payments taken.
+    instrument: counter
+    unit: "{payment}"
+    stability: stable
+    deprecated:
+      reason: renamed
+      renamed_to: acme.payment.duration
+`
+
+func writeRegistry(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestParseRegistry(t *testing.T) {
+	root := writeRegistry(t, map[string]string{
+		ManifestFile:               acmeManifest,
+		"registry/payment.yaml":    acmeAttributes,
+		"payment/metrics.yaml":     acmeMetrics,
+		"payment/notes.md":         "not a registry file",
+		".github/workflows/ci.yml": "on: push",
+	})
+
+	reg, err := ParseRegistry(root)
+	if err != nil {
+		t.Fatalf("ParseRegistry failed: %v", err)
+	}
+
+	if reg.Manifest.Name != "acme" || reg.Manifest.SemconvVersion != "v0.3.0" || len(reg.Manifest.Dependencies) != 1 {
+		t.Errorf("unexpected manifest %+v", reg.Manifest)
+	}
+	if len(reg.Attributes) != 2 {
+		t.Errorf("expected 2 attribute definitions, got %d", len(reg.Attributes))
+	}
+	if len(reg.Metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(reg.Metrics))
+	}
+
+	count, duration := reg.Metrics[0], reg.Metrics[1]
+	if count.Deprecated != "renamed to acme.payment.duration" {
+		t.Errorf("unexpected deprecation %q", count.Deprecated)
+	}
+	if duration.File != "payment/metrics.yaml" {
+		t.Errorf("expected file relative to the root, got %s", duration.File)
+	}
+
+	if len(duration.Attributes) != 3 {
+		t.Fatalf("expected inherited and own attributes, got %+v", duration.Attributes)
+	}
+	method, retries, server := duration.Attributes[0], duration.Attributes[1], duration.Attributes[2]
+	if method.Ref != "acme.payment.method" || method.RequirementLevel != "required" || method.Type != "string" {
+		t.Errorf("unexpected inherited attribute %+v", method)
+	}
+	if len(method.Members) != 2 || method.Members[1] != "wallet" {
+		t.Errorf("expected enum members, got %v", method.Members)
+	}
+	if retries.Type != "int" || retries.Brief != "Attempts before the payment went through." {
+		t.Errorf("expected resolved definition, got %+v", retries)
+	}
+	if server.Ref != "server.address" || server.Type != "" {
+		t.Errorf("expected dependency reference kept unresolved, got %+v", server)
+	}
+}
+
+func TestParseRegistryInvalidYAML(t *testing.T) {
+	root := writeRegistry(t, map[string]string{"broken.yaml": "groups: ["})
+	if _, err := ParseRegistry(root); err == nil {
+		t.Error("expected error for invalid YAML")
+	}
+}
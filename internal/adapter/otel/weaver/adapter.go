@@ -0,0 +1,177 @@
+package weaver
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/adapter/otel/semconv"
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Adapter imports a Weaver semantic convention registry, such as an
+// organization's own registry extending the OpenTelemetry conventions, from
+// a directory or a git repository.
+type Adapter struct {
+	sourceName string
+	location   string
+	subdir     string
+	fetcher    *fetcher.GitFetcher
+}
+
+// The weaver adapter is registered under its default source name;
+// Options.Source names the registry's metrics, and is what enrichment is
+// pointed at.
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "weaver",
+		Category:    domain.SourceOTEL,
+		Description: "Weaver semantic convention registry from a directory or git repository",
+		Tags:        []string{"otel", "semconv"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			if opts.File == "" {
+				return nil, fmt.Errorf("weaver adapter requires a registry directory or git URL")
+			}
+			source := opts.Source
+			if source == "" {
+				source = "weaver"
+			}
+			return NewAdapter(opts.CacheDir, source, opts.File), nil
+		},
+	})
+}
+
+// NewAdapter reads the registry at location, a directory or git URL. As
+// with Weaver, a trailing [dir] names the registry's directory within it,
+// e.g. https://github.com/acme/semconv[model].
+func NewAdapter(cacheDir, sourceName, location string) *Adapter {
+	a := &Adapter{
+		sourceName: sourceName,
+		location:   location,
+		fetcher:    fetcher.NewGitFetcher(cacheDir),
+	}
+	if i := strings.LastIndex(location, "["); i > 0 && strings.HasSuffix(location, "]") {
+		a.location = location[:i]
+		a.subdir = location[i+1 : len(location)-1]
+	}
+	return a
+}
+
+func (a *Adapter) Name() string {
+	return a.sourceName
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceOTEL
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMetadata
+}
+
+func (a *Adapter) RepoURL() string {
+	if a.remote() {
+		return a.location
+	}
+	return ""
+}
+
+func (a *Adapter) remote() bool {
+	return strings.HasPrefix(a.location, "https://") || strings.HasPrefix(a.location, "http://") ||
+		strings.HasPrefix(a.location, "git@") || strings.HasSuffix(a.location, ".git")
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	if !a.remote() {
+		root := filepath.Join(a.location, a.subdir)
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("registry %s is not a directory", root)
+		}
+		return &adapter.FetchResult{
+			RepoPath:  root,
+			Commit:    "local",
+			Timestamp: info.ModTime(),
+		}, nil
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetcher.FetchOptions{
+		RepoURL: a.location,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  filepath.Join(result.RepoPath, a.subdir),
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	reg, err := semconv.ParseRegistry(result.RepoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry: %w", err)
+	}
+
+	metrics := make([]*adapter.RawMetric, 0, len(reg.Metrics))
+	for _, def := range reg.Metrics {
+		attrs := make([]domain.Attribute, 0, len(def.Attributes))
+		for _, ref := range def.Attributes {
+			attrType := ref.Type
+			if attrType == "" {
+				attrType = "string"
+			}
+			attrs = append(attrs, domain.Attribute{
+				Name:        ref.Ref,
+				Type:        attrType,
+				Description: ref.Brief,
+				Required:    ref.RequirementLevel == "required",
+				Enum:        ref.Members,
+			})
+		}
+
+		metrics = append(metrics, &adapter.RawMetric{
+			Name:             def.Name,
+			Description:      def.Brief,
+			InstrumentType:   semconv.InstrumentType(def.Instrument),
+			Unit:             def.Unit,
+			Attributes:       attrs,
+			EnabledByDefault: true,
+			ComponentType:    string(domain.ComponentInstrumentation),
+			ComponentName:    componentName(def.File),
+			SourceLocation:   def.File,
+			Path:             def.File,
+			Stability:        semconv.Stability(def),
+		})
+	}
+
+	return metrics, nil
+}
+
+// componentName follows the otel-semconv adapter, naming components after
+// the directory a definition is in.
+func componentName(file string) string {
+	dir := path.Dir(file)
+	if dir == "." {
+		return "general"
+	}
+	return strings.ReplaceAll(dir, "/", ".")
+}
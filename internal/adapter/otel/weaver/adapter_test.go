@@ -0,0 +1,106 @@
+package weaver
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const registry = `groups:
+  - id: registry.acme
+    type: attribute_group
+    brief: Acme attributes.
+    attributes:
+      - id: acme.tenant
+        type: string
+        stability: development
+        brief: Tenant the request was for.
+  - id: metric.acme.jobs.active
+    type: metric
+    metric_name: acme.jobs.active
+    brief: Jobs currently running.
+    instrument: updowncounter
+    unit: "{job}"
+    stability: experimental
+    attributes:
+      - ref: acme.tenant
+        requirement_level: required
+  - id: metric.acme.jobs.queued
+    type: metric
+    metric_name: acme.jobs.queued
+    brief: Jobs waiting to run.
+    instrument: gauge
+    unit: "{job}"
+    stability: stable
+    deprecated: Use acme.jobs.pending.
+`
+
+func TestNewAdapterLocation(t *testing.T) {
+	a := NewAdapter(".cache", "acme", "https://github.com/acme/semconv[model]")
+	if a.location != "https://github.com/acme/semconv" || a.subdir != "model" {
+		t.Errorf("unexpected location %s and dir %s", a.location, a.subdir)
+	}
+	if a.RepoURL() != "https://github.com/acme/semconv" {
+		t.Errorf("expected remote repo URL, got %s", a.RepoURL())
+	}
+
+	local := NewAdapter(".cache", "acme", "./semconv")
+	if local.RepoURL() != "" || local.subdir != "" {
+		t.Errorf("expected local registry, got %s [%s]", local.RepoURL(), local.subdir)
+	}
+}
+
+func TestAdapterExtract(t *testing.T) {
+	root := t.TempDir()
+	path := filepath.Join(root, "model", "jobs", "metrics.yaml")
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(registry), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewAdapter(t.TempDir(), "acme-semconv", root+"[model]")
+	if a.Name() != "acme-semconv" {
+		t.Errorf("expected source name as adapter name, got %s", a.Name())
+	}
+
+	ctx := context.Background()
+	result, err := a.Fetch(ctx, adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	metrics, err := a.Extract(ctx, result)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(metrics))
+	}
+
+	active, queued := metrics[0], metrics[1]
+	if active.Name != "acme.jobs.active" || active.InstrumentType != "updowncounter" || active.ComponentName != "jobs" {
+		t.Errorf("unexpected metric %+v", active)
+	}
+	if active.Stability != string(domain.StabilityDevelopment) {
+		t.Errorf("expected experimental read as development, got %s", active.Stability)
+	}
+	if len(active.Attributes) != 1 || !active.Attributes[0].Required || active.Attributes[0].Description != "Tenant the request was for." {
+		t.Errorf("unexpected attributes %+v", active.Attributes)
+	}
+	if queued.Stability != string(domain.StabilityDeprecated) {
+		t.Errorf("expected deprecated metric, got %s", queued.Stability)
+	}
+}
+
+func TestAdapterFetchMissingDirectory(t *testing.T) {
+	a := NewAdapter(t.TempDir(), "acme", filepath.Join(t.TempDir(), "missing"))
+	if _, err := a.Fetch(context.Background(), adapter.FetchOptions{}); err == nil {
+		t.Error("expected error for a missing registry")
+	}
+}
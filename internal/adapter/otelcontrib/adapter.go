@@ -3,12 +3,10 @@ package otelcontrib
 import (
 	"context"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/discovery"
-	"github.com/base-14/metric-library/internal/domain"
-	"github.com/base-14/metric-library/internal/extractor"
+	"github.com/base-14/metric-library/internal/adapter/collectormetadata"
 	"github.com/base-14/metric-library/internal/fetcher"
-	"github.com/base-14/metric-library/internal/parser"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const (
@@ -17,16 +15,27 @@ const (
 )
 
 type Adapter struct {
-	fetcher   *fetcher.GitFetcher
-	discovery *discovery.MetadataDiscovery
-	parser    *parser.MetadataParser
+	fetcher  *fetcher.GitFetcher
+	pipeline *collectormetadata.Pipeline
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "otel-collector-contrib",
+		Category:    domain.SourceOTEL,
+		Description: "OpenTelemetry Collector contrib components, from their metadata.yaml",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"otel", "collector"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
 }
 
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
-		fetcher:   fetcher.NewGitFetcher(cacheDir),
-		discovery: discovery.NewMetadataDiscovery(),
-		parser:    parser.NewMetadataParser(),
+		fetcher:  fetcher.NewGitFetcher(cacheDir),
+		pipeline: collectormetadata.NewPipeline(sourceName),
 	}
 }
 
@@ -72,42 +81,9 @@ func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapte
 }
 
 func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
-	files, err := a.discovery.FindMetadataFiles(result.RepoPath)
-	if err != nil {
-		return nil, err
-	}
-
-	var metrics []*adapter.RawMetric
-
-	for _, file := range files {
-		meta, err := a.parser.ParseFile(file.Path)
-		if err != nil {
-			continue
-		}
-
-		ext := extractor.NewMetricExtractor(sourceName, file.ComponentName, file.ComponentType)
-		extracted, err := ext.Extract(meta)
-		if err != nil {
-			continue
-		}
-
-		for _, m := range extracted {
-			rawMetric := &adapter.RawMetric{
-				Name:             m.MetricName,
-				Description:      m.Description,
-				Unit:             m.Unit,
-				InstrumentType:   string(m.InstrumentType),
-				Attributes:       m.Attributes,
-				EnabledByDefault: m.EnabledByDefault,
-				ComponentName:    file.ComponentName,
-				ComponentType:    file.ComponentType,
-				SourceLocation:   file.Path,
-				Path:             file.Path,
-			}
-
-			metrics = append(metrics, rawMetric)
-		}
-	}
+	return a.pipeline.Metrics(result.RepoPath)
+}
 
-	return metrics, nil
+func (a *Adapter) ExtractComponents(ctx context.Context, result *adapter.FetchResult) ([]*domain.Component, error) {
+	return a.pipeline.Components(result.RepoPath)
 }
@@ -6,8 +6,8 @@ import (
 	"path/filepath"
 	"testing"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 func TestOTelContribAdapter_Name(t *testing.T) {
@@ -48,6 +48,7 @@ func TestOTelContribAdapter_RepoURL(t *testing.T) {
 func TestOTelContribAdapter_ImplementsAdapter(t *testing.T) {
 	a := NewAdapter("/tmp/cache")
 	var _ adapter.Adapter = a
+	var _ adapter.ComponentExtractor = a
 }
 
 func TestOTelContribAdapter_Extract(t *testing.T) {
@@ -152,3 +153,125 @@ func TestOTelContribAdapter_Extract_EmptyRepo(t *testing.T) {
 		t.Errorf("expected 0 metrics, got %d", len(metrics))
 	}
 }
+
+func TestOTelContribAdapter_ExtractComponents(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "otelcontrib-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	content := `
+type: mysqlreceiver
+
+status:
+  class: receiver
+  stability:
+    beta: [metrics]
+  distributions: [contrib]
+  codeowners:
+    active: [antonblock]
+
+metrics:
+  mysql.commands:
+    enabled: false
+    unit: "{commands}"
+    sum:
+      value_type: int
+      monotonic: true
+      aggregation_temporality: cumulative
+    warnings:
+      if_enabled_not_set: This metric will be enabled by default in the next release.
+`
+	fullPath := filepath.Join(tmpDir, "receiver/mysqlreceiver/metadata.yaml")
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	a := NewAdapter("/tmp/cache")
+	components, err := a.ExtractComponents(context.Background(), &adapter.FetchResult{RepoPath: tmpDir})
+	if err != nil {
+		t.Fatalf("ExtractComponents failed: %v", err)
+	}
+
+	if len(components) != 1 {
+		t.Fatalf("expected 1 component, got %d", len(components))
+	}
+
+	c := components[0]
+	if c.Name != "mysqlreceiver" || c.Type != domain.ComponentReceiver {
+		t.Errorf("unexpected component: %s/%s", c.Type, c.Name)
+	}
+	if c.Stability["metrics"] != domain.StabilityBeta {
+		t.Errorf("unexpected stability: %v", c.Stability)
+	}
+	if c.Path != fullPath {
+		t.Errorf("unexpected path: %q", c.Path)
+	}
+	if len(c.Warnings) != 1 || c.Warnings[0].Kind != "if_enabled_not_set" {
+		t.Errorf("unexpected warnings: %v", c.Warnings)
+	}
+}
+
+func TestOTelContribAdapter_Extract_EventsAndTelemetry(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "otelcontrib-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	content := `
+type: batch
+
+status:
+  class: processor
+
+events:
+  batch.dropped:
+    enabled: true
+    description: A batch was dropped.
+
+telemetry:
+  metrics:
+    processor_batch_batch_send_size:
+      enabled: true
+      unit: "{units}"
+      histogram:
+        value_type: int
+`
+	fullPath := filepath.Join(tmpDir, "processor/batchprocessor/metadata.yaml")
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	a := NewAdapter("/tmp/cache")
+	metrics, err := a.Extract(context.Background(), &adapter.FetchResult{RepoPath: tmpDir})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	if len(metrics) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(metrics))
+	}
+
+	for _, m := range metrics {
+		switch m.Name {
+		case "batch.dropped":
+			if m.Signal != string(domain.SignalKindEvent) || m.ComponentType != "processor" {
+				t.Errorf("unexpected event: signal %q component type %q", m.Signal, m.ComponentType)
+			}
+		case "processor_batch_batch_send_size":
+			if m.ComponentType != string(domain.ComponentTelemetry) || m.ComponentName != "batchprocessor" {
+				t.Errorf("unexpected telemetry component: %s/%s", m.ComponentType, m.ComponentName)
+			}
+		default:
+			t.Errorf("unexpected entry %q", m.Name)
+		}
+	}
+}
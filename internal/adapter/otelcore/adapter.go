@@ -0,0 +1,89 @@
+package otelcore
+
+import (
+	"context"
+
+	"github.com/base-14/metric-library/internal/adapter/collectormetadata"
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const (
+	repoURL    = "https://github.com/open-telemetry/opentelemetry-collector"
+	sourceName = "opentelemetry-collector"
+)
+
+type Adapter struct {
+	fetcher  *fetcher.GitFetcher
+	pipeline *collectormetadata.Pipeline
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "otel-collector-core",
+		Category:    domain.SourceOTEL,
+		Description: "OpenTelemetry Collector core components, from their metadata.yaml",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"otel", "collector"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher:  fetcher.NewGitFetcher(cacheDir),
+		pipeline: collectormetadata.NewPipeline(sourceName),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "otel-collector-core"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceOTEL
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMetadata
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	return a.pipeline.Metrics(result.RepoPath)
+}
+
+func (a *Adapter) ExtractComponents(ctx context.Context, result *adapter.FetchResult) ([]*domain.Component, error) {
+	return a.pipeline.Components(result.RepoPath)
+}
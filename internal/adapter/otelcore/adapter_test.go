@@ -0,0 +1,104 @@
+package otelcore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func TestOTelCoreAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "otel-collector-core" {
+		t.Errorf("expected name 'otel-collector-core', got %q", a.Name())
+	}
+}
+
+func TestOTelCoreAdapter_RepoURL(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.RepoURL() != "https://github.com/open-telemetry/opentelemetry-collector" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestOTelCoreAdapter_ImplementsAdapter(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	var _ adapter.Adapter = a
+	var _ adapter.ComponentExtractor = a
+}
+
+func TestOTelCoreAdapter_Extract(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "otelcore-test-*")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	structure := map[string]string{
+		"processor/batchprocessor/metadata.yaml": `
+type: batch
+
+status:
+  class: processor
+  stability:
+    beta: [traces, metrics, logs]
+
+telemetry:
+  metrics:
+    processor_batch_batch_send_size:
+      enabled: true
+      unit: "{units}"
+      histogram:
+        value_type: int
+`,
+		"service/metadata.yaml": `
+type: service
+
+status:
+  class: pkg
+
+telemetry:
+  metrics:
+    process_uptime:
+      enabled: true
+      unit: s
+      sum:
+        value_type: double
+        monotonic: true
+`,
+	}
+
+	for path, content := range structure {
+		fullPath := filepath.Join(tmpDir, path)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+
+	a := NewAdapter("/tmp/cache")
+	metrics, err := a.Extract(context.Background(), &adapter.FetchResult{RepoPath: tmpDir})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	components := make(map[string]string)
+	for _, m := range metrics {
+		if m.ComponentType != string(domain.ComponentTelemetry) {
+			t.Errorf("expected %s to be catalogued as telemetry, got %q", m.Name, m.ComponentType)
+		}
+		components[m.Name] = m.ComponentName
+	}
+
+	if components["processor_batch_batch_send_size"] != "batchprocessor" {
+		t.Errorf("unexpected component for batch send size: %q", components["processor_batch_batch_send_size"])
+	}
+	if components["process_uptime"] != "service" {
+		t.Errorf("unexpected component for process uptime: %q", components["process_uptime"])
+	}
+}
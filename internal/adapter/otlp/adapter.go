@@ -0,0 +1,131 @@
+package otlp
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Adapter catalogs the metrics present in OTLP payloads, such as the output
+// of the collector's file exporter, under a caller-chosen source name.
+type Adapter struct {
+	sourceName string
+	files      []string
+}
+
+// The otlp adapter is registered under its default source name; Options.Source
+// renames what it stores.
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "otlp",
+		Category:    domain.SourceOTEL,
+		Description: "Metrics observed in OTLP payloads such as collector file exporter output",
+		Tags:        []string{"otel", "observed"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			if opts.File == "" {
+				return nil, fmt.Errorf("otlp adapter requires a file")
+			}
+			source := opts.Source
+			if source == "" {
+				source = "otlp"
+			}
+			return NewAdapter(source, strings.Split(opts.File, ",")), nil
+		},
+	})
+}
+
+func NewAdapter(sourceName string, files []string) *Adapter {
+	return &Adapter{
+		sourceName: sourceName,
+		files:      files,
+	}
+}
+
+func (a *Adapter) Name() string {
+	return a.sourceName
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceOTEL
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceDerived
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionScrape
+}
+
+func (a *Adapter) RepoURL() string {
+	return ""
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	if a.sourceName == "" {
+		return nil, fmt.Errorf("source name is required")
+	}
+	if len(a.files) == 0 {
+		return nil, fmt.Errorf("at least one OTLP file is required")
+	}
+
+	h := sha256.New()
+	for _, path := range a.files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OTLP payload: %w", err)
+		}
+		h.Write(data)
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  filepath.Dir(a.files[0]),
+		Commit:    hex.EncodeToString(h.Sum(nil))[:12],
+		Timestamp: time.Now(),
+		Files:     a.files,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	var metrics []*adapter.RawMetric
+
+	for _, path := range result.Files {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read OTLP payload: %w", err)
+		}
+
+		batches, err := DecodeFile(path, data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+
+		metrics = append(metrics, Derive(batches, path)...)
+	}
+
+	return metrics, nil
+}
+
+// DecodeFile picks the encoding from the file extension, falling back to
+// trying JSON and then protobuf.
+func DecodeFile(path string, data []byte) ([]*MetricsData, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json", ".jsonl", ".ndjson":
+		return DecodeJSON(data)
+	case ".pb", ".binpb", ".proto", ".bin":
+		return DecodeProtobuf(data)
+	}
+
+	if batches, err := DecodeJSON(data); err == nil {
+		return batches, nil
+	}
+	return DecodeProtobuf(data)
+}
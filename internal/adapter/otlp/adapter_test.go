@@ -0,0 +1,119 @@
+package otlp
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func TestAdapterProperties(t *testing.T) {
+	a := NewAdapter("fleet", []string{"metrics.json"})
+
+	if a.Name() != "fleet" {
+		t.Errorf("expected name to be the source name, got %s", a.Name())
+	}
+	if a.SourceCategory() != domain.SourceOTEL {
+		t.Errorf("expected source category otel, got %s", a.SourceCategory())
+	}
+	if a.Confidence() != domain.ConfidenceDerived {
+		t.Errorf("expected confidence derived, got %s", a.Confidence())
+	}
+	if a.ExtractionMethod() != domain.ExtractionScrape {
+		t.Errorf("expected extraction method scrape, got %s", a.ExtractionMethod())
+	}
+}
+
+func TestAdapterImplementsInterface(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("fleet", nil)
+}
+
+func TestAdapterExtract(t *testing.T) {
+	dir := t.TempDir()
+	jsonPath := filepath.Join(dir, "metrics.json")
+	protoPath := filepath.Join(dir, "metrics.pb")
+	if err := os.WriteFile(jsonPath, []byte(sampleJSON), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(protoPath, sampleProto(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewAdapter("fleet", []string{jsonPath, protoPath})
+	ctx := context.Background()
+
+	result, err := a.Fetch(ctx, adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+
+	metrics, err := a.Extract(ctx, result)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(metrics) != 5 {
+		t.Fatalf("expected 5 metrics, got %d", len(metrics))
+	}
+
+	byName := make(map[string]*adapter.RawMetric)
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+
+	hist := byName["http.server.request.duration"]
+	if hist.InstrumentType != "histogram" || hist.Unit != "s" || hist.AggregationTemporality != "cumulative" {
+		t.Errorf("unexpected histogram: %+v", hist)
+	}
+	if hist.ComponentName != "checkout" || hist.SourceLocation != "go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp" {
+		t.Errorf("expected component from service.name and location from scope, got %s / %s", hist.ComponentName, hist.SourceLocation)
+	}
+	if len(hist.BucketBoundaries) != 3 {
+		t.Errorf("expected 3 bucket boundaries, got %v", hist.BucketBoundaries)
+	}
+
+	var names []string
+	for _, attr := range hist.Attributes {
+		names = append(names, attr.Name)
+		if attr.Name == "host.cpu.count" && (!attr.Resource || attr.Type != "int") {
+			t.Errorf("expected int resource attribute, got %+v", attr)
+		}
+	}
+	if len(names) != 4 || names[0] != "http.request.method" || names[1] != "http.response.status_code" {
+		t.Errorf("expected data point attributes before resource attributes, got %v", names)
+	}
+
+	sent := byName["bytes.sent"]
+	if sent.InstrumentType != "counter" || sent.ValueType != "double" || sent.AggregationTemporality != "delta" {
+		t.Errorf("unexpected counter: %+v", sent)
+	}
+	if sent.Monotonic == nil || !*sent.Monotonic {
+		t.Errorf("expected monotonic counter, got %v", sent.Monotonic)
+	}
+	if len(sent.Attributes) != 4 {
+		t.Errorf("expected attribute keys to be unioned across data points, got %+v", sent.Attributes)
+	}
+
+	if byName["queue.depth"].InstrumentType != "gauge" || byName["queue.depth"].ValueType != "int" {
+		t.Errorf("unexpected gauge: %+v", byName["queue.depth"])
+	}
+	if byName["orders.processed"].ComponentName != "payments" {
+		t.Errorf("expected protobuf metric under payments, got %s", byName["orders.processed"].ComponentName)
+	}
+}
+
+func TestDeriveWithoutServiceName(t *testing.T) {
+	batches := []*MetricsData{{ResourceMetrics: []ResourceMetrics{{
+		ScopeMetrics: []ScopeMetrics{{Metrics: []Metric{{Name: "up", Kind: KindSum}}}},
+	}}}}
+
+	metrics := Derive(batches, "payload")
+	if len(metrics) != 1 || metrics[0].ComponentName != unknownServiceName {
+		t.Fatalf("expected metric under %s, got %+v", unknownServiceName, metrics)
+	}
+	if metrics[0].InstrumentType != "updowncounter" {
+		t.Errorf("expected non-monotonic sum to be an updowncounter, got %s", metrics[0].InstrumentType)
+	}
+}
@@ -0,0 +1,190 @@
+package otlp
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+const sampleJSON = `{"resourceMetrics":[{"resource":{"attributes":[
+  {"key":"service.name","value":{"stringValue":"checkout"}},
+  {"key":"host.cpu.count","value":{"intValue":"8"}}]},
+ "scopeMetrics":[{"scope":{"name":"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp","version":"0.60.0"},
+  "metrics":[
+   {"name":"http.server.request.duration","unit":"s","description":"Duration of HTTP server requests.",
+    "histogram":{"aggregationTemporality":2,"dataPoints":[
+     {"attributes":[{"key":"http.request.method","value":{"stringValue":"GET"}},{"key":"http.response.status_code","value":{"intValue":"200"}}],
+      "explicitBounds":[0.005,0.01,0.025]}]}},
+   {"name":"queue.depth","gauge":{"dataPoints":[{"asInt":"3"}]}},
+   {"name":"bytes.sent","unit":"By","sum":{"aggregationTemporality":"AGGREGATION_TEMPORALITY_DELTA","isMonotonic":true,
+    "dataPoints":[{"asInt":"10","attributes":[{"key":"peer","value":{"stringValue":"a"}}]},{"asDouble":1.5,"attributes":[{"key":"tls","value":{"boolValue":true}}]}]}}
+  ]}]}]}`
+
+func TestDecodeJSON(t *testing.T) {
+	batches, err := DecodeJSON([]byte(sampleJSON))
+	if err != nil {
+		t.Fatalf("DecodeJSON failed: %v", err)
+	}
+	if len(batches) != 1 || len(batches[0].ResourceMetrics) != 1 {
+		t.Fatalf("expected one resource, got %+v", batches)
+	}
+
+	rm := batches[0].ResourceMetrics[0]
+	if stringValue(rm.Attributes, "service.name") != "checkout" {
+		t.Errorf("expected service.name checkout, got %+v", rm.Attributes)
+	}
+
+	metrics := rm.ScopeMetrics[0].Metrics
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d", len(metrics))
+	}
+	if metrics[0].Kind != KindHistogram || len(metrics[0].DataPoints[0].ExplicitBounds) != 3 {
+		t.Errorf("unexpected histogram: %+v", metrics[0])
+	}
+	if metrics[2].Kind != KindSum || !metrics[2].Monotonic || metrics[2].Temporality != temporalityDelta {
+		t.Errorf("expected monotonic delta sum, got %+v", metrics[2])
+	}
+}
+
+func TestDecodeJSON_Lines(t *testing.T) {
+	lines := `{"resourceMetrics":[{"scopeMetrics":[{"metrics":[{"name":"a","gauge":{"dataPoints":[{"asDouble":1}]}}]}]}]}
+{"resourceSpans":[{"scopeSpans":[]}]}
+{"resourceMetrics":[{"scopeMetrics":[{"metrics":[{"name":"b","gauge":{"dataPoints":[{"asDouble":1}]}}]}]}]}
+`
+	batches, err := DecodeJSON([]byte(lines))
+	if err != nil {
+		t.Fatalf("DecodeJSON failed: %v", err)
+	}
+	if len(batches) != 2 {
+		t.Errorf("expected 2 metric batches with the span line skipped, got %d", len(batches))
+	}
+}
+
+// Minimal protobuf encoding helpers for building fixtures.
+
+func pbKey(num, wire int) []byte {
+	return binary.AppendUvarint(nil, uint64(num<<3|wire))
+}
+
+func pbBytes(num int, parts ...[]byte) []byte {
+	var body []byte
+	for _, p := range parts {
+		body = append(body, p...)
+	}
+	out := pbKey(num, wireBytes)
+	out = binary.AppendUvarint(out, uint64(len(body)))
+	return append(out, body...)
+}
+
+func pbString(num int, s string) []byte {
+	return pbBytes(num, []byte(s))
+}
+
+func pbVarint(num int, v uint64) []byte {
+	return binary.AppendUvarint(pbKey(num, wireVarint), v)
+}
+
+func pbFixed64(num int, v uint64) []byte {
+	return binary.LittleEndian.AppendUint64(pbKey(num, wireFixed64), v)
+}
+
+func pbPackedDoubles(num int, values ...float64) []byte {
+	var body []byte
+	for _, v := range values {
+		body = binary.LittleEndian.AppendUint64(body, math.Float64bits(v))
+	}
+	return pbBytes(num, body)
+}
+
+func pbStringAttr(num int, key, value string) []byte {
+	return pbBytes(num, pbString(1, key), pbBytes(2, pbString(1, value)))
+}
+
+func sampleProto() []byte {
+	resource := pbBytes(1, pbStringAttr(1, "service.name", "payments"))
+
+	sum := pbBytes(2,
+		pbString(1, "orders.processed"),
+		pbString(3, "{order}"),
+		pbBytes(7,
+			pbBytes(1, pbStringAttr(7, "region", "eu"), pbFixed64(6, 42)),
+			pbVarint(2, temporalityCumulative),
+			pbVarint(3, 1),
+		),
+	)
+	histogram := pbBytes(2,
+		pbString(1, "rpc.duration"),
+		pbString(2, "RPC latency"),
+		pbBytes(9,
+			pbBytes(1,
+				pbBytes(9, pbString(1, "rpc.method"), pbBytes(2, pbVarint(2, 1))),
+				pbPackedDoubles(7, 0.1, 0.5, 1),
+			),
+			pbVarint(2, temporalityDelta),
+		),
+	)
+
+	scope := pbBytes(2, pbBytes(1, pbString(1, "payments-lib"), pbString(2, "1.0")), sum, histogram)
+	return pbBytes(1, resource, scope)
+}
+
+func TestDecodeProtobuf(t *testing.T) {
+	batches, err := DecodeProtobuf(sampleProto())
+	if err != nil {
+		t.Fatalf("DecodeProtobuf failed: %v", err)
+	}
+	if len(batches) != 1 {
+		t.Fatalf("expected 1 batch, got %d", len(batches))
+	}
+
+	rm := batches[0].ResourceMetrics[0]
+	if stringValue(rm.Attributes, "service.name") != "payments" {
+		t.Errorf("expected service.name payments, got %+v", rm.Attributes)
+	}
+
+	sm := rm.ScopeMetrics[0]
+	if sm.ScopeName != "payments-lib" || len(sm.Metrics) != 2 {
+		t.Fatalf("unexpected scope metrics: %+v", sm)
+	}
+
+	sum := sm.Metrics[0]
+	if sum.Kind != KindSum || !sum.Monotonic || sum.Temporality != temporalityCumulative {
+		t.Errorf("expected monotonic cumulative sum, got %+v", sum)
+	}
+	if sum.DataPoints[0].ValueType != "int" || sum.DataPoints[0].Attributes[0].Key != "region" {
+		t.Errorf("unexpected sum data point: %+v", sum.DataPoints[0])
+	}
+
+	hist := sm.Metrics[1]
+	if hist.Kind != KindHistogram || len(hist.DataPoints[0].ExplicitBounds) != 3 {
+		t.Errorf("unexpected histogram: %+v", hist)
+	}
+	if attr := hist.DataPoints[0].Attributes[0]; attr.Key != "rpc.method" || attr.Type != "bool" {
+		t.Errorf("expected bool attribute rpc.method, got %+v", attr)
+	}
+}
+
+func TestDecodeProtobuf_Framed(t *testing.T) {
+	msg := sampleProto()
+
+	var framed []byte
+	for range 2 {
+		framed = binary.BigEndian.AppendUint32(framed, uint32(len(msg)))
+		framed = append(framed, msg...)
+	}
+
+	batches, err := DecodeProtobuf(framed)
+	if err != nil {
+		t.Fatalf("DecodeProtobuf failed: %v", err)
+	}
+	if len(batches) != 2 {
+		t.Errorf("expected 2 length-prefixed batches, got %d", len(batches))
+	}
+}
+
+func TestDecodeProtobuf_Truncated(t *testing.T) {
+	msg := sampleProto()
+	if _, err := DecodeProtobuf(msg[:len(msg)-3]); err == nil {
+		t.Error("expected error for truncated message")
+	}
+}
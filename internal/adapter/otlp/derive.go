@@ -0,0 +1,164 @@
+package otlp
+
+import (
+	"sort"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const (
+	serviceNameKey     = "service.name"
+	unknownServiceName = "unknown_service"
+)
+
+type observed struct {
+	metric    Metric
+	component string
+	scope     string
+	valueType string
+	bounds    []float64
+	attrs     map[string]string
+	resources map[string]string
+	attrOrder []string
+	resOrder  []string
+}
+
+// Derive folds observed payloads into one RawMetric per service and metric
+// name, taking the union of attribute keys across data points and batches.
+func Derive(batches []*MetricsData, path string) []*adapter.RawMetric {
+	seen := make(map[string]*observed)
+	var order []*observed
+
+	for _, md := range batches {
+		for _, rm := range md.ResourceMetrics {
+			component := stringValue(rm.Attributes, serviceNameKey)
+			if component == "" {
+				component = unknownServiceName
+			}
+
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					if m.Name == "" || m.Kind == "" {
+						continue
+					}
+
+					key := component + "\x00" + m.Name
+					obs, ok := seen[key]
+					if !ok {
+						obs = &observed{
+							metric:    m,
+							component: component,
+							scope:     sm.ScopeName,
+							attrs:     make(map[string]string),
+							resources: make(map[string]string),
+						}
+						seen[key] = obs
+						order = append(order, obs)
+					}
+					obs.observe(m, rm.Attributes)
+				}
+			}
+		}
+	}
+
+	metrics := make([]*adapter.RawMetric, 0, len(order))
+	for _, obs := range order {
+		metrics = append(metrics, obs.toRawMetric(path))
+	}
+	return metrics
+}
+
+func (o *observed) observe(m Metric, resource []KeyValue) {
+	if o.metric.Description == "" {
+		o.metric.Description = m.Description
+	}
+	if o.metric.Unit == "" {
+		o.metric.Unit = m.Unit
+	}
+
+	for _, dp := range m.DataPoints {
+		// A double anywhere means the series isn't integral
+		if dp.ValueType == "double" || o.valueType == "" {
+			o.valueType = dp.ValueType
+		}
+		if o.bounds == nil && len(dp.ExplicitBounds) > 0 {
+			o.bounds = dp.ExplicitBounds
+		}
+		for _, kv := range dp.Attributes {
+			if _, ok := o.attrs[kv.Key]; !ok {
+				o.attrs[kv.Key] = kv.Type
+				o.attrOrder = append(o.attrOrder, kv.Key)
+			}
+		}
+	}
+
+	for _, kv := range resource {
+		if _, ok := o.resources[kv.Key]; !ok {
+			o.resources[kv.Key] = kv.Type
+			o.resOrder = append(o.resOrder, kv.Key)
+		}
+	}
+}
+
+func (o *observed) toRawMetric(path string) *adapter.RawMetric {
+	sort.Strings(o.attrOrder)
+	sort.Strings(o.resOrder)
+
+	attrs := make([]domain.Attribute, 0, len(o.attrOrder)+len(o.resOrder))
+	for _, key := range o.attrOrder {
+		attrs = append(attrs, domain.Attribute{Name: key, Type: o.attrs[key]})
+	}
+	// Data point attributes shadow resource attributes of the same name
+	for _, key := range o.resOrder {
+		if _, ok := o.attrs[key]; ok {
+			continue
+		}
+		attrs = append(attrs, domain.Attribute{Name: key, Type: o.resources[key], Resource: true})
+	}
+
+	raw := &adapter.RawMetric{
+		Name:             o.metric.Name,
+		InstrumentType:   instrumentType(o.metric),
+		Description:      o.metric.Description,
+		Unit:             o.metric.Unit,
+		Attributes:       attrs,
+		EnabledByDefault: true,
+		ComponentType:    string(domain.ComponentPlatform),
+		ComponentName:    o.component,
+		SourceLocation:   o.scope,
+		Path:             path,
+		ValueType:        o.valueType,
+		BucketBoundaries: o.bounds,
+	}
+
+	if o.metric.Kind == KindSum {
+		monotonic := o.metric.Monotonic
+		raw.Monotonic = &monotonic
+	}
+
+	switch o.metric.Temporality {
+	case temporalityDelta:
+		raw.AggregationTemporality = string(domain.TemporalityDelta)
+	case temporalityCumulative:
+		raw.AggregationTemporality = string(domain.TemporalityCumulative)
+	}
+
+	return raw
+}
+
+func instrumentType(m Metric) string {
+	switch m.Kind {
+	case KindSum:
+		if m.Monotonic {
+			return string(domain.InstrumentCounter)
+		}
+		return string(domain.InstrumentUpDownCounter)
+	case KindHistogram, KindExponentialHistogram:
+		return string(domain.InstrumentHistogram)
+	case KindSummary:
+		return string(domain.InstrumentSummary)
+	default:
+		return string(domain.InstrumentGauge)
+	}
+}
@@ -0,0 +1,209 @@
+package otlp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// DecodeJSON decodes OTLP/JSON metrics. It accepts a single
+// ExportMetricsServiceRequest or the JSON lines written by the collector's
+// file exporter; lines carrying traces or logs are skipped.
+func DecodeJSON(data []byte) ([]*MetricsData, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, nil
+	}
+
+	var single jsonMetricsData
+	if err := json.Unmarshal(trimmed, &single); err == nil {
+		return []*MetricsData{single.toModel()}, nil
+	}
+
+	var batches []*MetricsData
+
+	scanner := bufio.NewScanner(bytes.NewReader(trimmed))
+	scanner.Buffer(make([]byte, 0, 64*1024), 64*1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var md jsonMetricsData
+		if err := json.Unmarshal(line, &md); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+		if len(md.ResourceMetrics) > 0 {
+			batches = append(batches, md.toModel())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read OTLP/JSON: %w", err)
+	}
+
+	return batches, nil
+}
+
+type jsonMetricsData struct {
+	ResourceMetrics []struct {
+		Resource struct {
+			Attributes []jsonKeyValue `json:"attributes"`
+		} `json:"resource"`
+		ScopeMetrics []struct {
+			Scope struct {
+				Name    string `json:"name"`
+				Version string `json:"version"`
+			} `json:"scope"`
+			Metrics []jsonMetric `json:"metrics"`
+		} `json:"scopeMetrics"`
+	} `json:"resourceMetrics"`
+}
+
+type jsonMetric struct {
+	Name                 string    `json:"name"`
+	Description          string    `json:"description"`
+	Unit                 string    `json:"unit"`
+	Gauge                *jsonData `json:"gauge"`
+	Sum                  *jsonData `json:"sum"`
+	Histogram            *jsonData `json:"histogram"`
+	ExponentialHistogram *jsonData `json:"exponentialHistogram"`
+	Summary              *jsonData `json:"summary"`
+}
+
+type jsonData struct {
+	DataPoints             []jsonDataPoint `json:"dataPoints"`
+	AggregationTemporality jsonTemporality `json:"aggregationTemporality"`
+	IsMonotonic            bool            `json:"isMonotonic"`
+}
+
+type jsonDataPoint struct {
+	Attributes     []jsonKeyValue  `json:"attributes"`
+	AsInt          json.RawMessage `json:"asInt"`
+	AsDouble       json.RawMessage `json:"asDouble"`
+	ExplicitBounds []float64       `json:"explicitBounds"`
+}
+
+type jsonKeyValue struct {
+	Key   string `json:"key"`
+	Value struct {
+		StringValue *string         `json:"stringValue"`
+		BoolValue   json.RawMessage `json:"boolValue"`
+		IntValue    json.RawMessage `json:"intValue"`
+		DoubleValue json.RawMessage `json:"doubleValue"`
+		ArrayValue  json.RawMessage `json:"arrayValue"`
+		KvlistValue json.RawMessage `json:"kvlistValue"`
+		BytesValue  json.RawMessage `json:"bytesValue"`
+	} `json:"value"`
+}
+
+// jsonTemporality accepts the enum as the spec's integer or by name, which
+// some producers emit.
+type jsonTemporality int
+
+func (t *jsonTemporality) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*t = jsonTemporality(n)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("invalid aggregation temporality %s", data)
+	}
+	switch strings.TrimPrefix(s, "AGGREGATION_TEMPORALITY_") {
+	case "DELTA":
+		*t = temporalityDelta
+	case "CUMULATIVE":
+		*t = temporalityCumulative
+	}
+	return nil
+}
+
+func (md *jsonMetricsData) toModel() *MetricsData {
+	out := &MetricsData{}
+	for _, rm := range md.ResourceMetrics {
+		resource := ResourceMetrics{Attributes: convertKeyValues(rm.Resource.Attributes)}
+		for _, sm := range rm.ScopeMetrics {
+			scope := ScopeMetrics{ScopeName: sm.Scope.Name, ScopeVersion: sm.Scope.Version}
+			for _, m := range sm.Metrics {
+				scope.Metrics = append(scope.Metrics, m.toModel())
+			}
+			resource.ScopeMetrics = append(resource.ScopeMetrics, scope)
+		}
+		out.ResourceMetrics = append(out.ResourceMetrics, resource)
+	}
+	return out
+}
+
+func (m *jsonMetric) toModel() Metric {
+	metric := Metric{Name: m.Name, Description: m.Description, Unit: m.Unit}
+
+	var data *jsonData
+	switch {
+	case m.Gauge != nil:
+		metric.Kind, data = KindGauge, m.Gauge
+	case m.Sum != nil:
+		metric.Kind, data = KindSum, m.Sum
+	case m.Histogram != nil:
+		metric.Kind, data = KindHistogram, m.Histogram
+	case m.ExponentialHistogram != nil:
+		metric.Kind, data = KindExponentialHistogram, m.ExponentialHistogram
+	case m.Summary != nil:
+		metric.Kind, data = KindSummary, m.Summary
+	default:
+		return metric
+	}
+
+	metric.Monotonic = data.IsMonotonic
+	metric.Temporality = int(data.AggregationTemporality)
+	for _, dp := range data.DataPoints {
+		point := DataPoint{
+			Attributes:     convertKeyValues(dp.Attributes),
+			ExplicitBounds: dp.ExplicitBounds,
+		}
+		switch {
+		case dp.AsInt != nil:
+			point.ValueType = "int"
+		case dp.AsDouble != nil:
+			point.ValueType = "double"
+		}
+		metric.DataPoints = append(metric.DataPoints, point)
+	}
+
+	return metric
+}
+
+func convertKeyValues(kvs []jsonKeyValue) []KeyValue {
+	out := make([]KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		v := kv.Value
+		attr := KeyValue{Key: kv.Key}
+		switch {
+		case v.StringValue != nil:
+			attr.Type, attr.Value = "string", *v.StringValue
+		case v.BoolValue != nil:
+			attr.Type = "bool"
+		case v.IntValue != nil:
+			attr.Type = "int"
+		case v.DoubleValue != nil:
+			attr.Type = "double"
+		case v.ArrayValue != nil:
+			attr.Type = "slice"
+		case v.KvlistValue != nil:
+			attr.Type = "map"
+		case v.BytesValue != nil:
+			attr.Type = "bytes"
+		default:
+			attr.Type = "string"
+		}
+		out = append(out, attr)
+	}
+	return out
+}
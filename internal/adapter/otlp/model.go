@@ -0,0 +1,70 @@
+package otlp
+
+// The types below keep only the parts of the OTLP metrics data model that
+// say something about a metric's shape; values and timestamps are dropped.
+
+// DataKind is the oneof data field of an OTLP Metric.
+type DataKind string
+
+const (
+	KindGauge                DataKind = "gauge"
+	KindSum                  DataKind = "sum"
+	KindHistogram            DataKind = "histogram"
+	KindExponentialHistogram DataKind = "exponential_histogram"
+	KindSummary              DataKind = "summary"
+)
+
+// AggregationTemporality values as numbered in the OTLP proto.
+const (
+	temporalityDelta      = 1
+	temporalityCumulative = 2
+)
+
+type MetricsData struct {
+	ResourceMetrics []ResourceMetrics
+}
+
+type ResourceMetrics struct {
+	Attributes   []KeyValue
+	ScopeMetrics []ScopeMetrics
+}
+
+type ScopeMetrics struct {
+	ScopeName    string
+	ScopeVersion string
+	Metrics      []Metric
+}
+
+type Metric struct {
+	Name        string
+	Description string
+	Unit        string
+	Kind        DataKind
+	Monotonic   bool
+	Temporality int
+	DataPoints  []DataPoint
+}
+
+type DataPoint struct {
+	Attributes []KeyValue
+	// ValueType is int or double for number data points
+	ValueType      string
+	ExplicitBounds []float64
+}
+
+// KeyValue records an attribute key and the kind of its AnyValue. Value is
+// only kept for strings, which is all resource lookups need.
+type KeyValue struct {
+	Key   string
+	Type  string
+	Value string
+}
+
+func stringValue(attrs []KeyValue, key string) string {
+	for _, kv := range attrs {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,319 @@
+package otlp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// DecodeProtobuf decodes binary OTLP metrics: either one
+// ExportMetricsServiceRequest (which shares MetricsData's wire layout) or the
+// length-prefixed stream written by the collector's file exporter.
+func DecodeProtobuf(data []byte) ([]*MetricsData, error) {
+	if frames, ok := splitFrames(data); ok {
+		batches := make([]*MetricsData, 0, len(frames))
+		for _, frame := range frames {
+			md, err := decodeMetricsData(frame)
+			if err != nil {
+				return nil, err
+			}
+			batches = append(batches, md)
+		}
+		return batches, nil
+	}
+
+	md, err := decodeMetricsData(data)
+	if err != nil {
+		return nil, err
+	}
+	return []*MetricsData{md}, nil
+}
+
+// splitFrames reports whether data is a sequence of messages each prefixed
+// with a big-endian uint32 length, consuming it exactly.
+func splitFrames(data []byte) ([][]byte, bool) {
+	var frames [][]byte
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, false
+		}
+		n := binary.BigEndian.Uint32(data)
+		if uint64(n) > uint64(len(data)-4) {
+			return nil, false
+		}
+		frames = append(frames, data[4:4+n])
+		data = data[4+n:]
+	}
+	return frames, len(frames) > 0
+}
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("truncated protobuf message")
+
+type protoField struct {
+	num    int
+	wire   int
+	varint uint64
+	bytes  []byte
+}
+
+// forEachField walks the top-level fields of a message.
+func forEachField(data []byte, fn func(f protoField) error) error {
+	for len(data) > 0 {
+		key, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncated
+		}
+		data = data[n:]
+
+		f := protoField{num: int(key >> 3), wire: int(key & 7)}
+		switch f.wire {
+		case wireVarint:
+			f.varint, n = binary.Uvarint(data)
+			if n <= 0 {
+				return errTruncated
+			}
+			data = data[n:]
+		case wireFixed64:
+			if len(data) < 8 {
+				return errTruncated
+			}
+			f.varint = binary.LittleEndian.Uint64(data)
+			data = data[8:]
+		case wireBytes:
+			size, n := binary.Uvarint(data)
+			if n <= 0 || size > uint64(len(data)-n) {
+				return errTruncated
+			}
+			f.bytes = data[n : n+int(size)]
+			data = data[n+int(size):]
+		case wireFixed32:
+			if len(data) < 4 {
+				return errTruncated
+			}
+			f.varint = uint64(binary.LittleEndian.Uint32(data))
+			data = data[4:]
+		default:
+			return fmt.Errorf("unsupported protobuf wire type %d", f.wire)
+		}
+
+		if err := fn(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeMetricsData(data []byte) (*MetricsData, error) {
+	md := &MetricsData{}
+	err := forEachField(data, func(f protoField) error {
+		if f.num != 1 || f.wire != wireBytes {
+			return nil
+		}
+		rm, err := decodeResourceMetrics(f.bytes)
+		if err != nil {
+			return err
+		}
+		md.ResourceMetrics = append(md.ResourceMetrics, rm)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode OTLP protobuf: %w", err)
+	}
+	return md, nil
+}
+
+func decodeResourceMetrics(data []byte) (ResourceMetrics, error) {
+	var rm ResourceMetrics
+	err := forEachField(data, func(f protoField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			return forEachField(f.bytes, func(rf protoField) error {
+				if rf.num != 1 || rf.wire != wireBytes {
+					return nil
+				}
+				kv, err := decodeKeyValue(rf.bytes)
+				rm.Attributes = append(rm.Attributes, kv)
+				return err
+			})
+		case 2:
+			sm, err := decodeScopeMetrics(f.bytes)
+			rm.ScopeMetrics = append(rm.ScopeMetrics, sm)
+			return err
+		}
+		return nil
+	})
+	return rm, err
+}
+
+func decodeScopeMetrics(data []byte) (ScopeMetrics, error) {
+	var sm ScopeMetrics
+	err := forEachField(data, func(f protoField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			return forEachField(f.bytes, func(sf protoField) error {
+				switch {
+				case sf.num == 1 && sf.wire == wireBytes:
+					sm.ScopeName = string(sf.bytes)
+				case sf.num == 2 && sf.wire == wireBytes:
+					sm.ScopeVersion = string(sf.bytes)
+				}
+				return nil
+			})
+		case 2:
+			m, err := decodeMetric(f.bytes)
+			sm.Metrics = append(sm.Metrics, m)
+			return err
+		}
+		return nil
+	})
+	return sm, err
+}
+
+// Field numbers of the Metric data oneof.
+var metricDataKinds = map[int]DataKind{
+	5:  KindGauge,
+	7:  KindSum,
+	9:  KindHistogram,
+	10: KindExponentialHistogram,
+	11: KindSummary,
+}
+
+func decodeMetric(data []byte) (Metric, error) {
+	var m Metric
+	err := forEachField(data, func(f protoField) error {
+		if f.wire != wireBytes {
+			return nil
+		}
+		switch f.num {
+		case 1:
+			m.Name = string(f.bytes)
+		case 2:
+			m.Description = string(f.bytes)
+		case 3:
+			m.Unit = string(f.bytes)
+		default:
+			if kind, ok := metricDataKinds[f.num]; ok {
+				m.Kind = kind
+				return decodeMetricData(f.bytes, &m)
+			}
+		}
+		return nil
+	})
+	return m, err
+}
+
+// decodeMetricData reads Gauge, Sum, Histogram, ExponentialHistogram or
+// Summary, which all keep data points in field 1.
+func decodeMetricData(data []byte, m *Metric) error {
+	return forEachField(data, func(f protoField) error {
+		switch {
+		case f.num == 1 && f.wire == wireBytes:
+			dp, err := decodeDataPoint(f.bytes, m.Kind)
+			m.DataPoints = append(m.DataPoints, dp)
+			return err
+		case f.num == 2 && f.wire == wireVarint && m.Kind != KindGauge && m.Kind != KindSummary:
+			m.Temporality = int(f.varint)
+		case f.num == 3 && f.wire == wireVarint && m.Kind == KindSum:
+			m.Monotonic = f.varint != 0
+		}
+		return nil
+	})
+}
+
+// Field number of the attributes on each data point type.
+var dataPointAttributesField = map[DataKind]int{
+	KindGauge:                7,
+	KindSum:                  7,
+	KindHistogram:            9,
+	KindExponentialHistogram: 1,
+	KindSummary:              7,
+}
+
+func decodeDataPoint(data []byte, kind DataKind) (DataPoint, error) {
+	var dp DataPoint
+	number := kind == KindGauge || kind == KindSum
+
+	err := forEachField(data, func(f protoField) error {
+		switch {
+		case f.num == dataPointAttributesField[kind] && f.wire == wireBytes:
+			kv, err := decodeKeyValue(f.bytes)
+			dp.Attributes = append(dp.Attributes, kv)
+			return err
+		case number && f.num == 4 && f.wire == wireFixed64:
+			dp.ValueType = "double"
+		case number && f.num == 6 && f.wire == wireFixed64:
+			dp.ValueType = "int"
+		case kind == KindHistogram && f.num == 7:
+			bounds, err := decodeDoubles(f)
+			dp.ExplicitBounds = append(dp.ExplicitBounds, bounds...)
+			return err
+		}
+		return nil
+	})
+	return dp, err
+}
+
+// decodeDoubles reads a repeated double, packed or not.
+func decodeDoubles(f protoField) ([]float64, error) {
+	switch f.wire {
+	case wireFixed64:
+		return []float64{math.Float64frombits(f.varint)}, nil
+	case wireBytes:
+		if len(f.bytes)%8 != 0 {
+			return nil, errTruncated
+		}
+		out := make([]float64, 0, len(f.bytes)/8)
+		for i := 0; i < len(f.bytes); i += 8 {
+			out = append(out, math.Float64frombits(binary.LittleEndian.Uint64(f.bytes[i:])))
+		}
+		return out, nil
+	}
+	return nil, nil
+}
+
+// Field numbers of the AnyValue oneof, mapped to attribute types.
+var anyValueTypes = map[int]string{
+	1: "string",
+	2: "bool",
+	3: "int",
+	4: "double",
+	5: "slice",
+	6: "map",
+	7: "bytes",
+}
+
+func decodeKeyValue(data []byte) (KeyValue, error) {
+	kv := KeyValue{Type: "string"}
+	err := forEachField(data, func(f protoField) error {
+		switch {
+		case f.num == 1 && f.wire == wireBytes:
+			kv.Key = string(f.bytes)
+		case f.num == 2 && f.wire == wireBytes:
+			return forEachField(f.bytes, func(vf protoField) error {
+				if t, ok := anyValueTypes[vf.num]; ok {
+					kv.Type = t
+					if vf.num == 1 {
+						kv.Value = string(vf.bytes)
+					}
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	return kv, err
+}
@@ -0,0 +1,113 @@
+package otlp
+
+import (
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+// MetricsPath is where OTLP/HTTP exporters send metrics.
+const MetricsPath = "/v1/metrics"
+
+const maxBodySize = 32 << 20
+
+// IngestFunc stores the metrics derived from one export request.
+type IngestFunc func(ctx context.Context, metrics []*adapter.RawMetric, result *adapter.FetchResult) error
+
+// Receiver is a minimal OTLP/HTTP metrics endpoint. It accepts protobuf and
+// JSON export requests, optionally gzipped, and catalogs what they contain.
+type Receiver struct {
+	ingest IngestFunc
+}
+
+func NewReceiver(ingest IngestFunc) *Receiver {
+	return &Receiver{ingest: ingest}
+}
+
+func (rc *Receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != MetricsPath {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	var decode func([]byte) ([]*MetricsData, error)
+	switch mediaType {
+	case "application/x-protobuf":
+		decode = DecodeProtobuf
+	case "application/json":
+		decode = DecodeJSON
+	default:
+		http.Error(w, fmt.Sprintf("unsupported content type %q", mediaType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := readBody(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	batches, err := decode(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	result := &adapter.FetchResult{
+		Commit:    hex.EncodeToString(sum[:])[:12],
+		Timestamp: time.Now(),
+	}
+
+	if err := rc.ingest(r.Context(), Derive(batches, MetricsPath), result); err != nil {
+		log.Printf("OTLP ingest failed: %v", err)
+		http.Error(w, "failed to store metrics", http.StatusServiceUnavailable)
+		return
+	}
+
+	// An empty ExportMetricsServiceResponse signals full success
+	w.Header().Set("Content-Type", mediaType)
+	if mediaType == "application/json" {
+		_, _ = w.Write([]byte("{}"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func readBody(r *http.Request) ([]byte, error) {
+	var reader io.Reader = http.MaxBytesReader(nil, r.Body, maxBodySize)
+
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gzip body: %w", err)
+		}
+		defer func() { _ = gz.Close() }()
+		reader = io.LimitReader(gz, maxBodySize+1)
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+	if len(body) > maxBodySize {
+		return nil, errors.New("request body too large")
+	}
+	return body, nil
+}
@@ -0,0 +1,99 @@
+package otlp
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+func newTestReceiver(got *[]*adapter.RawMetric) *Receiver {
+	return NewReceiver(func(ctx context.Context, metrics []*adapter.RawMetric, result *adapter.FetchResult) error {
+		*got = append(*got, metrics...)
+		return nil
+	})
+}
+
+func TestReceiver_JSON(t *testing.T) {
+	var got []*adapter.RawMetric
+	rc := newTestReceiver(&got)
+
+	req := httptest.NewRequest(http.MethodPost, MetricsPath, strings.NewReader(sampleJSON))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	rc.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if w.Body.String() != "{}" {
+		t.Errorf("expected empty JSON response, got %q", w.Body.String())
+	}
+	if len(got) != 3 {
+		t.Errorf("expected 3 metrics ingested, got %d", len(got))
+	}
+}
+
+func TestReceiver_GzipProtobuf(t *testing.T) {
+	var got []*adapter.RawMetric
+	rc := newTestReceiver(&got)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, _ = gz.Write(sampleProto())
+	_ = gz.Close()
+
+	req := httptest.NewRequest(http.MethodPost, MetricsPath, &buf)
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "gzip")
+	w := httptest.NewRecorder()
+	rc.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", w.Code, w.Body.String())
+	}
+	if len(got) != 2 {
+		t.Errorf("expected 2 metrics ingested, got %d", len(got))
+	}
+}
+
+func TestReceiver_Rejects(t *testing.T) {
+	var got []*adapter.RawMetric
+	rc := newTestReceiver(&got)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		contentType string
+		body        string
+		want        int
+	}{
+		{"wrong method", http.MethodGet, MetricsPath, "application/json", "", http.StatusMethodNotAllowed},
+		{"wrong path", http.MethodPost, "/v1/traces", "application/json", "{}", http.StatusNotFound},
+		{"unsupported content type", http.MethodPost, MetricsPath, "text/plain", "x", http.StatusUnsupportedMediaType},
+		{"malformed body", http.MethodPost, MetricsPath, "application/json", "{", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			w := httptest.NewRecorder()
+			rc.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+
+	if len(got) != 0 {
+		t.Errorf("expected nothing ingested, got %d metrics", len(got))
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"math"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -13,16 +15,66 @@ type MetricDef struct {
 	Name   string
 	Help   string
 	Labels []string
+
+	// Set when the metric is declared through a typed constructor such as
+	// prometheus.NewHistogramVec rather than a bare NewDesc
+	Type    string
+	Buckets []float64
+
+	// Kubernetes component-base metrics declare a stability class (ALPHA,
+	// BETA, STABLE, INTERNAL); unset levels default to ALPHA as upstream does
+	StabilityLevel    string
+	DeprecatedVersion string
+}
+
+var optsConstructors = map[string]string{
+	"NewCounter":      "counter",
+	"NewCounterVec":   "counter",
+	"NewGauge":        "gauge",
+	"NewGaugeVec":     "gauge",
+	"NewHistogram":    "histogram",
+	"NewHistogramVec": "histogram",
+	"NewSummary":      "summary",
+	"NewSummaryVec":   "summary",
+
+	// component-base only
+	"NewTimingHistogram":    "histogram",
+	"NewTimingHistogramVec": "histogram",
+}
+
+const (
+	componentBaseImport = "k8s.io/component-base/metrics"
+	defaultStability    = "ALPHA"
+)
+
+// fileScope holds what a file declares that metric definitions refer to.
+type fileScope struct {
+	constants map[string]string
+	sliceVars map[string][]string
+	// Local names of k8s.io/component-base/metrics
+	componentBase map[string]bool
 }
 
+// prometheus.DefBuckets
+var defBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Guards against generating absurd bucket lists from bad constant folding
+const maxBucketCount = 1000
+
 func ParseSource(filename string, src []byte) ([]MetricDef, error) {
+	return ParseSourceWithConstants(filename, src, nil)
+}
+
+// ParseSourceWithConstants resolves names that live outside the file, such
+// as CoreDNS's plugin.Namespace, from the given "pkg.Name" keyed values.
+func ParseSourceWithConstants(filename string, src []byte, external map[string]string) ([]MetricDef, error) {
 	fset := token.NewFileSet()
 	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
 	if err != nil {
 		return nil, err
 	}
 
-	return extractMetrics(f)
+	return extractMetrics(f, external)
 }
 
 func ParseFile(path string) ([]MetricDef, error) {
@@ -33,10 +85,25 @@ func ParseFile(path string) ([]MetricDef, error) {
 	return ParseSource(filepath.Base(path), src)
 }
 
-func extractMetrics(f *ast.File) ([]MetricDef, error) {
+func ParseFileWithConstants(path string, external map[string]string) ([]MetricDef, error) {
+	src, err := os.ReadFile(path) //nolint:gosec // Reading Go source files from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+	return ParseSourceWithConstants(filepath.Base(path), src, external)
+}
+
+func extractMetrics(f *ast.File, external map[string]string) ([]MetricDef, error) {
 	var metrics []MetricDef
-	constants := extractConstants(f)
-	sliceVars := extractStringSliceVars(f)
+
+	scope := &fileScope{
+		constants:     extractConstants(f),
+		sliceVars:     extractStringSliceVars(f),
+		componentBase: componentBaseNames(f),
+	}
+	for k, v := range external {
+		scope.constants[k] = v
+	}
 
 	ast.Inspect(f, func(n ast.Node) bool {
 		call, ok := n.(*ast.CallExpr)
@@ -44,7 +111,14 @@ func extractMetrics(f *ast.File) ([]MetricDef, error) {
 			return true
 		}
 
-		if !isNewDescCall(call) {
+		if metricType, ok := scope.optsConstructorType(call); ok {
+			if def, ok := scope.parseOptsConstructor(call, metricType); ok {
+				metrics = append(metrics, def)
+			}
+			return true
+		}
+
+		if !scope.isNewDescCall(call) {
 			return true
 		}
 
@@ -52,21 +126,36 @@ func extractMetrics(f *ast.File) ([]MetricDef, error) {
 			return true
 		}
 
-		name := extractMetricName(call.Args[0], constants)
+		name := extractMetricName(call.Args[0], scope.constants)
 		help := extractStringLiteral(call.Args[1])
 
 		var labels []string
 		if len(call.Args) >= 3 {
-			labels = extractLabels(call.Args[2], sliceVars)
+			labels = extractLabels(call.Args[2], scope.sliceVars)
+		}
+
+		if name == "" {
+			return true
 		}
 
-		if name != "" {
-			metrics = append(metrics, MetricDef{
-				Name:   name,
-				Help:   help,
-				Labels: labels,
-			})
+		def := MetricDef{
+			Name:   name,
+			Help:   help,
+			Labels: labels,
+		}
+		// component-base NewDesc(fqName, help, labels, constLabels, stability, deprecatedVersion)
+		if scope.isComponentBase(call) {
+			def.StabilityLevel = defaultStability
+			if len(call.Args) >= 5 {
+				if level := stabilityLevel(call.Args[4]); level != "" {
+					def.StabilityLevel = level
+				}
+			}
+			if len(call.Args) >= 6 {
+				def.DeprecatedVersion = resolveStringArg(call.Args[5], scope.constants)
+			}
 		}
+		metrics = append(metrics, def)
 
 		return true
 	})
@@ -74,6 +163,44 @@ func extractMetrics(f *ast.File) ([]MetricDef, error) {
 	return metrics, nil
 }
 
+func componentBaseNames(f *ast.File) map[string]bool {
+	names := make(map[string]bool)
+	for _, imp := range f.Imports {
+		if strings.Trim(imp.Path.Value, `"`) != componentBaseImport {
+			continue
+		}
+		if imp.Name != nil {
+			names[imp.Name.Name] = true
+		} else {
+			names["metrics"] = true
+		}
+	}
+	return names
+}
+
+// isComponentBase reports whether a call's function is selected from the
+// component-base metrics package.
+func (s *fileScope) isComponentBase(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	return ok && s.componentBase[ident.Name]
+}
+
+func (s *fileScope) isMetricsPackage(name string) bool {
+	return name == "prometheus" || s.componentBase[name]
+}
+
+// stabilityLevel reads metrics.ALPHA style selectors.
+func stabilityLevel(expr ast.Expr) string {
+	if sel, ok := expr.(*ast.SelectorExpr); ok {
+		return sel.Sel.Name
+	}
+	return ""
+}
+
 func extractConstants(f *ast.File) map[string]string {
 	constants := make(map[string]string)
 
@@ -102,7 +229,7 @@ func extractConstants(f *ast.File) map[string]string {
 	return constants
 }
 
-func isNewDescCall(call *ast.CallExpr) bool {
+func (s *fileScope) isNewDescCall(call *ast.CallExpr) bool {
 	sel, ok := call.Fun.(*ast.SelectorExpr)
 	if !ok {
 		return false
@@ -113,7 +240,7 @@ func isNewDescCall(call *ast.CallExpr) bool {
 		return false
 	}
 
-	return ident.Name == "prometheus" && sel.Sel.Name == "NewDesc"
+	return s.isMetricsPackage(ident.Name) && sel.Sel.Name == "NewDesc"
 }
 
 func extractMetricName(arg ast.Expr, constants map[string]string) string {
@@ -178,6 +305,14 @@ func resolveStringArg(arg ast.Expr, constants map[string]string) string {
 		}
 	}
 
+	if sel, ok := arg.(*ast.SelectorExpr); ok {
+		if pkg, ok := sel.X.(*ast.Ident); ok {
+			if val, found := constants[pkg.Name+"."+sel.Sel.Name]; found {
+				return val
+			}
+		}
+	}
+
 	return ""
 }
 
@@ -240,3 +375,203 @@ func parseStringSliceLit(expr ast.Expr) []string {
 
 	return labels
 }
+
+// optsConstructorType recognises prometheus.NewXxx(...), promauto.NewXxx(...),
+// promauto.With(reg).NewXxx(...) and the component-base equivalents.
+func (s *fileScope) optsConstructorType(call *ast.CallExpr) (string, bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", false
+	}
+
+	metricType, ok := optsConstructors[sel.Sel.Name]
+	if !ok {
+		return "", false
+	}
+
+	switch x := sel.X.(type) {
+	case *ast.Ident:
+		if s.componentBase[x.Name] {
+			return metricType, true
+		}
+		if strings.HasPrefix(sel.Sel.Name, "NewTiming") {
+			return "", false
+		}
+		return metricType, x.Name == "prometheus" || x.Name == "promauto"
+	case *ast.CallExpr:
+		if inner, ok := x.Fun.(*ast.SelectorExpr); ok {
+			if ident, ok := inner.X.(*ast.Ident); ok && ident.Name == "promauto" && inner.Sel.Name == "With" {
+				return metricType, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func (s *fileScope) parseOptsConstructor(call *ast.CallExpr, metricType string) (MetricDef, bool) {
+	if len(call.Args) == 0 {
+		return MetricDef{}, false
+	}
+
+	opts := optsLiteral(call.Args[0])
+	if opts == nil {
+		return MetricDef{}, false
+	}
+
+	var namespace, subsystem, name string
+	def := MetricDef{Type: metricType}
+	if s.isComponentBase(call) {
+		def.StabilityLevel = defaultStability
+	}
+
+	for _, elt := range opts.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		key, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		switch key.Name {
+		case "Namespace":
+			namespace = resolveStringArg(kv.Value, s.constants)
+		case "Subsystem":
+			subsystem = resolveStringArg(kv.Value, s.constants)
+		case "Name":
+			name = resolveStringArg(kv.Value, s.constants)
+		case "Help":
+			def.Help = resolveStringArg(kv.Value, s.constants)
+		case "Buckets":
+			def.Buckets = s.evalBuckets(kv.Value)
+		case "StabilityLevel":
+			if level := stabilityLevel(kv.Value); level != "" && def.StabilityLevel != "" {
+				def.StabilityLevel = level
+			}
+		case "DeprecatedVersion":
+			def.DeprecatedVersion = resolveStringArg(kv.Value, s.constants)
+		}
+	}
+
+	if name == "" {
+		return MetricDef{}, false
+	}
+
+	parts := []string{}
+	for _, part := range []string{namespace, subsystem, name} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	def.Name = strings.Join(parts, "_")
+
+	if len(call.Args) >= 2 {
+		def.Labels = extractLabels(call.Args[1], s.sliceVars)
+	}
+
+	return def, true
+}
+
+func optsLiteral(expr ast.Expr) *ast.CompositeLit {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+	lit, _ := expr.(*ast.CompositeLit)
+	return lit
+}
+
+// evalBuckets folds bucket expressions that can be computed statically:
+// literal slices, prometheus.DefBuckets and the Linear/Exponential helpers.
+func (s *fileScope) evalBuckets(expr ast.Expr) []float64 {
+	switch e := expr.(type) {
+	case *ast.CompositeLit:
+		buckets := make([]float64, 0, len(e.Elts))
+		for _, elt := range e.Elts {
+			v, ok := evalNumber(elt)
+			if !ok {
+				return nil
+			}
+			buckets = append(buckets, v)
+		}
+		return buckets
+	case *ast.SelectorExpr:
+		if ident, ok := e.X.(*ast.Ident); ok && s.isMetricsPackage(ident.Name) && e.Sel.Name == "DefBuckets" {
+			return append([]float64(nil), defBuckets...)
+		}
+	case *ast.CallExpr:
+		sel, ok := e.Fun.(*ast.SelectorExpr)
+		if !ok || len(e.Args) != 3 {
+			return nil
+		}
+		if ident, ok := sel.X.(*ast.Ident); !ok || !s.isMetricsPackage(ident.Name) {
+			return nil
+		}
+
+		a, okA := evalNumber(e.Args[0])
+		b, okB := evalNumber(e.Args[1])
+		n, okN := evalNumber(e.Args[2])
+		count := int(n)
+		if !okA || !okB || !okN || count < 1 || count > maxBucketCount {
+			return nil
+		}
+
+		buckets := make([]float64, count)
+		switch sel.Sel.Name {
+		case "LinearBuckets":
+			for i := range buckets {
+				buckets[i] = a + b*float64(i)
+			}
+		case "ExponentialBuckets":
+			for i := range buckets {
+				buckets[i] = a * math.Pow(b, float64(i))
+			}
+		case "ExponentialBucketsRange":
+			if count < 2 || a <= 0 {
+				return nil
+			}
+			factor := math.Pow(b/a, 1/float64(count-1))
+			for i := range buckets {
+				buckets[i] = a * math.Pow(factor, float64(i))
+			}
+		default:
+			return nil
+		}
+		return buckets
+	}
+
+	return nil
+}
+
+func evalNumber(expr ast.Expr) (float64, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT && e.Kind != token.FLOAT {
+			return 0, false
+		}
+		v, err := strconv.ParseFloat(strings.ReplaceAll(e.Value, "_", ""), 64)
+		return v, err == nil
+	case *ast.UnaryExpr:
+		if e.Op != token.SUB {
+			return 0, false
+		}
+		v, ok := evalNumber(e.X)
+		return -v, ok
+	case *ast.ParenExpr:
+		return evalNumber(e.X)
+	}
+	return 0, false
+}
+
+// Constants returns the string constants and vars a file declares, for
+// extractors outside this package that resolve their own call shapes.
+func Constants(f *ast.File) map[string]string {
+	return extractConstants(f)
+}
+
+// ResolveString evaluates a string literal, local constant or "pkg.Name"
+// reference found in constants; it returns "" when the value isn't static.
+func ResolveString(expr ast.Expr, constants map[string]string) string {
+	return resolveStringArg(expr, constants)
+}
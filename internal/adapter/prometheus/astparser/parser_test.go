@@ -1,6 +1,8 @@
 package astparser
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -248,3 +250,233 @@ func doSomething() {
 		t.Errorf("expected 0 metrics, got %d", len(metrics))
 	}
 }
+
+func TestParseFile_HistogramVecWithBuckets(t *testing.T) {
+	src := `
+package collector
+
+import "github.com/prometheus/client_golang/prometheus"
+
+const namespace = "etcd"
+
+var requestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "disk",
+	Name:      "wal_fsync_duration_seconds",
+	Help:      "The latency distributions of fsync called by WAL.",
+	Buckets:   prometheus.ExponentialBuckets(0.001, 2, 4),
+}, []string{"member"})
+
+var requests = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "http_requests_total",
+	Help: "Total HTTP requests.",
+})
+
+var latency = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Name:    "request_latency_seconds",
+	Buckets: prometheus.DefBuckets,
+})
+`
+	metrics, err := ParseSource("test.go", []byte(src))
+	if err != nil {
+		t.Fatalf("ParseSource failed: %v", err)
+	}
+
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d", len(metrics))
+	}
+
+	m := metrics[0]
+	if m.Name != "etcd_disk_wal_fsync_duration_seconds" {
+		t.Errorf("expected name 'etcd_disk_wal_fsync_duration_seconds', got '%s'", m.Name)
+	}
+	if m.Type != "histogram" {
+		t.Errorf("expected type 'histogram', got '%s'", m.Type)
+	}
+	if len(m.Labels) != 1 || m.Labels[0] != "member" {
+		t.Errorf("expected labels [member], got %v", m.Labels)
+	}
+	expected := []float64{0.001, 0.002, 0.004, 0.008}
+	if len(m.Buckets) != len(expected) {
+		t.Fatalf("expected buckets %v, got %v", expected, m.Buckets)
+	}
+	for i, b := range expected {
+		if m.Buckets[i] != b {
+			t.Errorf("expected bucket %d to be %v, got %v", i, b, m.Buckets[i])
+		}
+	}
+
+	if metrics[1].Type != "counter" || metrics[1].Buckets != nil {
+		t.Errorf("unexpected counter definition: %+v", metrics[1])
+	}
+
+	if len(metrics[2].Buckets) != 11 {
+		t.Errorf("expected default buckets, got %v", metrics[2].Buckets)
+	}
+}
+
+func TestEvalBuckets_Linear(t *testing.T) {
+	src := `
+package collector
+
+var h = prometheus.NewHistogram(prometheus.HistogramOpts{
+	Name:    "queue_depth",
+	Buckets: prometheus.LinearBuckets(10, 5, 3),
+})
+`
+	metrics, err := ParseSource("test.go", []byte(src))
+	if err != nil {
+		t.Fatalf("ParseSource failed: %v", err)
+	}
+
+	if len(metrics) != 1 {
+		t.Fatalf("expected 1 metric, got %d", len(metrics))
+	}
+
+	buckets := metrics[0].Buckets
+	if len(buckets) != 3 || buckets[0] != 10 || buckets[1] != 15 || buckets[2] != 20 {
+		t.Errorf("unexpected buckets: %v", buckets)
+	}
+}
+
+func TestParseSource_ComponentBase(t *testing.T) {
+	src := `package metrics
+
+import (
+	compbasemetrics "k8s.io/component-base/metrics"
+)
+
+const subsystem = "apiserver"
+
+var (
+	requestCounter = compbasemetrics.NewCounterVec(
+		&compbasemetrics.CounterOpts{
+			Subsystem:      subsystem,
+			Name:           "request_total",
+			Help:           "Counter of apiserver requests.",
+			StabilityLevel: compbasemetrics.STABLE,
+		},
+		[]string{"verb", "resource", "code"},
+	)
+	latency = compbasemetrics.NewTimingHistogramVec(
+		&compbasemetrics.TimingHistogramOpts{
+			Name:              "request_latency_seconds",
+			Help:              "Request latency.",
+			Buckets:           compbasemetrics.ExponentialBuckets(0.001, 2, 3),
+			DeprecatedVersion: "1.30.0",
+		},
+		[]string{"verb"},
+	)
+	descriptor = compbasemetrics.NewDesc("node_cpu_usage_seconds_total",
+		"Cumulative cpu time consumed by the node.",
+		nil, nil, compbasemetrics.BETA, "")
+)
+`
+
+	metrics, err := ParseSource("metrics.go", []byte(src))
+	if err != nil {
+		t.Fatalf("ParseSource failed: %v", err)
+	}
+	if len(metrics) != 3 {
+		t.Fatalf("expected 3 metrics, got %d", len(metrics))
+	}
+
+	counter := metrics[0]
+	if counter.Name != "apiserver_request_total" || counter.Type != "counter" || counter.StabilityLevel != "STABLE" {
+		t.Errorf("unexpected counter: %+v", counter)
+	}
+	if len(counter.Labels) != 3 {
+		t.Errorf("expected 3 labels, got %v", counter.Labels)
+	}
+
+	hist := metrics[1]
+	if hist.Type != "histogram" || hist.StabilityLevel != "ALPHA" || hist.DeprecatedVersion != "1.30.0" {
+		t.Errorf("expected deprecated alpha histogram, got %+v", hist)
+	}
+	if len(hist.Buckets) != 3 || hist.Buckets[2] != 0.004 {
+		t.Errorf("expected component-base bucket helper to be folded, got %v", hist.Buckets)
+	}
+
+	if metrics[2].Name != "node_cpu_usage_seconds_total" || metrics[2].StabilityLevel != "BETA" {
+		t.Errorf("unexpected descriptor: %+v", metrics[2])
+	}
+}
+
+func TestParseSource_PrometheusHasNoStability(t *testing.T) {
+	src := `package collector
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var up = prometheus.NewGauge(prometheus.GaugeOpts{Name: "up", Help: "Up."})
+`
+
+	metrics, err := ParseSource("collector.go", []byte(src))
+	if err != nil {
+		t.Fatalf("ParseSource failed: %v", err)
+	}
+	if len(metrics) != 1 || metrics[0].StabilityLevel != "" {
+		t.Errorf("expected plain prometheus metric without stability, got %+v", metrics)
+	}
+}
+
+func TestParseSourceWithConstants_External(t *testing.T) {
+	src := `package cache
+
+import (
+	"github.com/coredns/coredns/plugin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var cacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: plugin.Namespace,
+	Subsystem: "cache",
+	Name:      "hits_total",
+	Help:      "The count of cache hits.",
+}, []string{"server", "type", "zones", "view"})
+`
+
+	metrics, err := ParseSourceWithConstants("metrics.go", []byte(src), map[string]string{"plugin.Namespace": "coredns"})
+	if err != nil {
+		t.Fatalf("ParseSourceWithConstants failed: %v", err)
+	}
+	if len(metrics) != 1 || metrics[0].Name != "coredns_cache_hits_total" {
+		t.Errorf("expected coredns_cache_hits_total, got %+v", metrics)
+	}
+}
+
+func TestParseTree(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, src string) {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	metric := `package p
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var c = prometheus.NewCounter(prometheus.CounterOpts{Name: "requests_total", Help: "Requests."})
+`
+	write("server/wal/metrics.go", metric)
+	write("server/wal/metrics_test.go", metric)
+	write("server/vendor/dep/metrics.go", metric)
+	write("server/testdata/metrics.go", metric)
+	write("server/util.go", "package p\n")
+
+	files, err := ParseTree(filepath.Join(root, "server"), nil)
+	if err != nil {
+		t.Fatalf("ParseTree failed: %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("expected 1 file with metrics, got %d", len(files))
+	}
+	if filepath.Base(filepath.Dir(files[0].Path)) != "wal" || files[0].Metrics[0].Name != "requests_total" {
+		t.Errorf("unexpected file metrics: %+v", files[0])
+	}
+}
@@ -0,0 +1,72 @@
+package astparser
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileMetrics pairs a source file with the metrics it declares.
+type FileMetrics struct {
+	Path    string
+	Metrics []MetricDef
+}
+
+// Cheap markers for files worth handing to the Go parser
+var metricMarkers = [][]byte{[]byte("Opts{"), []byte("NewDesc(")}
+
+// ParseTree parses every non-test Go file under root, skipping vendored code
+// and test fixtures, and returns the files that declare metrics.
+func ParseTree(root string, external map[string]string) ([]FileMetrics, error) {
+	var files []FileMetrics
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			name := d.Name()
+			if path != root && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		src, err := os.ReadFile(path) //nolint:gosec // Reading Go source files from cloned repos is intentional
+		if err != nil {
+			return nil
+		}
+		if !containsAny(src, metricMarkers) {
+			return nil
+		}
+
+		defs, err := ParseSourceWithConstants(filepath.Base(path), src, external)
+		if err != nil || len(defs) == 0 {
+			return nil
+		}
+
+		files = append(files, FileMetrics{Path: path, Metrics: defs})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func containsAny(src []byte, markers [][]byte) bool {
+	for _, m := range markers {
+		if bytes.Contains(src, m) {
+			return true
+		}
+	}
+	return false
+}
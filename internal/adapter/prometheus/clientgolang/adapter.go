@@ -0,0 +1,186 @@
+package clientgolang
+
+import (
+	"context"
+	"maps"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/prometheus/client_golang"
+
+// The Go source tree describes the runtime/metrics keys client_golang's
+// generated table only names
+const goRepoURL = "https://github.com/golang/go"
+
+var runtimeDescriptionsFile = filepath.Join("src", "runtime", "metrics", "description.go")
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-client-golang",
+		Category:    domain.SourcePrometheus,
+		Description: "Prometheus client_golang default collectors and Go runtime metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "go", "runtime"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "prometheus-client-golang"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	goResult, err := a.fetcher.Fetch(ctx, fetcher.FetchOptions{
+		RepoURL: goRepoURL,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+		Files:     []string{filepath.Join(goResult.RepoPath, runtimeDescriptionsFile)},
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	promDir := filepath.Join(result.RepoPath, "prometheus")
+
+	var metrics []*adapter.RawMetric
+	seen := make(map[string]bool)
+
+	for _, dir := range []string{promDir, filepath.Join(promDir, "collectors")} {
+		defs, err := ParseCollectorDir(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, def := range defs {
+			// Only the default go_* and process_* collectors; anything else
+			// in the package is an example or a test helper
+			if seen[def.Name] || !(strings.HasPrefix(def.Name, "go_") || strings.HasPrefix(def.Name, "process_")) {
+				continue
+			}
+			seen[def.Name] = true
+
+			attrs := make([]domain.Attribute, 0, len(def.Labels))
+			for _, label := range def.Labels {
+				attrs = append(attrs, domain.Attribute{Name: label, Type: "string"})
+			}
+
+			relPath, _ := filepath.Rel(result.RepoPath, def.File)
+			metrics = append(metrics, &adapter.RawMetric{
+				Name:             def.Name,
+				Description:      def.Help,
+				InstrumentType:   def.Type,
+				Attributes:       attrs,
+				EnabledByDefault: true,
+				ComponentType:    string(domain.ComponentInstrumentation),
+				ComponentName:    componentName(def.Name),
+				SourceLocation:   def.File,
+				Path:             relPath,
+			})
+		}
+	}
+
+	table, tablePath, err := LatestRuntimeTable(promDir)
+	if err != nil {
+		return nil, err
+	}
+	relTable, _ := filepath.Rel(result.RepoPath, tablePath)
+
+	descriptions := make(map[string]RuntimeDescription)
+	for _, path := range result.Files {
+		if !strings.HasSuffix(path, runtimeDescriptionsFile) {
+			continue
+		}
+		parsed, err := ParseRuntimeDescriptions(path)
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(descriptions, parsed)
+	}
+
+	for _, rm := range RuntimeMetrics(table, descriptions) {
+		if seen[rm.Name] {
+			continue
+		}
+		seen[rm.Name] = true
+
+		metrics = append(metrics, &adapter.RawMetric{
+			Name:             rm.Name,
+			Description:      rm.Description,
+			Unit:             rm.Unit,
+			InstrumentType:   rm.Type,
+			EnabledByDefault: rm.EnabledByDefault,
+			ComponentType:    string(domain.ComponentInstrumentation),
+			ComponentName:    componentName(rm.Name),
+			SourceLocation:   rm.Key,
+			Path:             relTable,
+		})
+	}
+
+	return metrics, nil
+}
+
+func componentName(metricName string) string {
+	switch {
+	case strings.HasPrefix(metricName, "process_"):
+		return "process"
+	case strings.HasPrefix(metricName, "go_sql_"):
+		return "database/sql"
+	default:
+		return "go"
+	}
+}
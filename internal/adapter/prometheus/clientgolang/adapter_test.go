@@ -0,0 +1,184 @@
+package clientgolang
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const dbStatsCollector = `package collectors
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func NewDBStatsCollector(db *sql.DB, dbName string) prometheus.Collector {
+	fqName := func(name string) string {
+		return "go_sql_" + name
+	}
+	return &dbStatsCollector{
+		maxOpenConnections: prometheus.NewDesc(
+			prometheus.BuildFQName("go", "sql", "max_open_connections"),
+			"Maximum number of open connections to the database.",
+			nil, prometheus.Labels{"db_name": dbName},
+		),
+		waitCount: prometheus.NewDesc(fqName("wait_count_total"), "The total number of connections waited for.", nil, nil),
+	}
+}
+`
+
+// setupRepo lays out the prometheus package the way client_golang does:
+// the default collectors, the generated runtime table and collectors/.
+func setupRepo(t *testing.T) string {
+	t.Helper()
+
+	repo := t.TempDir()
+	promDir := filepath.Join(repo, "prometheus")
+	writeCollectorFixtures(t, promDir)
+
+	collectorsDir := filepath.Join(promDir, "collectors")
+	if err := os.MkdirAll(collectorsDir, 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+
+	files := map[string]string{
+		filepath.Join(promDir, "go_collector_metrics_go122_test.go"): runtimeTable,
+		filepath.Join(collectorsDir, "dbstats_collector.go"):         dbStatsCollector,
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+	return repo
+}
+
+func extract(t *testing.T, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{
+		RepoPath: repo,
+		Files:    []string{writeRuntimeDescriptions(t, t.TempDir())},
+	})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestClientGolangAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "prometheus-client-golang" {
+		t.Errorf("expected name 'prometheus-client-golang', got %q", a.Name())
+	}
+}
+
+func TestClientGolangAdapter_RepoURL(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.RepoURL() != "https://github.com/prometheus/client_golang" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestClientGolangAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestClientGolangAdapter_Extract_DefaultCollectors(t *testing.T) {
+	metrics := extract(t, setupRepo(t))
+
+	gc := metrics["go_gc_duration_seconds"]
+	if gc == nil {
+		t.Fatal("expected go_gc_duration_seconds")
+	}
+	if gc.InstrumentType != "summary" || gc.ComponentName != "go" || !gc.EnabledByDefault {
+		t.Errorf("unexpected go_gc_duration_seconds: %+v", gc)
+	}
+	if gc.Path != filepath.Join("prometheus", "go_collector.go") {
+		t.Errorf("expected a repo-relative path, got %q", gc.Path)
+	}
+
+	cpu := metrics["process_cpu_seconds_total"]
+	if cpu == nil || cpu.InstrumentType != "counter" || cpu.ComponentName != "process" {
+		t.Errorf("unexpected process_cpu_seconds_total: %+v", cpu)
+	}
+
+	if _, ok := metrics["go_test_only"]; ok {
+		t.Error("expected descriptors from test files to be skipped")
+	}
+}
+
+func TestClientGolangAdapter_Extract_DBStatsCollector(t *testing.T) {
+	metrics := extract(t, setupRepo(t))
+
+	open := metrics["go_sql_max_open_connections"]
+	if open == nil || open.ComponentName != "database/sql" || len(open.Attributes) != 1 {
+		t.Errorf("unexpected go_sql_max_open_connections: %+v", open)
+	}
+
+	if wait := metrics["go_sql_wait_count_total"]; wait == nil || wait.InstrumentType != "counter" {
+		t.Errorf("expected closure-built go_sql_wait_count_total, got %+v", wait)
+	}
+}
+
+func TestClientGolangAdapter_Extract_RuntimeMetricsOptIn(t *testing.T) {
+	metrics := extract(t, setupRepo(t))
+
+	allocs := metrics["go_gc_heap_allocs_bytes_total"]
+	if allocs == nil {
+		t.Fatal("expected go_gc_heap_allocs_bytes_total")
+	}
+	if allocs.InstrumentType != "counter" || allocs.EnabledByDefault || allocs.SourceLocation != "/gc/heap/allocs:bytes" {
+		t.Errorf("expected an opt-in runtime counter, got %+v", allocs)
+	}
+
+	if gogc := metrics["go_gc_gogc_percent"]; gogc == nil || !gogc.EnabledByDefault {
+		t.Errorf("expected default-enabled go_gc_gogc_percent, got %+v", gogc)
+	}
+}
+
+func TestClientGolangAdapter_Extract_RuntimeMetricsFromSources(t *testing.T) {
+	metrics := extract(t, setupRepo(t))
+
+	if _, ok := metrics["go_sched_goroutines_goroutines"]; ok {
+		t.Error("expected no runtime metrics missing from the generated table")
+	}
+	if allocs := metrics["go_gc_heap_allocs_bytes_total"]; allocs == nil || allocs.Description == "" {
+		t.Errorf("expected the description from the Go source, got %+v", allocs)
+	}
+	if latencies := metrics["go_sched_latencies_seconds"]; latencies == nil || latencies.InstrumentType != "histogram" {
+		t.Errorf("expected the kind from the Go source, got %+v", latencies)
+	}
+}
+
+func TestClientGolangAdapter_Extract_InstrumentationComponents(t *testing.T) {
+	for name, m := range extract(t, setupRepo(t)) {
+		if m.ComponentType != string(domain.ComponentInstrumentation) {
+			t.Errorf("unexpected component type for %s: %s", name, m.ComponentType)
+		}
+	}
+}
+
+func TestComponentName(t *testing.T) {
+	tests := []struct {
+		metricName string
+		expected   string
+	}{
+		{"process_cpu_seconds_total", "process"},
+		{"go_sql_max_open_connections", "database/sql"},
+		{"go_goroutines", "go"},
+	}
+
+	for _, tt := range tests {
+		if got := componentName(tt.metricName); got != tt.expected {
+			t.Errorf("componentName(%q) = %q, expected %q", tt.metricName, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,341 @@
+package clientgolang
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+)
+
+// CollectorMetric is a descriptor built by one of client_golang's own
+// collectors.
+type CollectorMetric struct {
+	Name   string
+	Help   string
+	Labels []string
+	Type   string
+	File   string
+}
+
+// Value types passed alongside a descriptor when the metric is emitted
+var valueTypes = map[string]string{
+	"CounterValue": "counter",
+	"GaugeValue":   "gauge",
+	"UntypedValue": "gauge",
+}
+
+// Const metric constructors that fix the type without a ValueType argument
+var constConstructors = map[string]string{
+	"MustNewConstSummary":   "summary",
+	"NewConstSummary":       "summary",
+	"MustNewConstHistogram": "histogram",
+	"NewConstHistogram":     "histogram",
+}
+
+// desc is a NewDesc call and the field or variable it was stored in.
+type desc struct {
+	metric CollectorMetric
+	key    string
+}
+
+// ParseCollectorDir reads the descriptors declared across a package's
+// non-test files. The collectors keep descriptors in struct fields and emit
+// them from other files, so types are resolved over the whole package.
+func ParseCollectorDir(dir string) ([]CollectorMetric, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var descs []desc
+	types := make(map[string]string)
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		src, err := os.ReadFile(path) //nolint:gosec // Reading Go source files from cloned repos is intentional
+		if err != nil {
+			continue
+		}
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, name, src, 0)
+		if err != nil {
+			continue
+		}
+
+		fileDescs, fileTypes := parseCollectorFile(f)
+		for i := range fileDescs {
+			fileDescs[i].metric.File = path
+		}
+		descs = append(descs, fileDescs...)
+		for k, v := range fileTypes {
+			types[k] = v
+		}
+	}
+
+	seen := make(map[string]bool)
+	var metrics []CollectorMetric
+	for _, d := range descs {
+		if seen[d.metric.Name] {
+			continue
+		}
+		seen[d.metric.Name] = true
+
+		m := d.metric
+		if m.Type == "" {
+			m.Type = types[d.key]
+		}
+		if m.Type == "" {
+			m.Type = inferType(m.Name)
+		}
+		metrics = append(metrics, m)
+	}
+
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].Name < metrics[j].Name })
+	return metrics, nil
+}
+
+func parseCollectorFile(f *ast.File) ([]desc, map[string]string) {
+	eval := newEvaluator(f)
+	var descs []desc
+	types := make(map[string]string)
+
+	record := func(key string, call *ast.CallExpr, valType string) {
+		m, ok := eval.newDesc(call)
+		if !ok {
+			return
+		}
+		m.Type = valType
+		descs = append(descs, desc{metric: m, key: key})
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.CompositeLit:
+			// {desc: NewDesc(...), valType: GaugeValue} as in the memstats tables
+			valType := ""
+			for _, elt := range node.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if t, ok := valueTypes[selectorName(kv.Value)]; ok {
+						valType = t
+					}
+				}
+			}
+			for _, elt := range node.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				if call, ok := kv.Value.(*ast.CallExpr); ok && isNewDesc(call) {
+					record(selectorName(kv.Key), call, valType)
+				}
+			}
+		case *ast.AssignStmt:
+			for i, rhs := range node.Rhs {
+				if call, ok := rhs.(*ast.CallExpr); ok && isNewDesc(call) && i < len(node.Lhs) {
+					record(selectorName(node.Lhs[i]), call, "")
+				}
+			}
+		case *ast.ValueSpec:
+			for i, value := range node.Values {
+				if call, ok := value.(*ast.CallExpr); ok && isNewDesc(call) && i < len(node.Names) {
+					record(node.Names[i].Name, call, "")
+				}
+			}
+		case *ast.CallExpr:
+			fn := selectorName(node.Fun)
+			if len(node.Args) == 0 {
+				return true
+			}
+			key := selectorName(node.Args[0])
+			if t, ok := constConstructors[fn]; ok {
+				types[key] = t
+			} else if (fn == "MustNewConstMetric" || fn == "NewConstMetric") && len(node.Args) > 1 {
+				if t, ok := valueTypes[selectorName(node.Args[1])]; ok {
+					types[key] = t
+				}
+			}
+		}
+		return true
+	})
+
+	return descs, types
+}
+
+func isNewDesc(call *ast.CallExpr) bool {
+	return selectorName(call.Fun) == "NewDesc" && len(call.Args) >= 2
+}
+
+// selectorName returns the final identifier of x, pkg.X or c.x.
+func selectorName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
+
+// evaluator folds the string expressions collectors build names from:
+// literals, constants, concatenation, BuildFQName and one-line helpers such
+// as memstatNamespace or dbstats' fqName closure. Unknown identifiers, like
+// an unset namespace prefix, evaluate to "".
+type evaluator struct {
+	constants map[string]string
+	helpers   map[string]helper
+	depth     int
+}
+
+// Helpers calling helpers are rare; this only stops runaway recursion
+const maxHelperDepth = 8
+
+// helper is a single-parameter function whose body is one return.
+type helper struct {
+	param  string
+	result ast.Expr
+}
+
+func newEvaluator(f *ast.File) *evaluator {
+	e := &evaluator{
+		constants: astparser.Constants(f),
+		helpers:   make(map[string]helper),
+	}
+
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncDecl:
+			if node.Recv == nil {
+				e.addHelper(node.Name.Name, node.Type, node.Body)
+			}
+		case *ast.AssignStmt:
+			for i, rhs := range node.Rhs {
+				lit, ok := rhs.(*ast.FuncLit)
+				if !ok || i >= len(node.Lhs) {
+					continue
+				}
+				if ident, ok := node.Lhs[i].(*ast.Ident); ok {
+					e.addHelper(ident.Name, lit.Type, lit.Body)
+				}
+			}
+		}
+		return true
+	})
+	return e
+}
+
+func (e *evaluator) addHelper(name string, fnType *ast.FuncType, body *ast.BlockStmt) {
+	if body == nil || len(body.List) != 1 {
+		return
+	}
+	params := fnType.Params.List
+	if len(params) != 1 || len(params[0].Names) != 1 {
+		return
+	}
+	ret, ok := body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return
+	}
+	e.helpers[name] = helper{param: params[0].Names[0].Name, result: ret.Results[0]}
+}
+
+func (e *evaluator) newDesc(call *ast.CallExpr) (CollectorMetric, bool) {
+	name := e.eval(call.Args[0], nil)
+	if name == "" || strings.HasPrefix(name, "_") || strings.HasSuffix(name, "_") {
+		return CollectorMetric{}, false
+	}
+
+	m := CollectorMetric{Name: name, Help: e.eval(call.Args[1], nil)}
+	if len(call.Args) > 2 {
+		m.Labels = append(m.Labels, stringSlice(call.Args[2], e)...)
+	}
+	if len(call.Args) > 3 {
+		m.Labels = append(m.Labels, mapKeys(call.Args[3], e)...)
+	}
+	return m, true
+}
+
+func (e *evaluator) eval(expr ast.Expr, params map[string]string) string {
+	switch x := expr.(type) {
+	case *ast.BasicLit, *ast.SelectorExpr:
+		return astparser.ResolveString(x, e.constants)
+	case *ast.Ident:
+		if v, ok := params[x.Name]; ok {
+			return v
+		}
+		return astparser.ResolveString(x, e.constants)
+	case *ast.ParenExpr:
+		return e.eval(x.X, params)
+	case *ast.BinaryExpr:
+		if x.Op == token.ADD {
+			return e.eval(x.X, params) + e.eval(x.Y, params)
+		}
+	case *ast.CallExpr:
+		if selectorName(x.Fun) == "BuildFQName" {
+			var parts []string
+			for _, arg := range x.Args {
+				if v := e.eval(arg, params); v != "" {
+					parts = append(parts, v)
+				}
+			}
+			return strings.Join(parts, "_")
+		}
+		if ident, ok := x.Fun.(*ast.Ident); ok && len(x.Args) == 1 {
+			if h, ok := e.helpers[ident.Name]; ok && e.depth < maxHelperDepth {
+				arg := e.eval(x.Args[0], params)
+				e.depth++
+				defer func() { e.depth-- }()
+				return e.eval(h.result, map[string]string{h.param: arg})
+			}
+		}
+	}
+	return ""
+}
+
+func stringSlice(expr ast.Expr, e *evaluator) []string {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	var values []string
+	for _, elt := range lit.Elts {
+		if v := e.eval(elt, nil); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func mapKeys(expr ast.Expr, e *evaluator) []string {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	var keys []string
+	for _, elt := range lit.Elts {
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			if k := e.eval(kv.Key, nil); k != "" {
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func inferType(name string) string {
+	if strings.HasSuffix(name, "_total") {
+		return "counter"
+	}
+	return "gauge"
+}
@@ -0,0 +1,119 @@
+package clientgolang
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+var collectorFixtures = map[string]string{
+	"go_collector.go": `package prometheus
+
+func memstatNamespace(s string) string {
+	return "go_memstats_" + s
+}
+
+type baseGoCollector struct {
+	goroutinesDesc *Desc
+	gcDesc         *Desc
+}
+
+func newBaseGoCollector() baseGoCollector {
+	return baseGoCollector{
+		goroutinesDesc: NewDesc("go_goroutines", "Number of goroutines that currently exist.", nil, nil),
+		gcDesc: NewDesc("go_gc_duration_seconds", "A summary of the wall-time pause (stop-the-world) duration in garbage collection cycles.", nil, nil),
+	}
+}
+
+func (c *baseGoCollector) Collect(ch chan<- Metric) {
+	ch <- MustNewConstMetric(c.goroutinesDesc, GaugeValue, 1)
+	ch <- MustNewConstSummary(c.gcDesc, 0, 0, nil)
+}
+
+func goRuntimeMemStats() memStatsMetrics {
+	return memStatsMetrics{
+		{
+			desc:    NewDesc(memstatNamespace("alloc_bytes_total"), "Total number of bytes allocated in heap until now.", nil, nil),
+			valType: CounterValue,
+		}, {
+			desc:    NewDesc(memstatNamespace("heap_objects"), "Number of currently allocated objects.", nil, nil),
+			valType: GaugeValue,
+		},
+	}
+}
+
+var goInfo = NewDesc("go_info", "Information about the Go environment.", nil, Labels{"version": "go1.22"})
+`,
+	"process_collector.go": `package prometheus
+
+func NewProcessCollector(opts ProcessCollectorOpts) Collector {
+	ns := ""
+	if len(opts.Namespace) > 0 {
+		ns = opts.Namespace + "_"
+	}
+
+	c := &processCollector{}
+	c.cpuTotal = NewDesc(ns+"process_cpu_seconds_total", "Total user and system CPU time spent in seconds.", nil, nil)
+	c.openFDs = NewDesc(ns+"process_open_fds", "Number of open file descriptors.", nil, nil)
+	return c
+}
+`,
+	"process_collector_procfs.go": `package prometheus
+
+func (c *processCollector) processCollect(ch chan<- Metric) {
+	ch <- MustNewConstMetric(c.cpuTotal, CounterValue, 1)
+	ch <- MustNewConstMetric(c.openFDs, GaugeValue, 1)
+}
+`,
+	"go_collector_test.go": `package prometheus
+
+var testDesc = NewDesc("go_test_only", "ignored", nil, nil)
+`,
+}
+
+func writeCollectorFixtures(t *testing.T, dir string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range collectorFixtures {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestParseCollectorDir(t *testing.T) {
+	dir := t.TempDir()
+	writeCollectorFixtures(t, dir)
+
+	metrics, err := ParseCollectorDir(dir)
+	if err != nil {
+		t.Fatalf("ParseCollectorDir failed: %v", err)
+	}
+
+	types := make(map[string]string)
+	for _, m := range metrics {
+		types[m.Name] = m.Type
+	}
+
+	expected := map[string]string{
+		"go_goroutines":                 "gauge",
+		"go_gc_duration_seconds":        "summary",
+		"go_memstats_alloc_bytes_total": "counter",
+		"go_memstats_heap_objects":      "gauge",
+		"go_info":                       "gauge",
+		"process_cpu_seconds_total":     "counter",
+		"process_open_fds":              "gauge",
+	}
+	if !reflect.DeepEqual(types, expected) {
+		t.Errorf("expected %v, got %v", expected, types)
+	}
+
+	for _, m := range metrics {
+		if m.Name == "go_info" && !reflect.DeepEqual(m.Labels, []string{"version"}) {
+			t.Errorf("expected const label on go_info, got %v", m.Labels)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package clientgolang
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+)
+
+// RuntimeMetric is a runtime/metrics key as the Go collector exposes it.
+type RuntimeMetric struct {
+	Key         string
+	Name        string
+	Description string
+	Type        string
+	Unit        string
+	// Only a few runtime/metrics are collected without opting in through
+	// collectors.WithGoCollectorRuntimeMetrics
+	EnabledByDefault bool
+}
+
+// Exposed by the default Go collector alongside the MemStats metrics
+var defaultRuntimeMetrics = map[string]bool{
+	"/gc/gogc:percent":          true,
+	"/gc/gomemlimit:bytes":      true,
+	"/sched/gomaxprocs:threads": true,
+}
+
+var runtimeUnits = map[string]string{
+	"bytes":       "By",
+	"seconds":     "s",
+	"cpu-seconds": "s",
+	"percent":     "%",
+}
+
+var tableFileRe = regexp.MustCompile(`^go_collector_metrics_go(\d+)_test\.go$`)
+
+// LatestRuntimeTable returns the generated runtime/metrics name table for
+// the newest Go release client_golang has been generated against.
+func LatestRuntimeTable(dir string) (map[string]string, string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, "", err
+	}
+
+	best, bestVersion := "", -1
+	for _, entry := range entries {
+		m := tableFileRe.FindStringSubmatch(entry.Name())
+		if m == nil {
+			continue
+		}
+		if v, _ := strconv.Atoi(m[1]); v > bestVersion {
+			best, bestVersion = entry.Name(), v
+		}
+	}
+	if best == "" {
+		return nil, "", nil
+	}
+
+	tablePath := filepath.Join(dir, best)
+	src, err := os.ReadFile(tablePath) //nolint:gosec // Reading Go source files from cloned repos is intentional
+	if err != nil {
+		return nil, "", err
+	}
+	table, err := ParseRuntimeTable(best, src)
+	return table, tablePath, err
+}
+
+// ParseRuntimeTable reads the expectedRuntimeMetrics map from a generated
+// go_collector_metrics file.
+func ParseRuntimeTable(filename string, src []byte) (map[string]string, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	table := make(map[string]string)
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		for i, name := range spec.Names {
+			if name.Name != "expectedRuntimeMetrics" || i >= len(spec.Values) {
+				continue
+			}
+			lit, ok := spec.Values[i].(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			for _, elt := range lit.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				key := astparser.ResolveString(kv.Key, nil)
+				value := astparser.ResolveString(kv.Value, nil)
+				if key != "" && value != "" {
+					table[key] = value
+				}
+			}
+		}
+		return false
+	})
+
+	return table, nil
+}
+
+// RuntimeDescription is an entry of runtime/metrics' allDesc table.
+type RuntimeDescription struct {
+	Description string
+	Kind        string // KindUint64, KindFloat64, KindFloat64Histogram
+	Cumulative  bool
+}
+
+// ParseRuntimeDescriptions reads the allDesc table from the Go source tree's
+// runtime/metrics/description.go, keyed by metric key.
+func ParseRuntimeDescriptions(path string) (map[string]RuntimeDescription, error) {
+	src, err := os.ReadFile(path) //nolint:gosec // Reading Go source files from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions := make(map[string]RuntimeDescription)
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+		for i, name := range spec.Names {
+			if name.Name != "allDesc" || i >= len(spec.Values) {
+				continue
+			}
+			lit, ok := spec.Values[i].(*ast.CompositeLit)
+			if !ok {
+				continue
+			}
+			for _, elt := range lit.Elts {
+				entry, ok := elt.(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				key, d := runtimeDescription(entry)
+				if key != "" {
+					descriptions[key] = d
+				}
+			}
+		}
+		return false
+	})
+
+	return descriptions, nil
+}
+
+func runtimeDescription(entry *ast.CompositeLit) (string, RuntimeDescription) {
+	var key string
+	var d RuntimeDescription
+	for _, elt := range entry.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		field, ok := kv.Key.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		switch field.Name {
+		case "Name":
+			key = stringValue(kv.Value)
+		case "Description":
+			d.Description = stringValue(kv.Value)
+		case "Kind":
+			if ident, ok := kv.Value.(*ast.Ident); ok {
+				d.Kind = ident.Name
+			}
+		case "Cumulative":
+			if ident, ok := kv.Value.(*ast.Ident); ok {
+				d.Cumulative = ident.Name == "true"
+			}
+		}
+	}
+	return key, d
+}
+
+// stringValue evaluates a string literal or a concatenation of them, as
+// long descriptions are split across lines.
+func stringValue(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.STRING {
+			return ""
+		}
+		s, err := strconv.Unquote(e.Value)
+		if err != nil {
+			return ""
+		}
+		return s
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			return stringValue(e.X) + stringValue(e.Y)
+		}
+	case *ast.ParenExpr:
+		return stringValue(e.X)
+	}
+	return ""
+}
+
+// RuntimeMetrics lists the keys of client_golang's generated table under
+// the names it gives them. Kinds and descriptions come from the Go source's
+// runtime/metrics table when it has the key; otherwise the type is read
+// from the name.
+func RuntimeMetrics(table map[string]string, descriptions map[string]RuntimeDescription) []RuntimeMetric {
+	result := make([]RuntimeMetric, 0, len(table))
+	for key, name := range table {
+		rm := RuntimeMetric{
+			Key:              key,
+			Name:             name,
+			Unit:             runtimeUnit(key),
+			EnabledByDefault: defaultRuntimeMetrics[key],
+		}
+
+		if d, ok := descriptions[key]; ok {
+			rm.Description = d.Description
+			rm.Type = runtimeType(d)
+		}
+		if rm.Type == "" {
+			rm.Type = inferType(name)
+		}
+
+		result = append(result, rm)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+func runtimeType(d RuntimeDescription) string {
+	switch d.Kind {
+	case "KindFloat64Histogram":
+		return "histogram"
+	case "KindUint64", "KindFloat64":
+		if d.Cumulative {
+			return "counter"
+		}
+		return "gauge"
+	}
+	return ""
+}
+
+func runtimeUnit(key string) string {
+	_, unit, _ := strings.Cut(key, ":")
+	if u, ok := runtimeUnits[unit]; ok {
+		return u
+	}
+	if unit == "" {
+		return ""
+	}
+	return "{" + unit + "}"
+}
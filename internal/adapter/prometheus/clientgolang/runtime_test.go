@@ -0,0 +1,168 @@
+package clientgolang
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const runtimeTable = `// Code generated by gen_go_collector_metrics_set.go go1.22; DO NOT EDIT.
+
+package prometheus
+
+var expectedRuntimeMetrics = map[string]string{
+	"/gc/gogc:percent":                "go_gc_gogc_percent",
+	"/gc/heap/allocs:bytes":           "go_gc_heap_allocs_bytes_total",
+	"/sched/latencies:seconds":        "go_sched_latencies_seconds",
+	"/future/metric:widgets":          "go_future_metric_widgets",
+}
+
+const expectedRuntimeMetricsCardinality = 4
+`
+
+const runtimeDescriptions = `package metrics
+
+var allDesc = []Description{
+	{
+		Name:        "/gc/gogc:percent",
+		Description: "Heap size target percentage configured by the user, otherwise 100. This " +
+			"value is set by the GOGC environment variable.",
+		Kind: KindUint64,
+	},
+	{
+		Name:        "/gc/heap/allocs:bytes",
+		Description: "Cumulative sum of memory allocated to the heap by the application.",
+		Kind:        KindUint64,
+		Cumulative:  true,
+	},
+	{
+		Name:        "/sched/latencies:seconds",
+		Description: "Distribution of the time goroutines have spent in the scheduler in a runnable state.",
+		Kind:        KindFloat64Histogram,
+		Cumulative:  true,
+	},
+	{
+		Name:        "/sched/goroutines:goroutines",
+		Description: "Count of live goroutines.",
+		Kind:        KindUint64,
+	},
+}
+`
+
+// writeRuntimeDescriptions lays out description.go as in the Go source tree.
+func writeRuntimeDescriptions(t *testing.T, dir string) string {
+	t.Helper()
+
+	path := filepath.Join(dir, runtimeDescriptionsFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(runtimeDescriptions), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	return path
+}
+
+func TestLatestRuntimeTable(t *testing.T) {
+	dir := t.TempDir()
+	for name, src := range map[string]string{
+		"go_collector_metrics_go19_test.go":  "package prometheus\n\nvar expectedRuntimeMetrics = map[string]string{\"/old:bytes\": \"go_old_bytes\"}\n",
+		"go_collector_metrics_go122_test.go": runtimeTable,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	table, path, err := LatestRuntimeTable(dir)
+	if err != nil {
+		t.Fatalf("LatestRuntimeTable failed: %v", err)
+	}
+	if filepath.Base(path) != "go_collector_metrics_go122_test.go" {
+		t.Errorf("expected newest table, got %s", path)
+	}
+	if len(table) != 4 || table["/gc/heap/allocs:bytes"] != "go_gc_heap_allocs_bytes_total" {
+		t.Errorf("unexpected table: %v", table)
+	}
+}
+
+func TestParseRuntimeDescriptions(t *testing.T) {
+	descriptions, err := ParseRuntimeDescriptions(writeRuntimeDescriptions(t, t.TempDir()))
+	if err != nil {
+		t.Fatalf("ParseRuntimeDescriptions failed: %v", err)
+	}
+	if len(descriptions) != 4 {
+		t.Fatalf("expected 4 descriptions, got %d", len(descriptions))
+	}
+
+	gogc := descriptions["/gc/gogc:percent"]
+	if gogc.Kind != "KindUint64" || gogc.Cumulative {
+		t.Errorf("unexpected gogc description: %+v", gogc)
+	}
+	if gogc.Description != "Heap size target percentage configured by the user, otherwise 100. This value is set by the GOGC environment variable." {
+		t.Errorf("expected the concatenated description, got %q", gogc.Description)
+	}
+
+	if allocs := descriptions["/gc/heap/allocs:bytes"]; !allocs.Cumulative {
+		t.Errorf("expected a cumulative allocs description, got %+v", allocs)
+	}
+}
+
+func TestRuntimeMetrics(t *testing.T) {
+	table, err := ParseRuntimeTable("table.go", []byte(runtimeTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptions, err := ParseRuntimeDescriptions(writeRuntimeDescriptions(t, t.TempDir()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byKey := make(map[string]RuntimeMetric)
+	for _, rm := range RuntimeMetrics(table, descriptions) {
+		byKey[rm.Key] = rm
+	}
+
+	gogc := byKey["/gc/gogc:percent"]
+	if gogc.Name != "go_gc_gogc_percent" || gogc.Type != "gauge" || gogc.Unit != "%" || !gogc.EnabledByDefault || gogc.Description == "" {
+		t.Errorf("unexpected gogc metric: %+v", gogc)
+	}
+
+	allocs := byKey["/gc/heap/allocs:bytes"]
+	if allocs.Type != "counter" || allocs.Unit != "By" || allocs.EnabledByDefault {
+		t.Errorf("unexpected allocs metric: %+v", allocs)
+	}
+
+	latencies := byKey["/sched/latencies:seconds"]
+	if latencies.Type != "histogram" || latencies.Unit != "s" || latencies.EnabledByDefault {
+		t.Errorf("unexpected latencies metric: %+v", latencies)
+	}
+
+	// Keys the Go source doesn't describe keep the table's name
+	future := byKey["/future/metric:widgets"]
+	if future.Name != "go_future_metric_widgets" || future.Type != "gauge" || future.Unit != "{widgets}" || future.Description != "" {
+		t.Errorf("unexpected future metric: %+v", future)
+	}
+
+	// Described keys missing from the table are not collected
+	if _, ok := byKey["/sched/goroutines:goroutines"]; ok {
+		t.Error("expected only keys from the generated table")
+	}
+}
+
+func TestRuntimeMetrics_WithoutDescriptions(t *testing.T) {
+	table, err := ParseRuntimeTable("table.go", []byte(runtimeTable))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metrics := RuntimeMetrics(table, nil)
+	if len(metrics) != 4 {
+		t.Fatalf("expected 4 metrics, got %d", len(metrics))
+	}
+	for _, rm := range metrics {
+		if rm.Name == "go_gc_heap_allocs_bytes_total" && rm.Type != "counter" {
+			t.Errorf("expected the type read from the name, got %q", rm.Type)
+		}
+	}
+}
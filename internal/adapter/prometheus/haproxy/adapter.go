@@ -0,0 +1,135 @@
+package haproxy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/haproxy/haproxy"
+
+// promexSource is the native Prometheus exporter; field descriptions live
+// in the stats sources under src/.
+var promexSource = filepath.Join("addons", "promex", "service-prometheus.c")
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-haproxy",
+		Category:    domain.SourcePrometheus,
+		Description: "HAProxy native Prometheus exporter metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "proxy"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "prometheus-haproxy"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(_ context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	statsFiles, err := filepath.Glob(filepath.Join(result.RepoPath, "src", "stats*.c"))
+	if err != nil {
+		return nil, err
+	}
+
+	var sources []string
+	for _, path := range append(statsFiles, filepath.Join(result.RepoPath, promexSource)) {
+		content, err := os.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, string(content))
+	}
+
+	defs := Metrics(ParseFields(sources...))
+	relPromex := filepath.ToSlash(promexSource)
+
+	metrics := make([]*adapter.RawMetric, 0, len(defs))
+	for _, def := range defs {
+		attrs := make([]domain.Attribute, 0, len(def.Labels))
+		for _, label := range def.Labels {
+			attrs = append(attrs, domain.Attribute{Name: label, Type: "string"})
+		}
+
+		metrics = append(metrics, &adapter.RawMetric{
+			Name:             def.Name,
+			Description:      def.Help,
+			InstrumentType:   def.Type,
+			Unit:             unit(def.Name),
+			Attributes:       attrs,
+			EnabledByDefault: true,
+			ComponentType:    string(domain.ComponentPlatform),
+			ComponentName:    "haproxy",
+			SourceLocation:   relPromex,
+			Path:             relPromex,
+		})
+	}
+
+	return metrics, nil
+}
+
+func unit(name string) string {
+	name = strings.TrimSuffix(name, "_total")
+	switch {
+	case strings.HasSuffix(name, "_bytes"):
+		return "By"
+	case strings.HasSuffix(name, "_seconds"):
+		return "s"
+	}
+	return ""
+}
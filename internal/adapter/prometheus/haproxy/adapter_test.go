@@ -0,0 +1,122 @@
+package haproxy
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func setupRepo(t *testing.T) string {
+	t.Helper()
+
+	repo := t.TempDir()
+	for path, content := range map[string]string{
+		"src/stats.c":                        legacyStats,
+		"addons/promex/service-prometheus.c": legacyPromex,
+	} {
+		full := filepath.Join(repo, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(full), 0750); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+	return repo
+}
+
+func extract(t *testing.T, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestHAProxyAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "prometheus-haproxy" {
+		t.Errorf("expected name 'prometheus-haproxy', got %q", a.Name())
+	}
+}
+
+func TestHAProxyAdapter_RepoURL(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.RepoURL() != "https://github.com/haproxy/haproxy" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestHAProxyAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestHAProxyAdapter_Extract_MetricPerScope(t *testing.T) {
+	metrics := extract(t, setupRepo(t))
+	if len(metrics) != 7 {
+		t.Fatalf("expected 7 metrics, got %d", len(metrics))
+	}
+
+	for name, m := range metrics {
+		if m.ComponentType != string(domain.ComponentPlatform) || m.ComponentName != "haproxy" {
+			t.Errorf("unexpected component for %s: %s/%s", name, m.ComponentType, m.ComponentName)
+		}
+	}
+}
+
+func TestHAProxyAdapter_Extract_ProxyLabel(t *testing.T) {
+	sessions := extract(t, setupRepo(t))["haproxy_backend_current_sessions"]
+	if sessions == nil {
+		t.Fatal("expected haproxy_backend_current_sessions")
+	}
+	if len(sessions.Attributes) != 1 || sessions.Attributes[0].Name != "proxy" {
+		t.Errorf("expected the proxy label, got %+v", sessions.Attributes)
+	}
+}
+
+func TestHAProxyAdapter_Extract_UnitFromName(t *testing.T) {
+	uptime := extract(t, setupRepo(t))["haproxy_process_uptime_seconds"]
+	if uptime == nil || uptime.Unit != "s" {
+		t.Errorf("expected a seconds unit, got %+v", uptime)
+	}
+}
+
+func TestHAProxyAdapter_Extract_MissingPromex(t *testing.T) {
+	repo := setupRepo(t)
+	if err := os.Remove(filepath.Join(repo, promexSource)); err != nil {
+		t.Fatalf("failed to remove file: %v", err)
+	}
+
+	_, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err == nil {
+		t.Error("expected an error without the promex source")
+	}
+}
+
+func TestUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"haproxy_process_uptime_seconds", "s"},
+		{"haproxy_process_pool_allocated_bytes", "By"},
+		{"haproxy_frontend_current_sessions", ""},
+	}
+
+	for _, tt := range tests {
+		if got := unit(tt.name); got != tt.expected {
+			t.Errorf("unit(%q) = %q, expected %q", tt.name, got, tt.expected)
+		}
+	}
+}
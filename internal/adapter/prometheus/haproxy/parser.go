@@ -0,0 +1,321 @@
+package haproxy
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Field is one entry of HAProxy's stats field tables, keyed by its index
+// constant such as ST_I_PX_STOT or ST_F_STOT.
+type Field struct {
+	Index   string
+	Name    string
+	AltName string
+	Desc    string
+	Type    string
+	Scopes  []string
+}
+
+// MetricDef is a metric as the Prometheus exporter (promex) names it.
+type MetricDef struct {
+	Name   string
+	Help   string
+	Type   string
+	Scope  string
+	Labels []string
+}
+
+var (
+	// [ST_F_PXNAME] = { .name = "pxname", .desc = "The proxy name" }
+	structEntryPattern = regexp.MustCompile(`\[\s*(\w+)\s*\]\s*=\s*\{([^{}]*)\}`)
+	// [ST_I_PX_STOT] = ME_NEW_PX("stot", "sessions_total", FN_COUNTER, ..., "desc")
+	macroEntryPattern = regexp.MustCompile(`\[\s*(\w+)\s*\]\s*=\s*ME_NEW_\w+\s*\(`)
+	// [ST_F_PXNAME] = IST("The proxy name."), promex's own descriptions
+	istDescPattern    = regexp.MustCompile(`\[\s*(\w+)\s*\]\s*=\s*IST\(\s*"((?:[^"\\]|\\.)*)"\s*\)`)
+	designatorPattern = regexp.MustCompile(`\.(\w+)\s*=\s*("(?:[^"\\]|\\.)*"|IST\(\s*"(?:[^"\\]|\\.)*"\s*\)|[^,]+)`)
+	stringPattern     = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+	// STATS_PX_CAP_LFBS: one letter per listener/frontend/backend/server
+	capLettersPattern = regexp.MustCompile(`STATS_PX_CAP_([_LFBS]{4})\b`)
+)
+
+// Flags and capabilities naming where a field is exported
+var scopeFlags = map[string]string{
+	"PROMEX_FL_INFO_METRIC":       "process",
+	"PROMEX_FL_FRONT_METRIC":      "frontend",
+	"PROMEX_FL_BACK_METRIC":       "backend",
+	"PROMEX_FL_SRV_METRIC":        "server",
+	"PROMEX_FL_LI_METRIC":         "listener",
+	"PROMEX_FL_STICKTABLE_METRIC": "sticktable",
+	"STATS_PX_CAP_FE":             "frontend",
+	"STATS_PX_CAP_BE":             "backend",
+	"STATS_PX_CAP_SRV":            "server",
+	"STATS_PX_CAP_LI":             "listener",
+}
+
+var capLetters = map[rune]string{
+	'L': "listener",
+	'F': "frontend",
+	'B': "backend",
+	'S': "server",
+}
+
+// scopeOrder keeps output stable when a field is exported at several scopes.
+var scopeOrder = []string{"process", "frontend", "backend", "server", "listener", "sticktable"}
+
+// scopeLabels are the labels promex attaches at each scope.
+var scopeLabels = map[string][]string{
+	"frontend":   {"proxy"},
+	"backend":    {"proxy"},
+	"server":     {"proxy", "server"},
+	"listener":   {"proxy", "listener"},
+	"sticktable": {"name", "type"},
+}
+
+// ParseFields reads the indexed table entries of several source files into
+// one set: the stats field descriptions, their 3.x ME_NEW_* column
+// definitions, promex's metric table and promex's description overrides.
+// Later sources override earlier ones, so promex should come last.
+func ParseFields(sources ...string) map[string]*Field {
+	fields := make(map[string]*Field)
+	for _, content := range sources {
+		parseFields(content, fields)
+	}
+	return fields
+}
+
+func parseFields(content string, fields map[string]*Field) {
+	get := func(index string) *Field {
+		if f, ok := fields[index]; ok {
+			return f
+		}
+		f := &Field{Index: index}
+		fields[index] = f
+		return f
+	}
+
+	for _, m := range structEntryPattern.FindAllStringSubmatch(content, -1) {
+		f := get(m[1])
+		for _, d := range designatorPattern.FindAllStringSubmatch(m[2], -1) {
+			value := strings.TrimSpace(d[2])
+			switch d[1] {
+			case "name":
+				f.Name = unquote(value)
+			case "n":
+				f.AltName = unquote(value)
+			case "alt_name":
+				f.AltName = unquote(value)
+			case "desc":
+				f.Desc = unquote(value)
+			case "type":
+				f.Type = fieldType(value)
+			case "flags", "cap":
+				f.Scopes = mergeScopes(f.Scopes, scopes(value))
+			}
+		}
+	}
+
+	for _, loc := range macroEntryPattern.FindAllStringSubmatchIndex(content, -1) {
+		end := findMatchingParen(content, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		f := get(content[loc[2]:loc[3]])
+		args := splitArgs(content[loc[1] : end-1])
+
+		var strs []string
+		for i, arg := range args {
+			if m := stringPattern.FindStringSubmatch(arg); m != nil && strings.HasPrefix(arg, `"`) {
+				strs = append(strs, m[1])
+				// The alternate name directly follows the name, when set
+				if i == 1 && f.AltName == "" {
+					f.AltName = m[1]
+				}
+				continue
+			}
+			if t := fieldType(arg); t != "" {
+				f.Type = t
+			}
+			f.Scopes = mergeScopes(f.Scopes, scopes(arg))
+		}
+		if len(strs) > 0 {
+			f.Name = strs[0]
+			f.Desc = strs[len(strs)-1]
+		}
+	}
+
+	for _, m := range istDescPattern.FindAllStringSubmatch(content, -1) {
+		get(m[1]).Desc = unescape(m[2])
+	}
+}
+
+// Metrics builds promex's metric names from fields parsed across the stats
+// and promex sources. Only fields with a Prometheus name are exported, once
+// per scope they are reported at.
+func Metrics(fields map[string]*Field) []MetricDef {
+	var metrics []MetricDef
+	seen := make(map[string]bool)
+
+	for _, f := range fields {
+		if f.AltName == "" {
+			continue
+		}
+		fieldScopes := f.Scopes
+		if len(fieldScopes) == 0 && isInfoIndex(f.Index) {
+			fieldScopes = []string{"process"}
+		}
+
+		for _, scope := range fieldScopes {
+			name := "haproxy_" + scope + "_" + f.AltName
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			t := f.Type
+			if t == "" {
+				t = inferType(f.AltName)
+			}
+			metrics = append(metrics, MetricDef{
+				Name:   name,
+				Help:   f.Desc,
+				Type:   t,
+				Scope:  scope,
+				Labels: scopeLabels[scope],
+			})
+		}
+	}
+
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].Name < metrics[j].Name })
+	return metrics
+}
+
+// isInfoIndex reports whether an index belongs to the process info table
+// (INF_* before 3.0, ST_I_INF_* after).
+func isInfoIndex(index string) bool {
+	return strings.HasPrefix(index, "INF_") || strings.HasPrefix(index, "ST_I_INF_")
+}
+
+func fieldType(value string) string {
+	switch {
+	case strings.Contains(value, "PROMEX_MT_COUNTER"), strings.Contains(value, "FN_COUNTER"):
+		return "counter"
+	case strings.Contains(value, "PROMEX_MT_GAUGE"), strings.Contains(value, "FN_GAUGE"),
+		strings.Contains(value, "FN_MAX"), strings.Contains(value, "FN_AVG"),
+		strings.Contains(value, "FN_RATE"), strings.Contains(value, "FN_AGE"),
+		strings.Contains(value, "FN_DURATION"):
+		return "gauge"
+	}
+	return ""
+}
+
+func scopes(value string) []string {
+	var found []string
+	for flag, scope := range scopeFlags {
+		if strings.Contains(value, flag) {
+			found = mergeScopes(found, []string{scope})
+		}
+	}
+	for _, m := range capLettersPattern.FindAllStringSubmatch(value, -1) {
+		for _, letter := range m[1] {
+			if scope, ok := capLetters[letter]; ok {
+				found = mergeScopes(found, []string{scope})
+			}
+		}
+	}
+	return found
+}
+
+func mergeScopes(existing, add []string) []string {
+	set := make(map[string]bool)
+	for _, s := range existing {
+		set[s] = true
+	}
+	for _, s := range add {
+		set[s] = true
+	}
+	var merged []string
+	for _, s := range scopeOrder {
+		if set[s] {
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
+
+func inferType(name string) string {
+	if strings.HasSuffix(name, "_total") {
+		return "counter"
+	}
+	return "gauge"
+}
+
+func unquote(value string) string {
+	if m := stringPattern.FindStringSubmatch(value); m != nil {
+		return unescape(m[1])
+	}
+	return ""
+}
+
+func unescape(s string) string {
+	return strings.NewReplacer(`\"`, `"`, `\n`, " ", `\\`, `\`).Replace(s)
+}
+
+func findMatchingParen(content string, start int) int {
+	if start >= len(content) || content[start] != '(' {
+		return -1
+	}
+
+	depth := 1
+	inString := false
+	for i := start + 1; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return -1
+}
+
+func splitArgs(content string) []string {
+	var args []string
+	depth := 0
+	inString := false
+	start := 0
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '(' || c == '{':
+			depth++
+		case c == ')' || c == '}':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(content[start:i]))
+			start = i + 1
+		}
+	}
+
+	if rest := strings.TrimSpace(content[start:]); rest != "" {
+		args = append(args, rest)
+	}
+
+	return args
+}
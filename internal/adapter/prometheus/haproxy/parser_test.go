@@ -0,0 +1,109 @@
+package haproxy
+
+import (
+	"testing"
+)
+
+// Before 3.0: stats.c describes fields, promex lists what it exports
+const legacyStats = `
+const struct name_desc stat_fields[ST_F_TOTAL_FIELDS] = {
+	[ST_F_PXNAME]  = { .name = "pxname",  .desc = "Proxy name" },
+	[ST_F_SCUR]    = { .name = "scur",    .desc = "Number of current sessions on the frontend, backend or server" },
+	[ST_F_STOT]    = { .name = "stot",    .desc = "Total number of sessions since process started" },
+};
+
+const struct name_desc info_fields[INF_TOTAL_FIELDS] = {
+	[INF_NAME]     = { .name = "Name",    .desc = "Product name" },
+	[INF_UPTIME_SEC] = { .name = "Uptime_sec", .desc = "How long ago this worker process was started (seconds)" },
+};
+`
+
+const legacyPromex = `
+const struct promex_metric promex_global_metrics[INF_TOTAL_FIELDS] = {
+	[INF_UPTIME_SEC] = { .n = IST("uptime_seconds"), .type = PROMEX_MT_GAUGE, .flags = PROMEX_FL_INFO_METRIC },
+};
+
+const struct promex_metric promex_st_metrics[ST_F_TOTAL_FIELDS] = {
+	[ST_F_SCUR] = { .n = IST("current_sessions"), .type = PROMEX_MT_GAUGE,   .flags = (PROMEX_FL_FRONT_METRIC | PROMEX_FL_LI_METRIC | PROMEX_FL_BACK_METRIC | PROMEX_FL_SRV_METRIC) },
+	[ST_F_STOT] = { .n = IST("sessions_total"),   .type = PROMEX_MT_COUNTER, .flags = (PROMEX_FL_FRONT_METRIC | PROMEX_FL_BACK_METRIC) },
+};
+
+const struct ist promex_st_metric_desc[ST_F_TOTAL_FIELDS] = {
+	[ST_F_SCUR] = IST("Current number of active sessions."),
+};
+`
+
+// From 3.0 the stats columns carry the Prometheus name themselves
+const columnStats = `
+const struct stat_col stat_cols_px[ST_I_PX_MAX] = {
+	[ST_I_PX_PXNAME] = { .name = "pxname", .alt_name = NULL, .desc = "Proxy name" },
+	[ST_I_PX_STOT]   = ME_NEW_PX("stot", "sessions_total", FN_COUNTER, FF_U64, STATS_PX_CAP_LFBS, "Total number of sessions since process started"),
+	[ST_I_PX_QCUR]   = ME_NEW_BE("qcur", "current_queue", FN_GAUGE, FF_U32, STATS_PX_CAP___BS, "Number of current queued connections"),
+};
+`
+
+func TestMetricsLegacyTables(t *testing.T) {
+	defs := Metrics(ParseFields(legacyStats, legacyPromex))
+
+	byName := make(map[string]MetricDef)
+	for _, d := range defs {
+		byName[d.Name] = d
+	}
+	if len(defs) != 7 {
+		t.Errorf("expected 7 metrics, got %d: %+v", len(defs), defs)
+	}
+
+	uptime := byName["haproxy_process_uptime_seconds"]
+	if uptime.Type != "gauge" || uptime.Help != "How long ago this worker process was started (seconds)" || len(uptime.Labels) != 0 {
+		t.Errorf("unexpected process uptime: %+v", uptime)
+	}
+
+	server := byName["haproxy_server_current_sessions"]
+	if server.Help != "Current number of active sessions." {
+		t.Errorf("expected promex description override, got %q", server.Help)
+	}
+	if len(server.Labels) != 2 || server.Labels[1] != "server" {
+		t.Errorf("unexpected server labels %v", server.Labels)
+	}
+	if _, ok := byName["haproxy_listener_current_sessions"]; !ok {
+		t.Error("expected listener scope")
+	}
+
+	if sessions := byName["haproxy_frontend_sessions_total"]; sessions.Type != "counter" {
+		t.Errorf("unexpected frontend sessions: %+v", sessions)
+	}
+	if _, ok := byName["haproxy_server_sessions_total"]; ok {
+		t.Error("sessions_total is not exported for servers in this fixture")
+	}
+}
+
+func TestMetricsColumnTables(t *testing.T) {
+	fields := ParseFields(columnStats)
+
+	stot := fields["ST_I_PX_STOT"]
+	if stot == nil || stot.Name != "stot" || stot.AltName != "sessions_total" || stot.Type != "counter" {
+		t.Fatalf("unexpected ST_I_PX_STOT: %+v", stot)
+	}
+	if stot.Desc != "Total number of sessions since process started" {
+		t.Errorf("unexpected description %q", stot.Desc)
+	}
+
+	if len(stot.Scopes) != 4 {
+		t.Errorf("expected LFBS to cover four scopes, got %v", stot.Scopes)
+	}
+
+	defs := Metrics(fields)
+	names := make(map[string]bool)
+	for _, d := range defs {
+		names[d.Name] = true
+	}
+	if len(defs) != 6 {
+		t.Errorf("expected 6 metrics, got %d: %v", len(defs), names)
+	}
+	if !names["haproxy_listener_sessions_total"] || !names["haproxy_backend_current_queue"] || !names["haproxy_server_current_queue"] {
+		t.Errorf("unexpected column metrics %v", names)
+	}
+	if names["haproxy_frontend_current_queue"] || names["haproxy_frontend_pxname"] {
+		t.Errorf("unexpected column metrics %v", names)
+	}
+}
@@ -6,10 +6,10 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/base-14/metric-library/internal/adapter"
 	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 const repoURL = "https://github.com/danielqsj/kafka_exporter"
@@ -18,6 +18,19 @@ type Adapter struct {
 	fetcher *fetcher.GitFetcher
 }
 
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-kafka",
+		Category:    domain.SourcePrometheus,
+		Description: "Prometheus kafka_exporter metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "exporter", "messaging"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
 func NewAdapter(cacheDir string) *Adapter {
 	return &Adapter{
 		fetcher: fetcher.NewGitFetcher(cacheDir),
@@ -91,11 +104,17 @@ func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*
 		}
 
 		for _, def := range defs {
+			instrumentType := def.Type
+			if instrumentType == "" {
+				instrumentType = inferInstrumentType(def.Name)
+			}
+
 			rawMetric := &adapter.RawMetric{
 				Name:             def.Name,
 				Description:      def.Help,
-				InstrumentType:   inferInstrumentType(def.Name),
+				InstrumentType:   instrumentType,
 				Attributes:       labelsToAttributes(def.Labels),
+				BucketBoundaries: def.Buckets,
 				EnabledByDefault: true,
 				ComponentType:    string(domain.ComponentPlatform),
 				ComponentName:    componentName,
@@ -0,0 +1,256 @@
+package micrometer
+
+import (
+	"context"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// source describes one repository whose Java sources register Micrometer meters.
+type source struct {
+	adapterName string
+	repoURL     string
+	confidence  domain.ConfidenceLevel
+	// documented meters registered through the Observation API, where the
+	// name and tag keys live in conventions rather than builder chains
+	documented []*MeterDef
+}
+
+var (
+	micrometerCore = source{
+		adapterName: "prometheus-micrometer",
+		repoURL:     "https://github.com/micrometer-metrics/micrometer",
+		confidence:  domain.ConfidenceAuthoritative,
+	}
+	springBoot = source{
+		adapterName: "prometheus-spring-boot",
+		repoURL:     "https://github.com/spring-projects/spring-boot",
+		confidence:  domain.ConfidenceDerived,
+		documented: []*MeterDef{
+			{
+				Name:        "http.server.requests",
+				Builder:     "Timer",
+				Description: "Duration of HTTP server request handling",
+				Tags:        []string{"exception", "method", "outcome", "status", "uri", "error"},
+			},
+			{
+				Name:        "http.client.requests",
+				Builder:     "Timer",
+				Description: "Duration of HTTP client requests",
+				Tags:        []string{"client.name", "exception", "method", "outcome", "status", "uri", "error"},
+			},
+			{
+				Name:        "spring.data.repository.invocations",
+				Builder:     "Timer",
+				Description: "Duration of Spring Data repository method invocations",
+				Tags:        []string{"repository", "method", "state", "exception"},
+			},
+			{
+				Name:        "tasks.scheduled.execution",
+				Builder:     "Timer",
+				Description: "Duration of @Scheduled task executions",
+				Tags:        []string{"code.function", "code.namespace", "exception", "outcome"},
+			},
+		},
+	}
+)
+
+// skipDirs hold tests, samples and docs whose meters are not shipped.
+var skipDirs = map[string]bool{
+	"test":                    true,
+	"testFixtures":            true,
+	"jmh":                     true,
+	"samples":                 true,
+	"docs":                    true,
+	"benchmarks":              true,
+	"micrometer-test":         true,
+	"spring-boot-smoke-tests": true,
+	"spring-boot-docs":        true,
+	"integration-test":        true,
+}
+
+// Adapter extracts Micrometer meters and emits each under both its dotted
+// Micrometer name and the name the Prometheus registry renders it as.
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+	source  source
+}
+
+func newAdapter(cacheDir string, s source) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+		source:  s,
+	}
+}
+
+// NewAdapter reads the binders shipped with Micrometer itself.
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-micrometer",
+		Category:    domain.SourcePrometheus,
+		Description: "Micrometer binder meters",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "java", "micrometer"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-spring-boot",
+		Category:    domain.SourcePrometheus,
+		Description: "Spring Boot Actuator meters",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "java", "micrometer"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewSpringBootAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, micrometerCore)
+}
+
+// NewSpringBootAdapter reads the meters Spring Boot auto-configures.
+func NewSpringBootAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, springBoot)
+}
+
+func (a *Adapter) Name() string {
+	return a.source.adapterName
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return a.source.confidence
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return a.source.repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: a.source.repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	var metrics []*adapter.RawMetric
+	seen := make(map[string]bool)
+
+	emit := func(def *MeterDef, component, path string) {
+		for _, m := range toRawMetrics(def, component, path) {
+			if seen[m.Name] {
+				continue
+			}
+			seen[m.Name] = true
+			metrics = append(metrics, m)
+		}
+	}
+
+	err := filepath.WalkDir(result.RepoPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if skipDirs[d.Name()] || (strings.HasPrefix(d.Name(), ".") && path != result.RepoPath) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".java") {
+			return nil
+		}
+
+		defs, err := ParseFile(path)
+		if err != nil {
+			return err
+		}
+
+		relPath, _ := filepath.Rel(result.RepoPath, path)
+		for _, def := range defs {
+			emit(def, componentName(relPath), filepath.ToSlash(relPath))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, def := range a.source.documented {
+		emit(def, strings.SplitN(def.Name, ".", 2)[0], "")
+	}
+
+	return metrics, nil
+}
+
+// toRawMetrics returns the meter under its Micrometer name followed by its
+// Prometheus rendering, which also carries the exposed TYPE.
+func toRawMetrics(def *MeterDef, component, path string) []*adapter.RawMetric {
+	attrs := make([]domain.Attribute, 0, len(def.Tags))
+	for _, tag := range def.Tags {
+		attrs = append(attrs, domain.Attribute{Name: tag, Type: "string"})
+	}
+
+	dotted := &adapter.RawMetric{
+		Name:             def.Name,
+		Description:      def.Description,
+		Unit:             ucumUnit(def.baseUnit()),
+		InstrumentType:   instrumentType(def.Builder),
+		Attributes:       attrs,
+		EnabledByDefault: true,
+		ComponentType:    string(domain.ComponentInstrumentation),
+		ComponentName:    component,
+		SourceLocation:   path,
+		Path:             path,
+	}
+
+	rendered := *dotted
+	rendered.Name = PrometheusName(def)
+	rendered.Attributes = append([]domain.Attribute(nil), attrs...)
+	rendered.InstrumentType = prometheusType(def.Builder)
+
+	if rendered.Name == dotted.Name {
+		return []*adapter.RawMetric{dotted}
+	}
+	return []*adapter.RawMetric{dotted, &rendered}
+}
+
+// componentName is the package a meter is registered from, e.g.
+// .../binder/jvm/JvmMemoryMetrics.java -> "jvm".
+func componentName(relPath string) string {
+	dir := filepath.Dir(relPath)
+	name := filepath.Base(dir)
+	if name == "internal" || name == "binder" {
+		name = filepath.Base(filepath.Dir(dir))
+	}
+	return name
+}
@@ -0,0 +1,189 @@
+package micrometer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const jvmMemoryPath = "micrometer-core/src/main/java/io/micrometer/core/instrument/binder/jvm/JvmMemoryMetrics.java"
+
+const startupListener = `
+public class StartupTimeMetricsListener {
+	public static final String APPLICATION_STARTED_TIME_METRIC = "application.started.time";
+
+	private void registerGauge(String name, String description, Duration timeTaken, Iterable<Tag> tags) {
+		TimeGauge.builder(APPLICATION_STARTED_TIME_METRIC, timeTaken::toMillis, TimeUnit.MILLISECONDS)
+			.tags(tags)
+			.description("Time taken to start the application")
+			.register(this.meterRegistry);
+	}
+}
+`
+
+func writeRepoFile(t *testing.T, repo, rel, content string) {
+	t.Helper()
+
+	path := filepath.Join(repo, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func extract(t *testing.T, a *Adapter, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := a.Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestMicrometerAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "prometheus-micrometer" {
+		t.Errorf("expected name 'prometheus-micrometer', got %q", a.Name())
+	}
+}
+
+func TestMicrometerAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestSpringBootAdapter_Name(t *testing.T) {
+	a := NewSpringBootAdapter("/tmp/cache")
+	if a.Name() != "prometheus-spring-boot" {
+		t.Errorf("expected name 'prometheus-spring-boot', got %q", a.Name())
+	}
+}
+
+func TestSpringBootAdapter_Confidence(t *testing.T) {
+	a := NewSpringBootAdapter("/tmp/cache")
+	if a.Confidence() != domain.ConfidenceDerived {
+		t.Errorf("expected confidence 'derived', got %q", a.Confidence())
+	}
+}
+
+func TestSpringBootAdapter_RepoURL(t *testing.T) {
+	a := NewSpringBootAdapter("/tmp/cache")
+	if a.RepoURL() != "https://github.com/spring-projects/spring-boot" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestMicrometerAdapter_Extract_DottedAndRenderedNames(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, jvmMemoryPath, jvmMemorySource)
+
+	metrics := extract(t, NewAdapter("/tmp/cache"), repo)
+	if len(metrics) != 6 {
+		t.Errorf("expected 3 meters under two names each, got %d", len(metrics))
+	}
+
+	used := metrics["jvm.memory.used"]
+	if used == nil || used.InstrumentType != "gauge" || used.Unit != "By" {
+		t.Errorf("unexpected jvm.memory.used: %+v", used)
+	}
+
+	rendered := metrics["jvm_memory_used_bytes"]
+	if rendered == nil || rendered.Description != "The amount of used memory" || len(rendered.Attributes) != 1 {
+		t.Errorf("unexpected jvm_memory_used_bytes: %+v", rendered)
+	}
+
+	if metrics["jvm_gc_memory_allocated_bytes_total"] == nil {
+		t.Error("expected the rendered counter name")
+	}
+}
+
+func TestMicrometerAdapter_Extract_TimerRendersAsSummary(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, jvmMemoryPath, jvmMemorySource)
+
+	metrics := extract(t, NewAdapter("/tmp/cache"), repo)
+
+	pause := metrics["jvm.gc.pause"]
+	if pause == nil || pause.InstrumentType != "histogram" || pause.Unit != "s" {
+		t.Errorf("unexpected jvm.gc.pause: %+v", pause)
+	}
+	if rendered := metrics["jvm_gc_pause_seconds"]; rendered == nil || rendered.InstrumentType != "summary" {
+		t.Errorf("unexpected jvm_gc_pause_seconds: %+v", rendered)
+	}
+}
+
+func TestMicrometerAdapter_Extract_ComponentFromBinderPackage(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, jvmMemoryPath, jvmMemorySource)
+
+	for name, m := range extract(t, NewAdapter("/tmp/cache"), repo) {
+		if m.ComponentName != "jvm" {
+			t.Errorf("expected component 'jvm' for %s, got %q", name, m.ComponentName)
+		}
+		if m.Path != jvmMemoryPath {
+			t.Errorf("expected a repo-relative path for %s, got %q", name, m.Path)
+		}
+	}
+}
+
+func TestMicrometerAdapter_Extract_SkipsTestSources(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "micrometer-core/src/test/java/io/micrometer/core/instrument/FakeMetrics.java",
+		`Counter.builder("fake.counter").register(registry);`)
+
+	if metrics := extract(t, NewAdapter("/tmp/cache"), repo); metrics["fake.counter"] != nil {
+		t.Error("expected test sources to be skipped")
+	}
+}
+
+func TestSpringBootAdapter_Extract_SourceMeters(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, "spring-boot-project/spring-boot-actuator/src/main/java/org/springframework/boot/actuate/metrics/startup/StartupTimeMetricsListener.java", startupListener)
+
+	metrics := extract(t, NewSpringBootAdapter("/tmp/cache"), repo)
+
+	started := metrics["application_started_time_seconds"]
+	if started == nil || started.ComponentName != "startup" {
+		t.Errorf("unexpected application_started_time_seconds: %+v", started)
+	}
+}
+
+func TestSpringBootAdapter_Extract_ObservationMeters(t *testing.T) {
+	metrics := extract(t, NewSpringBootAdapter("/tmp/cache"), t.TempDir())
+
+	requests := metrics["http_server_requests_seconds"]
+	if requests == nil || requests.ComponentName != "http" || len(requests.Attributes) != 6 {
+		t.Errorf("unexpected http_server_requests_seconds: %+v", requests)
+	}
+	if metrics["http.server.requests"] == nil {
+		t.Error("expected dotted http.server.requests")
+	}
+}
+
+func TestComponentName(t *testing.T) {
+	tests := []struct {
+		relPath  string
+		expected string
+	}{
+		{"micrometer-core/src/main/java/io/micrometer/core/instrument/binder/jvm/JvmMemoryMetrics.java", "jvm"},
+		{"micrometer-core/src/main/java/io/micrometer/core/instrument/binder/jvm/internal/JvmInfo.java", "jvm"},
+		{"spring/actuate/metrics/startup/StartupTimeMetricsListener.java", "startup"},
+	}
+
+	for _, tt := range tests {
+		if got := componentName(tt.relPath); got != tt.expected {
+			t.Errorf("componentName(%q) = %q, expected %q", tt.relPath, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package micrometer
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_:]`)
+
+// meterKind groups the builders by how registries render them.
+type meterKind int
+
+const (
+	kindCounter meterKind = iota
+	kindGauge
+	kindTimer
+	kindLongTaskTimer
+	kindSummary
+)
+
+func builderKind(builder string) meterKind {
+	switch builder {
+	case "Counter", "FunctionCounter":
+		return kindCounter
+	case "Timer", "FunctionTimer":
+		return kindTimer
+	case "LongTaskTimer":
+		return kindLongTaskTimer
+	case "DistributionSummary":
+		return kindSummary
+	}
+	return kindGauge
+}
+
+// baseUnit returns the unit a registry reports the meter in; timers and
+// TimeGauge are always rendered in seconds by the Prometheus registry.
+func (d *MeterDef) baseUnit() string {
+	switch {
+	case builderKind(d.Builder) == kindTimer, builderKind(d.Builder) == kindLongTaskTimer, d.Builder == "TimeGauge":
+		return "seconds"
+	}
+	return d.BaseUnit
+}
+
+// PrometheusName renders a meter name the way Micrometer's
+// PrometheusNamingConvention does: snake case, the base unit appended to
+// counters, gauges and summaries, _total on counters and _seconds on timers.
+func PrometheusName(d *MeterDef) string {
+	name := strings.ReplaceAll(d.Name, ".", "_")
+	unit := d.baseUnit()
+	kind := builderKind(d.Builder)
+
+	switch kind {
+	case kindCounter, kindGauge, kindSummary:
+		if unit != "" && !strings.HasSuffix(name, "_"+unit) {
+			name += "_" + unit
+		}
+	}
+
+	switch kind {
+	case kindCounter:
+		if !strings.HasSuffix(name, "_total") {
+			name += "_total"
+		}
+	case kindTimer, kindLongTaskTimer:
+		if !strings.HasSuffix(name, "_seconds") {
+			name += "_seconds"
+		}
+	}
+
+	name = invalidNameChars.ReplaceAllString(name, "_")
+	if name != "" && !isLetter(name[0]) {
+		name = "m_" + name
+	}
+	return name
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// instrumentType is the meter's own type; timers and distribution summaries
+// record distributions while a long task timer reports in-flight tasks.
+func instrumentType(builder string) string {
+	switch builderKind(builder) {
+	case kindCounter:
+		return string(domain.InstrumentCounter)
+	case kindTimer, kindSummary:
+		return string(domain.InstrumentHistogram)
+	case kindLongTaskTimer:
+		return string(domain.InstrumentUpDownCounter)
+	}
+	return string(domain.InstrumentGauge)
+}
+
+// prometheusType is the TYPE line the Prometheus registry writes; without
+// publishPercentileHistogram timers and summaries are exposed as summaries.
+func prometheusType(builder string) string {
+	switch builderKind(builder) {
+	case kindCounter:
+		return string(domain.InstrumentCounter)
+	case kindTimer, kindSummary:
+		return string(domain.InstrumentSummary)
+	}
+	return string(domain.InstrumentGauge)
+}
+
+// ucumUnit translates Micrometer base units to the catalog's UCUM units.
+func ucumUnit(unit string) string {
+	switch unit {
+	case "":
+		return ""
+	case "bytes":
+		return "By"
+	case "seconds":
+		return "s"
+	case "ms", "milliseconds":
+		return "ms"
+	case "percent":
+		return "%"
+	}
+	return "{" + unit + "}"
+}
@@ -0,0 +1,273 @@
+package micrometer
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// MeterDef is one Micrometer meter registration found in Java source.
+type MeterDef struct {
+	Name        string
+	Builder     string
+	Description string
+	BaseUnit    string
+	Tags        []string
+}
+
+var (
+	// Match Counter.builder( and friends; the name expression follows
+	builderPattern = regexp.MustCompile(`\b(Counter|Gauge|Timer|DistributionSummary|LongTaskTimer|FunctionCounter|FunctionTimer|TimeGauge|MultiGauge)\s*\.\s*builder\s*\(`)
+	// Match .register(registry) which ends a builder chain
+	registerPattern    = regexp.MustCompile(`\.register\s*\(`)
+	descriptionPattern = regexp.MustCompile(`\.description\s*\(\s*"((?:[^"\\]|\\.)*)"`)
+	baseUnitPattern    = regexp.MustCompile(`\.baseUnit\s*\(\s*([^)]+)\)`)
+	// Match .tags( / .tag( / Tags.of( / Tag.of( calls whose arguments hold tag keys
+	tagsCallPattern = regexp.MustCompile(`(?:\.tags?|\bTags?\s*\.\s*of|\.and)\s*\(`)
+	// Match static final String FOO = "foo"; constant declarations
+	stringConstPattern = regexp.MustCompile(`\bString\s+(\w+)\s*=\s*((?:"(?:[^"\\]|\\.)*"|[\w.]+)(?:\s*\+\s*(?:"(?:[^"\\]|\\.)*"|[\w.]+))*)\s*;`)
+	stringLitPattern   = regexp.MustCompile(`^"((?:[^"\\]|\\.)*)"$`)
+	identPattern       = regexp.MustCompile(`^(?:\w+\.)*(\w+)$`)
+)
+
+// baseUnits mirrors io.micrometer.core.instrument.binder.BaseUnits.
+var baseUnits = map[string]string{
+	"BYTES":        "bytes",
+	"ROWS":         "rows",
+	"TASKS":        "tasks",
+	"THREADS":      "threads",
+	"CLASSES":      "classes",
+	"BUFFERS":      "buffers",
+	"EVENTS":       "events",
+	"FILES":        "files",
+	"SESSIONS":     "sessions",
+	"MILLISECONDS": "ms",
+	"MESSAGES":     "messages",
+	"CONNECTIONS":  "connections",
+	"OPERATIONS":   "operations",
+	"PERCENT":      "percent",
+	"OBJECTS":      "objects",
+}
+
+func ParseFile(path string) ([]*MeterDef, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSource(string(content)), nil
+}
+
+// ParseSource finds builder chains whose meter name resolves to a literal,
+// either directly or through string constants declared in the same file.
+func ParseSource(content string) []*MeterDef {
+	constants := stringConstants(content)
+
+	var defs []*MeterDef
+	for _, match := range builderPattern.FindAllStringSubmatchIndex(content, -1) {
+		open := match[1] - 1
+		end := findMatchingParen(content, open)
+		if end == -1 {
+			continue
+		}
+
+		args := splitArgs(content[open+1 : end-1])
+		if len(args) == 0 {
+			continue
+		}
+		name := resolveString(args[0], constants)
+		if name == "" {
+			continue
+		}
+
+		chainEnd := findChainEnd(content, end)
+		chain := content[end:chainEnd]
+
+		def := &MeterDef{
+			Name:    name,
+			Builder: content[match[2]:match[3]],
+			Tags:    tagKeys(chain, constants),
+		}
+		if m := descriptionPattern.FindStringSubmatch(chain); m != nil {
+			def.Description = strings.TrimSpace(m[1])
+		}
+		if m := baseUnitPattern.FindStringSubmatch(chain); m != nil {
+			def.BaseUnit = resolveBaseUnit(m[1], constants)
+		}
+
+		defs = append(defs, def)
+	}
+
+	return defs
+}
+
+// findChainEnd returns the end of the .register(...) call closing the chain
+// started at pos, or the next statement boundary when there is none.
+func findChainEnd(content string, pos int) int {
+	semicolon := strings.IndexByte(content[pos:], ';')
+	if loc := registerPattern.FindStringIndex(content[pos:]); loc != nil && (semicolon == -1 || loc[0] < semicolon) {
+		if end := findMatchingParen(content, pos+loc[1]-1); end != -1 {
+			return end
+		}
+	}
+	if semicolon == -1 {
+		return len(content)
+	}
+	return pos + semicolon
+}
+
+// tagKeys collects literal keys from .tags("k", v, ...), .tag("k", v) and
+// Tags.of("k", v) calls; key/value varargs hold keys at even positions.
+func tagKeys(chain string, constants map[string]string) []string {
+	var keys []string
+	for _, loc := range tagsCallPattern.FindAllStringIndex(chain, -1) {
+		end := findMatchingParen(chain, loc[1]-1)
+		if end == -1 {
+			continue
+		}
+		args := splitArgs(chain[loc[1] : end-1])
+		if len(args) < 2 {
+			continue
+		}
+		for i := 0; i+1 < len(args); i += 2 {
+			if key := resolveString(args[i], constants); key != "" {
+				keys = appendUnique(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+func resolveBaseUnit(expr string, constants map[string]string) string {
+	expr = strings.TrimSpace(expr)
+	if unit := resolveString(expr, constants); unit != "" {
+		return unit
+	}
+	if m := identPattern.FindStringSubmatch(expr); m != nil {
+		return baseUnits[m[1]]
+	}
+	return ""
+}
+
+// resolveString evaluates a Java string expression made of literals and
+// known constants joined by +. Anything else resolves to "".
+func resolveString(expr string, constants map[string]string) string {
+	var b strings.Builder
+	for _, part := range splitConcat(expr) {
+		part = strings.TrimSpace(part)
+		if m := stringLitPattern.FindStringSubmatch(part); m != nil {
+			b.WriteString(m[1])
+			continue
+		}
+		m := identPattern.FindStringSubmatch(part)
+		if m == nil {
+			return ""
+		}
+		value, ok := constants[m[1]]
+		if !ok {
+			return ""
+		}
+		b.WriteString(value)
+	}
+	return b.String()
+}
+
+func stringConstants(content string) map[string]string {
+	constants := make(map[string]string)
+	// Declarations may refer to earlier ones, so resolve in source order
+	for _, m := range stringConstPattern.FindAllStringSubmatch(content, -1) {
+		if value := resolveString(m[2], constants); value != "" {
+			constants[m[1]] = value
+		}
+	}
+	return constants
+}
+
+// splitConcat splits on + outside string literals.
+func splitConcat(expr string) []string {
+	var parts []string
+	inString := false
+	start := 0
+	for i := 0; i < len(expr); i++ {
+		c := expr[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case c == '+' && !inString:
+			parts = append(parts, expr[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, expr[start:])
+}
+
+func findMatchingParen(content string, start int) int {
+	if start >= len(content) || content[start] != '(' {
+		return -1
+	}
+
+	depth := 1
+	inString := false
+	for i := start + 1; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return -1
+}
+
+func splitArgs(content string) []string {
+	var args []string
+	depth := 0
+	inString := false
+	start := 0
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		switch {
+		case c == '\\' && inString:
+			i++
+		case c == '"':
+			inString = !inString
+		case inString:
+		case c == '(' || c == '{' || c == '[':
+			depth++
+		case c == ')' || c == '}' || c == ']':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(content[start:i]))
+			start = i + 1
+		}
+	}
+
+	if rest := strings.TrimSpace(content[start:]); rest != "" {
+		args = append(args, rest)
+	}
+
+	return args
+}
+
+func appendUnique(existing []string, key string) []string {
+	for _, e := range existing {
+		if e == key {
+			return existing
+		}
+	}
+	return append(existing, key)
+}
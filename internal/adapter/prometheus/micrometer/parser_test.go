@@ -0,0 +1,110 @@
+package micrometer
+
+import (
+	"testing"
+)
+
+const jvmMemorySource = `package io.micrometer.core.instrument.binder.jvm;
+
+public class JvmMemoryMetrics implements MeterBinder {
+
+    private static final String PREFIX = "jvm.memory";
+    private static final String USED = PREFIX + ".used";
+
+    @Override
+    public void bindTo(MeterRegistry registry) {
+        for (MemoryPoolMXBean memoryPoolBean : ManagementFactory.getPlatformMXBeans(MemoryPoolMXBean.class)) {
+            Iterable<Tag> tagsWithId = Tags.concat(tags, "id", memoryPoolBean.getName());
+
+            Gauge.builder(USED, memoryPoolBean, (mem) -> getUsageValue(mem, MemoryUsage::getUsed))
+                .tags(tagsWithId)
+                .tag("area", "heap")
+                .description("The amount of used memory")
+                .baseUnit(BaseUnits.BYTES)
+                .register(registry);
+        }
+
+        Counter.builder("jvm.gc.memory.allocated")
+            .tags(Tags.of("gc", gcName, "cause", "System.gc()"))
+            .baseUnit("bytes")
+            .description("Incremented for an increase in the size of the (young) heap memory pool after one GC to before the next")
+            .register(registry);
+
+        Timer.builder("jvm.gc.pause")
+            .description("Time spent in GC pause")
+            .tags("gc", gcName, "action", action)
+            .register(registry);
+
+        FunctionCounter.builder(name(), obj, fn).register(registry);
+    }
+}
+`
+
+func TestParseSource(t *testing.T) {
+	defs := ParseSource(jvmMemorySource)
+	if len(defs) != 3 {
+		t.Fatalf("expected 3 meters, got %d: %+v", len(defs), defs)
+	}
+
+	used := defs[0]
+	if used.Name != "jvm.memory.used" || used.Builder != "Gauge" || used.BaseUnit != "bytes" {
+		t.Errorf("unexpected gauge: %+v", used)
+	}
+	if used.Description != "The amount of used memory" {
+		t.Errorf("unexpected description %q", used.Description)
+	}
+	if len(used.Tags) != 1 || used.Tags[0] != "area" {
+		t.Errorf("unexpected tags %v", used.Tags)
+	}
+
+	allocated := defs[1]
+	if allocated.Builder != "Counter" || allocated.BaseUnit != "bytes" {
+		t.Errorf("unexpected counter: %+v", allocated)
+	}
+	if len(allocated.Tags) != 2 || allocated.Tags[0] != "gc" || allocated.Tags[1] != "cause" {
+		t.Errorf("unexpected Tags.of keys %v", allocated.Tags)
+	}
+
+	pause := defs[2]
+	if pause.Builder != "Timer" || len(pause.Tags) != 2 || pause.Tags[1] != "action" {
+		t.Errorf("unexpected timer: %+v", pause)
+	}
+}
+
+func TestPrometheusName(t *testing.T) {
+	tests := []struct {
+		def      MeterDef
+		expected string
+	}{
+		{MeterDef{Name: "jvm.memory.used", Builder: "Gauge", BaseUnit: "bytes"}, "jvm_memory_used_bytes"},
+		{MeterDef{Name: "jvm.gc.memory.allocated", Builder: "Counter", BaseUnit: "bytes"}, "jvm_gc_memory_allocated_bytes_total"},
+		{MeterDef{Name: "logback.events", Builder: "Counter"}, "logback_events_total"},
+		{MeterDef{Name: "jvm.gc.pause", Builder: "Timer"}, "jvm_gc_pause_seconds"},
+		{MeterDef{Name: "http.server.requests.active", Builder: "LongTaskTimer"}, "http_server_requests_active_seconds"},
+		{MeterDef{Name: "process.uptime", Builder: "TimeGauge"}, "process_uptime_seconds"},
+		{MeterDef{Name: "tomcat.sessions.active.max", Builder: "Gauge", BaseUnit: "sessions"}, "tomcat_sessions_active_max_sessions"},
+		{MeterDef{Name: "jvm.threads.live", Builder: "Gauge", BaseUnit: "threads"}, "jvm_threads_live_threads"},
+		{MeterDef{Name: "cache.size_bytes", Builder: "Gauge", BaseUnit: "bytes"}, "cache_size_bytes"},
+		{MeterDef{Name: "2xx.responses", Builder: "Counter"}, "m_2xx_responses_total"},
+	}
+
+	for _, tt := range tests {
+		if got := PrometheusName(&tt.def); got != tt.expected {
+			t.Errorf("PrometheusName(%s) = %s, want %s", tt.def.Name, got, tt.expected)
+		}
+	}
+}
+
+func TestResolveString(t *testing.T) {
+	constants := map[string]string{"PREFIX": "jvm.memory"}
+
+	if got := resolveString(`PREFIX + ".max"`, constants); got != "jvm.memory.max" {
+		t.Errorf("unexpected concatenation %q", got)
+	}
+	if got := resolveString(`Names.PREFIX`, constants); got != "jvm.memory" {
+		t.Errorf("unexpected qualified constant %q", got)
+	}
+	if got := resolveString(`prefix + ".max"`, constants); got != "" {
+		t.Errorf("expected unresolved local to give empty, got %q", got)
+	}
+}
@@ -0,0 +1,188 @@
+package nginx
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// source is one of the two ways NGINX metrics reach Prometheus: the
+// standalone exporter scraping stub_status or the Plus API, or the VTS
+// module rendering the format itself.
+type source struct {
+	adapterName string
+	repoURL     string
+	extract     func(repoPath string) ([]MetricDef, error)
+}
+
+var (
+	exporter = source{
+		adapterName: "prometheus-nginx",
+		repoURL:     "https://github.com/nginx/nginx-prometheus-exporter",
+		extract: func(repoPath string) ([]MetricDef, error) {
+			return ParseExporterDir(filepath.Join(repoPath, "collector"))
+		},
+	}
+	vts = source{
+		adapterName: "prometheus-nginx-vts",
+		repoURL:     "https://github.com/vozlt/nginx-module-vts",
+		extract: func(repoPath string) ([]MetricDef, error) {
+			headers, err := filepath.Glob(filepath.Join(repoPath, "src", "*prometheus*.h"))
+			if err != nil {
+				return nil, err
+			}
+			var defs []MetricDef
+			for _, header := range headers {
+				fileDefs, err := ParseVTSFile(header)
+				if err != nil {
+					return nil, err
+				}
+				defs = append(defs, fileDefs...)
+			}
+			return defs, nil
+		},
+	}
+)
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+	source  source
+}
+
+func newAdapter(cacheDir string, s source) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+		source:  s,
+	}
+}
+
+// NewAdapter reads nginx-prometheus-exporter's OSS and Plus collectors.
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-nginx",
+		Category:    domain.SourcePrometheus,
+		Description: "NGINX Prometheus exporter metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "exporter", "proxy"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-nginx-vts",
+		Category:    domain.SourcePrometheus,
+		Description: "nginx-module-vts Prometheus metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "proxy"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewVTSAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, exporter)
+}
+
+// NewVTSAdapter reads nginx-module-vts's built-in Prometheus output.
+func NewVTSAdapter(cacheDir string) *Adapter {
+	return newAdapter(cacheDir, vts)
+}
+
+func (a *Adapter) Name() string {
+	return a.source.adapterName
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return a.source.repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: a.source.repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(_ context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	defs, err := a.source.extract(result.RepoPath)
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make([]*adapter.RawMetric, 0, len(defs))
+	for _, def := range defs {
+		attrs := make([]domain.Attribute, 0, len(def.Labels))
+		for _, label := range def.Labels {
+			attrs = append(attrs, domain.Attribute{
+				Name: label,
+				Type: "string",
+				Enum: def.Enums[label],
+			})
+		}
+
+		relPath, _ := filepath.Rel(result.RepoPath, def.File)
+		metrics = append(metrics, &adapter.RawMetric{
+			Name:             def.Name,
+			Description:      def.Help,
+			InstrumentType:   def.Type,
+			Unit:             unit(def.Name),
+			Attributes:       attrs,
+			EnabledByDefault: true,
+			ComponentType:    string(domain.ComponentPlatform),
+			ComponentName:    componentName(def.Name),
+			SourceLocation:   relPath,
+			Path:             relPath,
+		})
+	}
+
+	return metrics, nil
+}
+
+func componentName(name string) string {
+	if strings.HasPrefix(name, "nginxplus_") {
+		return "nginx-plus"
+	}
+	return "nginx"
+}
+
+func unit(name string) string {
+	name = strings.TrimSuffix(name, "_total")
+	switch {
+	case strings.HasSuffix(name, "_bytes"):
+		return "By"
+	case strings.HasSuffix(name, "_seconds"):
+		return "s"
+	}
+	return ""
+}
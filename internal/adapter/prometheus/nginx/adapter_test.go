@@ -0,0 +1,123 @@
+package nginx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func extract(t *testing.T, a *Adapter, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := a.Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func writeVTSHeader(t *testing.T, repo string) {
+	t.Helper()
+
+	src := filepath.Join(repo, "src")
+	if err := os.MkdirAll(src, 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "ngx_http_vhost_traffic_status_display_prometheus.h"), []byte(vtsHeader), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func TestNginxAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "prometheus-nginx" {
+		t.Errorf("expected name 'prometheus-nginx', got %q", a.Name())
+	}
+}
+
+func TestNginxAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestVTSAdapter_Name(t *testing.T) {
+	a := NewVTSAdapter("/tmp/cache")
+	if a.Name() != "prometheus-nginx-vts" {
+		t.Errorf("expected name 'prometheus-nginx-vts', got %q", a.Name())
+	}
+}
+
+func TestVTSAdapter_RepoURL(t *testing.T) {
+	a := NewVTSAdapter("/tmp/cache")
+	if a.RepoURL() != "https://github.com/vozlt/nginx-module-vts" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestNginxAdapter_Extract_OSSCollector(t *testing.T) {
+	repo := t.TempDir()
+	writeExporterFixture(t, filepath.Join(repo, "collector"))
+
+	active := extract(t, NewAdapter("/tmp/cache"), repo)["nginx_connections_active"]
+	if active == nil {
+		t.Fatal("expected nginx_connections_active")
+	}
+	if active.ComponentName != "nginx" || active.ComponentType != string(domain.ComponentPlatform) {
+		t.Errorf("unexpected component: %s/%s", active.ComponentType, active.ComponentName)
+	}
+	if active.Path != filepath.Join("collector", "nginx.go") {
+		t.Errorf("expected a repo-relative path, got %q", active.Path)
+	}
+}
+
+func TestNginxAdapter_Extract_PlusCollector(t *testing.T) {
+	repo := t.TempDir()
+	writeExporterFixture(t, filepath.Join(repo, "collector"))
+
+	zone := extract(t, NewAdapter("/tmp/cache"), repo)["nginxplus_server_zone_requests"]
+	if zone == nil {
+		t.Fatal("expected nginxplus_server_zone_requests")
+	}
+	if zone.ComponentName != "nginx-plus" || len(zone.Attributes) != 1 {
+		t.Errorf("unexpected nginxplus_server_zone_requests: %+v", zone)
+	}
+}
+
+func TestVTSAdapter_Extract_HistogramSeriesFolded(t *testing.T) {
+	repo := t.TempDir()
+	writeVTSHeader(t, repo)
+
+	if metrics := extract(t, NewVTSAdapter("/tmp/cache"), repo); len(metrics) != 4 {
+		t.Errorf("expected 4 metrics, got %d", len(metrics))
+	}
+}
+
+func TestVTSAdapter_Extract_DirectionEnum(t *testing.T) {
+	repo := t.TempDir()
+	writeVTSHeader(t, repo)
+
+	bytes := extract(t, NewVTSAdapter("/tmp/cache"), repo)["nginx_vts_server_bytes_total"]
+	if bytes == nil {
+		t.Fatal("expected nginx_vts_server_bytes_total")
+	}
+	if bytes.Unit != "By" || len(bytes.Attributes) != 2 || len(bytes.Attributes[1].Enum) != 2 {
+		t.Errorf("unexpected nginx_vts_server_bytes_total: %+v", bytes)
+	}
+}
+
+func TestComponentName(t *testing.T) {
+	if got := componentName("nginxplus_http_requests_total"); got != "nginx-plus" {
+		t.Errorf("expected 'nginx-plus', got %q", got)
+	}
+	if got := componentName("nginx_vts_server_bytes_total"); got != "nginx" {
+		t.Errorf("expected 'nginx', got %q", got)
+	}
+}
@@ -0,0 +1,391 @@
+package nginx
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+)
+
+// MetricDef is a metric found in either nginx source.
+type MetricDef struct {
+	Name   string
+	Help   string
+	Type   string
+	Labels []string
+	// Enums holds the fixed values a label takes, where the source has them
+	Enums map[string][]string
+	File  string
+}
+
+// Collector constructors receive the namespace from main; these are the
+// values the exporter passes for NGINX OSS and NGINX Plus.
+var collectorNamespaces = map[string]string{
+	"NewNginxCollector":     "nginx",
+	"NewNginxPlusCollector": "nginxplus",
+}
+
+var valueTypes = map[string]string{
+	"CounterValue": "counter",
+	"GaugeValue":   "gauge",
+	"UntypedValue": "gauge",
+}
+
+// helper is a function returning a descriptor or metric built from its
+// parameters, such as newServerZoneMetric(namespace, name, doc, labels).
+type helper struct {
+	params []string
+	call   *ast.CallExpr
+}
+
+// descriptor is a helper call site and the key it is stored under, e.g.
+// "serverZoneMetrics.requests" for a map entry or "upMetric" for a field.
+type descriptor struct {
+	key  string
+	call *ast.CallExpr
+	ns   string
+	file string
+}
+
+// ParseExporterDir reads the nginx-prometheus-exporter collector package.
+// Descriptors are created through helpers in one file and emitted with their
+// value type in another, so the whole package is resolved at once.
+func ParseExporterDir(dir string) ([]MetricDef, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	var paths []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		path := filepath.Join(dir, name)
+		src, err := os.ReadFile(path) //nolint:gosec // Reading Go source files from cloned repos is intentional
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), name, src, 0)
+		if err != nil {
+			continue
+		}
+		files = append(files, f)
+		paths = append(paths, path)
+	}
+
+	constants := make(map[string]string)
+	helpers := make(map[string]helper)
+	for _, f := range files {
+		for k, v := range astparser.Constants(f) {
+			constants[k] = v
+		}
+		collectHelpers(f, helpers)
+	}
+
+	var descs []descriptor
+	types := make(map[string]string)
+	for i, f := range files {
+		descs = append(descs, collectDescriptors(f, helpers, paths[i])...)
+		collectValueTypes(f, types)
+	}
+
+	seen := make(map[string]bool)
+	var metrics []MetricDef
+	for _, d := range descs {
+		h := helpers[selectorName(d.call.Fun)]
+		args := make(map[string]string)
+		argExprs := make(map[string]ast.Expr)
+		for i, param := range h.params {
+			if i >= len(d.call.Args) {
+				break
+			}
+			argExprs[param] = d.call.Args[i]
+			if param == "namespace" {
+				args[param] = d.ns
+				continue
+			}
+			args[param] = astparser.ResolveString(d.call.Args[i], constants)
+		}
+
+		m, ok := evalHelper(h.call, args, argExprs, constants)
+		if !ok || seen[m.Name] {
+			continue
+		}
+		seen[m.Name] = true
+
+		if t, ok := types[d.key]; ok {
+			m.Type = t
+		}
+		if m.Type == "" {
+			m.Type = inferType(m.Name)
+		}
+		m.File = d.file
+		metrics = append(metrics, m)
+	}
+
+	sort.Slice(metrics, func(i, j int) bool { return metrics[i].Name < metrics[j].Name })
+	return metrics, nil
+}
+
+func collectHelpers(f *ast.File, helpers map[string]helper) {
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Body == nil || len(fn.Body.List) != 1 {
+			continue
+		}
+		ret, ok := fn.Body.List[0].(*ast.ReturnStmt)
+		if !ok || len(ret.Results) != 1 {
+			continue
+		}
+		call, ok := ret.Results[0].(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		switch selectorName(call.Fun) {
+		case "NewDesc", "NewGauge", "NewCounter":
+		default:
+			continue
+		}
+
+		var params []string
+		for _, field := range fn.Type.Params.List {
+			for _, name := range field.Names {
+				params = append(params, name.Name)
+			}
+		}
+		helpers[fn.Name.Name] = helper{params: params, call: call}
+	}
+}
+
+func collectDescriptors(f *ast.File, helpers map[string]helper, path string) []descriptor {
+	var descs []descriptor
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		ns, ok := collectorNamespaces[fn.Name.Name]
+		if !ok {
+			continue
+		}
+
+		isHelperCall := func(expr ast.Expr) (*ast.CallExpr, bool) {
+			call, ok := expr.(*ast.CallExpr)
+			if !ok {
+				return nil, false
+			}
+			_, ok = helpers[selectorName(call.Fun)]
+			return call, ok
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+			field := selectorName(kv.Key)
+			if call, ok := isHelperCall(kv.Value); ok {
+				descs = append(descs, descriptor{key: field, call: call, ns: ns, file: path})
+				return true
+			}
+			lit, ok := kv.Value.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+			for _, elt := range lit.Elts {
+				entry, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				if call, ok := isHelperCall(entry.Value); ok {
+					descs = append(descs, descriptor{key: field + "." + stringLit(entry.Key), call: call, ns: ns, file: path})
+				}
+			}
+			return false
+		})
+	}
+	return descs
+}
+
+// collectValueTypes records the ValueType each descriptor is emitted with,
+// from calls like MustNewConstMetric(c.serverZoneMetrics["requests"], prometheus.CounterValue, ...).
+func collectValueTypes(f *ast.File, types map[string]string) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+		if fn := selectorName(call.Fun); fn != "MustNewConstMetric" && fn != "NewConstMetric" {
+			return true
+		}
+		t, ok := valueTypes[selectorName(call.Args[1])]
+		if !ok {
+			return true
+		}
+		switch arg := call.Args[0].(type) {
+		case *ast.IndexExpr:
+			types[selectorName(arg.X)+"."+stringLit(arg.Index)] = t
+		case *ast.SelectorExpr:
+			types[arg.Sel.Name] = t
+		}
+		return true
+	})
+}
+
+func evalHelper(call *ast.CallExpr, args map[string]string, argExprs map[string]ast.Expr, constants map[string]string) (MetricDef, bool) {
+	var m MetricDef
+	switch selectorName(call.Fun) {
+	case "NewDesc":
+		if len(call.Args) < 2 {
+			return m, false
+		}
+		m.Name = evalString(call.Args[0], args, constants)
+		m.Help = evalString(call.Args[1], args, constants)
+		if len(call.Args) > 2 {
+			m.Labels = labelNames(call.Args[2], args, argExprs, constants)
+		}
+	case "NewGauge", "NewCounter":
+		if len(call.Args) != 1 {
+			return m, false
+		}
+		opts, ok := call.Args[0].(*ast.CompositeLit)
+		if !ok {
+			return m, false
+		}
+		var parts [3]string
+		for _, elt := range opts.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			value := evalString(kv.Value, args, constants)
+			switch selectorName(kv.Key) {
+			case "Namespace":
+				parts[0] = value
+			case "Subsystem":
+				parts[1] = value
+			case "Name":
+				parts[2] = value
+			case "Help":
+				m.Help = value
+			}
+		}
+		m.Name = joinNonEmpty(parts[:])
+		m.Type = "gauge"
+		if selectorName(call.Fun) == "NewCounter" {
+			m.Type = "counter"
+		}
+	}
+
+	if m.Name == "" || strings.HasPrefix(m.Name, "_") || strings.HasSuffix(m.Name, "_") {
+		return m, false
+	}
+	return m, true
+}
+
+func evalString(expr ast.Expr, args map[string]string, constants map[string]string) string {
+	switch x := expr.(type) {
+	case *ast.Ident:
+		if v, ok := args[x.Name]; ok {
+			return v
+		}
+		return astparser.ResolveString(x, constants)
+	case *ast.BasicLit, *ast.SelectorExpr:
+		return astparser.ResolveString(x, constants)
+	case *ast.ParenExpr:
+		return evalString(x.X, args, constants)
+	case *ast.BinaryExpr:
+		if x.Op == token.ADD {
+			return evalString(x.X, args, constants) + evalString(x.Y, args, constants)
+		}
+	case *ast.CallExpr:
+		if selectorName(x.Fun) == "BuildFQName" {
+			parts := make([]string, 0, len(x.Args))
+			for _, arg := range x.Args {
+				parts = append(parts, evalString(arg, args, constants))
+			}
+			return joinNonEmpty(parts)
+		}
+	}
+	return ""
+}
+
+// labelNames reads []string{...} literals, append(lit, extra...) and
+// parameters the helper was called with a literal for.
+func labelNames(expr ast.Expr, args map[string]string, argExprs map[string]ast.Expr, constants map[string]string) []string {
+	switch x := expr.(type) {
+	case *ast.CompositeLit:
+		var labels []string
+		for _, elt := range x.Elts {
+			if v := evalString(elt, args, constants); v != "" {
+				labels = append(labels, v)
+			}
+		}
+		return labels
+	case *ast.CallExpr:
+		if selectorName(x.Fun) == "append" && len(x.Args) > 0 {
+			labels := labelNames(x.Args[0], args, argExprs, constants)
+			if !x.Ellipsis.IsValid() {
+				for _, arg := range x.Args[1:] {
+					if v := evalString(arg, args, constants); v != "" {
+						labels = append(labels, v)
+					}
+				}
+			}
+			return labels
+		}
+	case *ast.Ident:
+		if arg, ok := argExprs[x.Name]; ok {
+			return labelNames(arg, nil, nil, constants)
+		}
+	}
+	return nil
+}
+
+func joinNonEmpty(parts []string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return strings.Join(nonEmpty, "_")
+}
+
+func stringLit(expr ast.Expr) string {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return ""
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+func selectorName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+	return ""
+}
+
+func inferType(name string) string {
+	if strings.HasSuffix(name, "_total") {
+		return "counter"
+	}
+	return "gauge"
+}
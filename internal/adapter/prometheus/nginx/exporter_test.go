@@ -0,0 +1,137 @@
+package nginx
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const helperSource = `package collector
+
+import "github.com/prometheus/client_golang/prometheus"
+
+func newGlobalMetric(namespace string, metricName string, docString string, constLabels map[string]string) *prometheus.Desc {
+	return prometheus.NewDesc(namespace+"_"+metricName, docString, nil, constLabels)
+}
+
+func newUpMetric(namespace string, constLabels map[string]string) prometheus.Gauge {
+	return prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Name:        "up",
+		Help:        "Status of the last metric scrape",
+		ConstLabels: constLabels,
+	})
+}
+`
+
+const ossSource = `package collector
+
+func NewNginxCollector(nginxClient *client.NginxClient, namespace string, constLabels map[string]string, logger *slog.Logger) *NginxCollector {
+	return &NginxCollector{
+		nginxClient: nginxClient,
+		metrics: map[string]*prometheus.Desc{
+			"connections_active":   newGlobalMetric(namespace, "connections_active", "Active client connections", constLabels),
+			"connections_accepted": newGlobalMetric(namespace, "connections_accepted", "Accepted client connections", constLabels),
+			"http_requests_total":  newGlobalMetric(namespace, "http_requests_total", "Total http requests", constLabels),
+		},
+		upMetric: newUpMetric(namespace, constLabels),
+	}
+}
+
+func (c *NginxCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(c.metrics["connections_active"], prometheus.GaugeValue, float64(stats.Connections.Active))
+	ch <- prometheus.MustNewConstMetric(c.metrics["connections_accepted"], prometheus.CounterValue, float64(stats.Connections.Accepted))
+}
+`
+
+const plusSource = `package collector
+
+func newServerZoneMetric(namespace string, metricName string, docString string, constLabels prometheus.Labels) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(namespace, "server_zone", metricName), docString, []string{"server_zone"}, constLabels)
+}
+
+func newUpstreamServerMetric(namespace string, metricName string, docString string, variableLabelNames []string, constLabels prometheus.Labels) *prometheus.Desc {
+	return prometheus.NewDesc(prometheus.BuildFQName(namespace, "upstream_server", metricName), docString, append([]string{"upstream", "server"}, variableLabelNames...), constLabels)
+}
+
+func NewNginxPlusCollector(nginxClient *plusclient.NginxClient, namespace string, variableLabelNames VariableLabelNames, constLabels map[string]string, logger *slog.Logger) *NginxPlusCollector {
+	return &NginxPlusCollector{
+		serverZoneMetrics: map[string]*prometheus.Desc{
+			"processing": newServerZoneMetric(namespace, "processing", "Client requests that are currently being processed", constLabels),
+			"requests":   newServerZoneMetric(namespace, "requests", "Total client requests", constLabels),
+		},
+		upstreamServerMetrics: map[string]*prometheus.Desc{
+			"requests": newUpstreamServerMetric(namespace, "requests", "Total client requests", variableLabelNames.UpstreamServerVariableLabelNames, constLabels),
+		},
+	}
+}
+
+func (c *NginxPlusCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(c.serverZoneMetrics["requests"], prometheus.CounterValue, float64(zone.Requests), name)
+	ch <- prometheus.MustNewConstMetric(c.upstreamServerMetrics["requests"], prometheus.CounterValue, float64(peer.Requests), labelValues...)
+}
+`
+
+func writeExporterFixture(t *testing.T, dir string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"helper.go":      helperSource,
+		"nginx.go":       ossSource,
+		"nginx_plus.go":  plusSource,
+		"helper_test.go": `package collector`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestParseExporterDir(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "collector")
+	writeExporterFixture(t, dir)
+
+	defs, err := ParseExporterDir(dir)
+	if err != nil {
+		t.Fatalf("ParseExporterDir failed: %v", err)
+	}
+
+	byName := make(map[string]MetricDef)
+	for _, d := range defs {
+		byName[d.Name] = d
+	}
+	if len(defs) != 7 {
+		t.Errorf("expected 7 metrics, got %d: %v", len(defs), defs)
+	}
+
+	if d := byName["nginx_connections_active"]; d.Type != "gauge" || d.Help != "Active client connections" {
+		t.Errorf("unexpected nginx_connections_active: %+v", d)
+	}
+	if d := byName["nginx_connections_accepted"]; d.Type != "counter" {
+		t.Errorf("expected counter from CounterValue, got %+v", d)
+	}
+	if d := byName["nginx_http_requests_total"]; d.Type != "counter" {
+		t.Errorf("expected counter from _total suffix, got %+v", d)
+	}
+	if d := byName["nginx_up"]; d.Type != "gauge" || d.Help != "Status of the last metric scrape" {
+		t.Errorf("unexpected nginx_up: %+v", d)
+	}
+	if _, ok := byName["nginxplus_up"]; ok {
+		t.Error("nginxplus_up is not built by the plus constructor in this fixture")
+	}
+
+	zone := byName["nginxplus_server_zone_requests"]
+	if zone.Type != "counter" || len(zone.Labels) != 1 || zone.Labels[0] != "server_zone" {
+		t.Errorf("unexpected nginxplus_server_zone_requests: %+v", zone)
+	}
+	if d := byName["nginxplus_server_zone_processing"]; d.Type != "gauge" {
+		t.Errorf("unexpected nginxplus_server_zone_processing: %+v", d)
+	}
+
+	upstream := byName["nginxplus_upstream_server_requests"]
+	if upstream.Type != "counter" || len(upstream.Labels) != 2 || upstream.Labels[1] != "server" {
+		t.Errorf("unexpected nginxplus_upstream_server_requests: %+v", upstream)
+	}
+}
@@ -0,0 +1,136 @@
+package nginx
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// A C string literal, allowing escaped quotes
+	cStringPattern = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+	helpPattern    = regexp.MustCompile(`^# HELP (\w+) (.*)$`)
+	typePattern    = regexp.MustCompile(`^# TYPE (\w+) (\w+)$`)
+	samplePattern  = regexp.MustCompile(`^(\w+)\{([^}]*)\}`)
+	labelPattern   = regexp.MustCompile(`(\w+)="([^"]*)"`)
+)
+
+// histogramSeries are the suffixes a histogram's samples carry.
+var histogramSeries = []string{"_bucket", "_sum", "_count"}
+
+// ParseVTSFile reads the Prometheus output formats nginx-module-vts builds
+// from C string macros: "# HELP"/"# TYPE" lines name each metric and the
+// sample lines that follow give its labels.
+func ParseVTSFile(path string) ([]MetricDef, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	defs := ParseVTSSource(string(content))
+	for i := range defs {
+		defs[i].File = path
+	}
+	return defs, nil
+}
+
+func ParseVTSSource(content string) []MetricDef {
+	var text strings.Builder
+	for _, m := range cStringPattern.FindAllStringSubmatch(content, -1) {
+		text.WriteString(unescapeC(m[1]))
+	}
+
+	byName := make(map[string]*MetricDef)
+	var order []string
+	get := func(name string) *MetricDef {
+		if m, ok := byName[name]; ok {
+			return m
+		}
+		m := &MetricDef{Name: name}
+		byName[name] = m
+		order = append(order, name)
+		return m
+	}
+
+	var samples [][2]string
+	for _, line := range strings.Split(text.String(), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case helpPattern.MatchString(line):
+			m := helpPattern.FindStringSubmatch(line)
+			get(m[1]).Help = m[2]
+		case typePattern.MatchString(line):
+			m := typePattern.FindStringSubmatch(line)
+			get(m[1]).Type = m[2]
+		case samplePattern.MatchString(line):
+			m := samplePattern.FindStringSubmatch(line)
+			samples = append(samples, [2]string{m[1], m[2]})
+		}
+	}
+
+	// Samples are matched once every HELP line is known, since the module
+	// keeps headers and sample formats in separate macros
+	for _, s := range samples {
+		m, ok := byName[s[0]]
+		if !ok {
+			m, ok = byName[histogramBase(s[0])]
+		}
+		if !ok {
+			continue
+		}
+		for _, label := range labelPattern.FindAllStringSubmatch(s[1], -1) {
+			key, value := label[1], label[2]
+			if key == "le" {
+				continue
+			}
+			if !contains(m.Labels, key) {
+				m.Labels = append(m.Labels, key)
+			}
+			if !strings.Contains(value, "%") && value != "" {
+				if m.Enums == nil {
+					m.Enums = make(map[string][]string)
+				}
+				if !contains(m.Enums[key], value) {
+					m.Enums[key] = append(m.Enums[key], value)
+				}
+			}
+		}
+	}
+
+	defs := make([]MetricDef, 0, len(order))
+	for _, name := range order {
+		m := byName[name]
+		if m.Type == "" {
+			m.Type = inferType(name)
+		}
+		for _, values := range m.Enums {
+			sort.Strings(values)
+		}
+		defs = append(defs, *m)
+	}
+	return defs
+}
+
+func histogramBase(name string) string {
+	for _, suffix := range histogramSeries {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+	return name
+}
+
+func unescapeC(s string) string {
+	return strings.NewReplacer(`\n`, "\n", `\"`, `"`, `\\`, `\`, `\t`, "\t").Replace(s)
+}
+
+func contains(values []string, v string) bool {
+	for _, existing := range values {
+		if existing == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package nginx
+
+import (
+	"testing"
+)
+
+const vtsHeader = `#ifndef _NGX_HTTP_VTS_DISPLAY_PROMETHEUS_H_INCLUDED_
+#define _NGX_HTTP_VTS_DISPLAY_PROMETHEUS_H_INCLUDED_
+
+#define NGX_HTTP_VHOST_TRAFFIC_STATUS_PROMETHEUS_FMT_MAIN                      \
+    "# HELP nginx_vts_info Nginx info\n"                                       \
+    "# TYPE nginx_vts_info gauge\n"                                            \
+    "nginx_vts_info{hostname=\"%V\",module_version=\"%s\",version=\"%s\"} 1\n" \
+    "# HELP nginx_vts_start_time_seconds Nginx start time\n"                   \
+    "# TYPE nginx_vts_start_time_seconds gauge\n"                              \
+    "nginx_vts_start_time_seconds %.3f\n"
+
+#define NGX_HTTP_VHOST_TRAFFIC_STATUS_PROMETHEUS_FMT_SERVER_S                  \
+    "# HELP nginx_vts_server_bytes_total The request/response bytes\n"         \
+    "# TYPE nginx_vts_server_bytes_total counter\n"                            \
+    "# HELP nginx_vts_server_request_seconds The histogram of request processing time\n" \
+    "# TYPE nginx_vts_server_request_seconds histogram\n"
+
+#define NGX_HTTP_VHOST_TRAFFIC_STATUS_PROMETHEUS_FMT_SERVER                    \
+    "nginx_vts_server_bytes_total{host=\"%V\",direction=\"in\"} %uA\n"         \
+    "nginx_vts_server_bytes_total{host=\"%V\",direction=\"out\"} %uA\n"
+
+#define NGX_HTTP_VHOST_TRAFFIC_STATUS_PROMETHEUS_FMT_SERVER_HISTOGRAM_BUCKET   \
+    "nginx_vts_server_request_seconds_bucket{host=\"%V\",le=\"%V\"} %uA\n"
+
+#endif
+`
+
+func TestParseVTSSource(t *testing.T) {
+	defs := ParseVTSSource(vtsHeader)
+	if len(defs) != 4 {
+		t.Fatalf("expected 4 metrics, got %d: %+v", len(defs), defs)
+	}
+
+	byName := make(map[string]MetricDef)
+	for _, d := range defs {
+		byName[d.Name] = d
+	}
+
+	info := byName["nginx_vts_info"]
+	if info.Help != "Nginx info" || len(info.Labels) != 3 || info.Labels[0] != "hostname" {
+		t.Errorf("unexpected nginx_vts_info: %+v", info)
+	}
+
+	bytes := byName["nginx_vts_server_bytes_total"]
+	if bytes.Type != "counter" || len(bytes.Labels) != 2 {
+		t.Errorf("unexpected nginx_vts_server_bytes_total: %+v", bytes)
+	}
+	if enum := bytes.Enums["direction"]; len(enum) != 2 || enum[0] != "in" || enum[1] != "out" {
+		t.Errorf("expected direction enum [in out], got %v", enum)
+	}
+	if len(bytes.Enums["host"]) != 0 {
+		t.Errorf("expected no enum for formatted host, got %v", bytes.Enums["host"])
+	}
+
+	hist := byName["nginx_vts_server_request_seconds"]
+	if hist.Type != "histogram" || len(hist.Labels) != 1 || hist.Labels[0] != "host" {
+		t.Errorf("expected histogram labels without le, got %+v", hist)
+	}
+}
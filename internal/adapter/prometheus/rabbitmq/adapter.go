@@ -0,0 +1,150 @@
+package rabbitmq
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/rabbitmq/rabbitmq-server"
+
+// The built-in plugin's collectors, plus the global counters the global
+// metrics collector exposes under its own prefix
+var (
+	collectorsDir  = filepath.Join("deps", "rabbitmq_prometheus", "src", "collectors")
+	globalCounters = filepath.Join("deps", "rabbit", "src", "rabbit_global_counters.erl")
+)
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "prometheus-rabbitmq",
+		Category:    domain.SourcePrometheus,
+		Description: "RabbitMQ built-in Prometheus plugin metrics",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"prometheus", "messaging"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "prometheus-rabbitmq"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionAST
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(_ context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	entries, err := os.ReadDir(filepath.Join(result.RepoPath, collectorsDir))
+	if err != nil {
+		return nil, err
+	}
+
+	type source struct {
+		path   string
+		prefix string
+	}
+	var sources []source
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".erl") {
+			continue
+		}
+		sources = append(sources, source{filepath.Join(collectorsDir, entry.Name()), "rabbitmq_"})
+	}
+	if _, err := os.Stat(filepath.Join(result.RepoPath, globalCounters)); err == nil {
+		sources = append(sources, source{globalCounters, "rabbitmq_global_"})
+	}
+
+	seen := make(map[string]bool)
+	var metrics []*adapter.RawMetric
+
+	for _, src := range sources {
+		defs, err := ParseFile(filepath.Join(result.RepoPath, src.path), src.prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, def := range defs {
+			if seen[def.Name] {
+				continue
+			}
+			seen[def.Name] = true
+
+			metrics = append(metrics, &adapter.RawMetric{
+				Name:             def.Name,
+				Description:      def.Help,
+				InstrumentType:   def.Type,
+				Unit:             unit(def.Name),
+				EnabledByDefault: true,
+				ComponentType:    string(domain.ComponentPlatform),
+				ComponentName:    "rabbitmq",
+				SourceLocation:   filepath.ToSlash(src.path),
+				Path:             filepath.ToSlash(src.path),
+			})
+		}
+	}
+
+	return metrics, nil
+}
+
+// unit reads the base unit Prometheus naming puts before any _total.
+func unit(name string) string {
+	name = strings.TrimSuffix(name, "_total")
+	switch {
+	case strings.HasSuffix(name, "_bytes"):
+		return "By"
+	case strings.HasSuffix(name, "_seconds"):
+		return "s"
+	}
+	return ""
+}
@@ -0,0 +1,118 @@
+package rabbitmq
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const protocolCounters = `-define(PROTOCOL_COUNTERS, [{messages_confirmed_total, ?MESSAGES_CONFIRMED, counter, "Total number of messages confirmed to publishers"}]).`
+
+func writeRepoFile(t *testing.T, repo, path, content string) {
+	t.Helper()
+
+	fullPath := filepath.Join(repo, path)
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func extract(t *testing.T, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestRabbitMQAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "prometheus-rabbitmq" {
+		t.Errorf("expected name 'prometheus-rabbitmq', got %q", a.Name())
+	}
+}
+
+func TestRabbitMQAdapter_RepoURL(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.RepoURL() != "https://github.com/rabbitmq/rabbitmq-server" {
+		t.Errorf("unexpected repo URL: %q", a.RepoURL())
+	}
+}
+
+func TestRabbitMQAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestRabbitMQAdapter_Extract_CoreCollector(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join(collectorsDir, "prometheus_rabbitmq_core_metrics_collector.erl"), coreCollector)
+
+	metrics := extract(t, repo)
+	if len(metrics) != 5 {
+		t.Fatalf("expected 5 metrics, got %d", len(metrics))
+	}
+
+	for name, m := range metrics {
+		if m.ComponentType != string(domain.ComponentPlatform) || m.ComponentName != "rabbitmq" {
+			t.Errorf("unexpected component for %s: %s/%s", name, m.ComponentType, m.ComponentName)
+		}
+	}
+
+	if io := metrics["rabbitmq_io_read_time_seconds_total"]; io == nil || io.Unit != "s" {
+		t.Errorf("expected a seconds unit before _total, got %+v", io)
+	}
+}
+
+func TestRabbitMQAdapter_Extract_GlobalCounters(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join(collectorsDir, "prometheus_rabbitmq_core_metrics_collector.erl"), coreCollector)
+	writeRepoFile(t, repo, globalCounters, protocolCounters)
+
+	confirmed := extract(t, repo)["rabbitmq_global_messages_confirmed_total"]
+	if confirmed == nil {
+		t.Fatal("expected rabbitmq_global_messages_confirmed_total")
+	}
+	if confirmed.Path != "deps/rabbit/src/rabbit_global_counters.erl" {
+		t.Errorf("expected the global counters path, got %q", confirmed.Path)
+	}
+}
+
+func TestRabbitMQAdapter_Extract_SkipsNonErlang(t *testing.T) {
+	repo := t.TempDir()
+	writeRepoFile(t, repo, filepath.Join(collectorsDir, "README.md"), coreCollector)
+
+	if metrics := extract(t, repo); len(metrics) != 0 {
+		t.Errorf("expected non-Erlang files to be skipped, got %d metrics", len(metrics))
+	}
+}
+
+func TestUnit(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"rabbitmq_io_read_time_seconds_total", "s"},
+		{"rabbitmq_process_resident_memory_bytes", "By"},
+		{"rabbitmq_channel_consumers", ""},
+	}
+
+	for _, tt := range tests {
+		if got := unit(tt.name); got != tt.expected {
+			t.Errorf("unit(%q) = %q, expected %q", tt.name, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package rabbitmq
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// MetricDef is one metric tuple from the rabbitmq_prometheus collectors.
+type MetricDef struct {
+	Name string
+	Type string
+	Help string
+}
+
+var (
+	// {2, undefined, channel_consumers, gauge, "Consumers on a channel", consumer_count}
+	// {messages_received_total, ?MESSAGES_RECEIVED, counter, "Total number ..."}
+	metricTuplePattern = regexp.MustCompile(`\{\s*(?:\d+\s*,\s*(?:\?\w+|\w+)\s*,\s*)?([a-z][a-z0-9_]*)\s*,\s*(?:\?[A-Z][A-Z0-9_]*\s*,\s*)?(counter|gauge|histogram|untyped|boolean)\s*,\s*"((?:[^"\\]|\\.)*)"`)
+	// -define(METRICS_RAW, [ ... opens the table a tuple belongs to
+	definePattern = regexp.MustCompile(`-define\(\s*(\w+)\s*,`)
+	// -define(METRIC_NAME_PREFIX, <<"rabbitmq_">>).
+	prefixPattern = regexp.MustCompile(`-define\(\s*(\w*PREFIX)\s*,\s*(?:<<)?"([^"]+)"`)
+)
+
+func ParseFile(path, defaultPrefix string) ([]MetricDef, error) {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSource(string(content), defaultPrefix), nil
+}
+
+// ParseSource returns the metric tuples of an Erlang module with the name
+// prefix the collector renders them with. Tables defined under a CLUSTER
+// macro take the cluster prefix when the module declares one.
+func ParseSource(content, defaultPrefix string) []MetricDef {
+	prefixes := make(map[string]string)
+	for _, m := range prefixPattern.FindAllStringSubmatch(content, -1) {
+		prefixes[m[1]] = m[2]
+	}
+
+	prefix := defaultPrefix
+	if p, ok := prefixes["METRIC_NAME_PREFIX"]; ok {
+		prefix = p
+	}
+
+	defines := definePattern.FindAllStringSubmatchIndex(content, -1)
+
+	var defs []MetricDef
+	for _, m := range metricTuplePattern.FindAllStringSubmatchIndex(content, -1) {
+		tablePrefix := prefix
+		if table := enclosingDefine(content, defines, m[0]); strings.Contains(table, "CLUSTER") {
+			if p, ok := prefixes["CLUSTER_METRIC_NAME_PREFIX"]; ok {
+				tablePrefix = p
+			}
+		}
+
+		defs = append(defs, MetricDef{
+			Name: tablePrefix + content[m[2]:m[3]],
+			Type: metricType(content[m[4]:m[5]]),
+			Help: strings.ReplaceAll(content[m[6]:m[7]], `\"`, `"`),
+		})
+	}
+
+	return defs
+}
+
+func enclosingDefine(content string, defines [][]int, pos int) string {
+	name := ""
+	for _, d := range defines {
+		if d[0] > pos {
+			break
+		}
+		name = content[d[2]:d[3]]
+	}
+	return name
+}
+
+// metricType maps prometheus.erl types; booleans are exposed as 0/1 gauges.
+func metricType(t string) string {
+	switch t {
+	case "counter", "histogram":
+		return t
+	}
+	return "gauge"
+}
@@ -0,0 +1,70 @@
+package rabbitmq
+
+import (
+	"testing"
+)
+
+const coreCollector = `-module(prometheus_rabbitmq_core_metrics_collector).
+
+-define(METRIC_NAME_PREFIX, <<"rabbitmq_">>).
+-define(CLUSTER_METRIC_NAME_PREFIX, <<"rabbitmq_cluster_">>).
+
+-define(METRICS_RAW, [
+    {channel_metrics, [
+        {2, undefined, channel_consumers, gauge, "Consumers on a channel", consumer_count},
+        {2, undefined, channel_messages_unacked, gauge, "Delivered but not yet acknowledged messages", messages_unacknowledged}
+    ]},
+    {node_persister_metrics, [
+        {2, ?MICROSECOND, io_read_time_seconds_total, counter, "Total I/O read time", io_read_time}
+    ]},
+    {node_metrics, [
+        {2, undefined, alarms_memory_used_watermark, boolean, "is memory alarm in effect", mem_alarm}
+    ]}
+]).
+
+-define(METRICS_CLUSTER, [
+    {vhost_status, [
+        {2, undefined, vhost_status, gauge, "Whether a given vhost is running"}
+    ]}
+]).
+`
+
+func TestParseSource(t *testing.T) {
+	defs := ParseSource(coreCollector, "ignored_")
+	if len(defs) != 5 {
+		t.Fatalf("expected 5 metrics, got %d: %+v", len(defs), defs)
+	}
+
+	byName := make(map[string]MetricDef)
+	for _, d := range defs {
+		byName[d.Name] = d
+	}
+
+	if d, ok := byName["rabbitmq_channel_consumers"]; !ok || d.Type != "gauge" || d.Help != "Consumers on a channel" {
+		t.Errorf("unexpected rabbitmq_channel_consumers: %+v", d)
+	}
+	if d := byName["rabbitmq_io_read_time_seconds_total"]; d.Type != "counter" {
+		t.Errorf("expected counter with unit macro, got %+v", d)
+	}
+	if d := byName["rabbitmq_alarms_memory_used_watermark"]; d.Type != "gauge" {
+		t.Errorf("expected boolean to map to gauge, got %+v", d)
+	}
+	if _, ok := byName["rabbitmq_cluster_vhost_status"]; !ok {
+		t.Error("expected cluster-prefixed vhost_status")
+	}
+}
+
+func TestParseSourceGlobalCounters(t *testing.T) {
+	src := `-define(PROTOCOL_COUNTERS,
+            [
+             {
+              messages_received_total, ?MESSAGES_RECEIVED, counter,
+              "Total number of messages received from publishers"
+             }
+            ]).
+`
+	defs := ParseSource(src, "rabbitmq_global_")
+	if len(defs) != 1 || defs[0].Name != "rabbitmq_global_messages_received_total" || defs[0].Type != "counter" {
+		t.Errorf("unexpected global counters: %+v", defs)
+	}
+}
@@ -0,0 +1,221 @@
+package scrape
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const acceptHeader = "application/openmetrics-text;version=1.0.0,text/plain;version=0.0.4;q=0.5"
+
+// Adapter reads a Prometheus or OpenMetrics exposition from a file or a
+// local HTTP endpoint and catalogs what it declares.
+type Adapter struct {
+	cacheDir      string
+	target        string
+	componentName string
+	client        *http.Client
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "scrape",
+		Category:    domain.SourcePrometheus,
+		Description: "Prometheus or OpenMetrics exposition from a file or HTTP endpoint",
+		Tags:        []string{"prometheus", "observed"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			if opts.File == "" || opts.Component == "" {
+				return nil, fmt.Errorf("scrape adapter requires a file and a component")
+			}
+			return NewAdapter(opts.CacheDir, opts.File, opts.Component), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir, target, componentName string) *Adapter {
+	return &Adapter{
+		cacheDir:      cacheDir,
+		target:        target,
+		componentName: componentName,
+		client:        &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "scrape"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourcePrometheus
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceDerived
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionScrape
+}
+
+func (a *Adapter) RepoURL() string {
+	return a.target
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	if a.target == "" {
+		return nil, fmt.Errorf("scrape target is required")
+	}
+	if a.componentName == "" {
+		return nil, fmt.Errorf("component name is required")
+	}
+
+	var (
+		data []byte
+		path string
+		err  error
+	)
+
+	if isHTTP(a.target) {
+		data, err = a.scrape(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		cacheDir := a.cacheDir
+		if opts.CacheDir != "" {
+			cacheDir = opts.CacheDir
+		}
+		dir := filepath.Join(cacheDir, "scrape")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("failed to create cache directory: %w", err)
+		}
+		path = filepath.Join(dir, a.componentName+".txt")
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return nil, fmt.Errorf("failed to cache scrape: %w", err)
+		}
+	} else {
+		path = a.target
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read exposition: %w", err)
+		}
+	}
+
+	sum := sha256.Sum256(data)
+
+	return &adapter.FetchResult{
+		RepoPath:  filepath.Dir(path),
+		Commit:    hex.EncodeToString(sum[:])[:12],
+		Timestamp: time.Now(),
+		Files:     []string{path},
+	}, nil
+}
+
+func (a *Adapter) scrape(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build scrape request: %w", err)
+	}
+	req.Header.Set("Accept", acceptHeader)
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape %s: %w", a.target, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to scrape %s: status %d", a.target, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scrape response: %w", err)
+	}
+
+	return data, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	var metrics []*adapter.RawMetric
+
+	for _, path := range result.Files {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open exposition: %w", err)
+		}
+		families, err := ParseExposition(f)
+		_ = f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		for _, family := range families {
+			metrics = append(metrics, a.toRawMetric(family, path))
+		}
+	}
+
+	return metrics, nil
+}
+
+func (a *Adapter) toRawMetric(family *MetricFamily, path string) *adapter.RawMetric {
+	attrs := make([]domain.Attribute, 0, len(family.Labels))
+	for _, label := range family.Labels {
+		attrs = append(attrs, domain.Attribute{
+			Name: label,
+			Type: "string",
+		})
+	}
+
+	name := family.Name
+	if family.Type == "counter" && family.Total && !strings.HasSuffix(name, "_total") {
+		name += "_total"
+	}
+	// OpenMetrics info families drop the _info their samples are queried by
+	if family.Type == "info" && !strings.HasSuffix(name, "_info") {
+		name += "_info"
+	}
+
+	return &adapter.RawMetric{
+		Name:             name,
+		InstrumentType:   instrumentType(family.Type),
+		Description:      family.Help,
+		Unit:             family.Unit,
+		Attributes:       attrs,
+		EnabledByDefault: true,
+		ComponentType:    string(domain.ComponentPlatform),
+		ComponentName:    a.componentName,
+		SourceLocation:   a.target,
+		Path:             path,
+		BucketBoundaries: family.Buckets,
+	}
+}
+
+// instrumentType maps exposition types onto the catalog's instruments; the
+// OpenMetrics types without a direct counterpart are point-in-time values.
+func instrumentType(t string) string {
+	switch t {
+	case "counter":
+		return string(domain.InstrumentCounter)
+	case "histogram", "gaugehistogram":
+		return string(domain.InstrumentHistogram)
+	case "summary":
+		return string(domain.InstrumentSummary)
+	default:
+		return string(domain.InstrumentGauge)
+	}
+}
+
+func isHTTP(target string) bool {
+	return strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://")
+}
@@ -0,0 +1,126 @@
+package scrape
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func TestAdapterProperties(t *testing.T) {
+	a := NewAdapter(".cache", "metrics.txt", "myservice")
+
+	if a.Name() != "scrape" {
+		t.Errorf("expected name scrape, got %s", a.Name())
+	}
+	if a.SourceCategory() != domain.SourcePrometheus {
+		t.Errorf("expected source category prometheus, got %s", a.SourceCategory())
+	}
+	if a.Confidence() != domain.ConfidenceDerived {
+		t.Errorf("expected confidence derived, got %s", a.Confidence())
+	}
+	if a.ExtractionMethod() != domain.ExtractionScrape {
+		t.Errorf("expected extraction method scrape, got %s", a.ExtractionMethod())
+	}
+	if a.RepoURL() != "metrics.txt" {
+		t.Errorf("expected repo URL to be the target, got %s", a.RepoURL())
+	}
+}
+
+func TestAdapterImplementsInterface(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter(".cache", "metrics.txt", "myservice")
+}
+
+func TestAdapterFetchRequiresComponent(t *testing.T) {
+	a := NewAdapter(".cache", "metrics.txt", "")
+	if _, err := a.Fetch(context.Background(), adapter.FetchOptions{}); err == nil {
+		t.Error("expected error without a component name")
+	}
+}
+
+func TestAdapterExtractFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.txt")
+	if err := os.WriteFile(path, []byte(openMetricsText), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewAdapter(t.TempDir(), path, "myservice")
+	ctx := context.Background()
+
+	result, err := a.Fetch(ctx, adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	if len(result.Commit) != 12 {
+		t.Errorf("expected content hash as commit, got %q", result.Commit)
+	}
+
+	metrics, err := a.Extract(ctx, result)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric)
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+
+	cpu := byName["process_cpu_seconds_total"]
+	if cpu == nil {
+		t.Fatal("expected OpenMetrics counter to be cataloged with its _total name")
+	}
+	if cpu.InstrumentType != "counter" || cpu.Unit != "seconds" {
+		t.Errorf("unexpected counter: %+v", cpu)
+	}
+	if cpu.ComponentName != "myservice" || cpu.ComponentType != string(domain.ComponentPlatform) {
+		t.Errorf("expected platform component myservice, got %s/%s", cpu.ComponentType, cpu.ComponentName)
+	}
+
+	build := byName["build_info"]
+	if build == nil || build.InstrumentType != "gauge" || len(build.Attributes) != 2 {
+		t.Errorf("expected info metric as build_info gauge with 2 attributes, got %+v", build)
+	}
+}
+
+func TestAdapterExtractHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != acceptHeader {
+			t.Errorf("unexpected Accept header %q", r.Header.Get("Accept"))
+		}
+		_, _ = w.Write([]byte(prometheusText))
+	}))
+	defer server.Close()
+
+	cacheDir := t.TempDir()
+	a := NewAdapter(cacheDir, server.URL+"/metrics", "myservice")
+	ctx := context.Background()
+
+	result, err := a.Fetch(ctx, adapter.FetchOptions{})
+	if err != nil {
+		t.Fatalf("Fetch failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(cacheDir, "scrape", "myservice.txt")); err != nil {
+		t.Errorf("expected scrape to be cached: %v", err)
+	}
+
+	metrics, err := a.Extract(ctx, result)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+	if len(metrics) != 4 {
+		t.Fatalf("expected 4 metrics, got %d", len(metrics))
+	}
+
+	for _, m := range metrics {
+		if m.Name == "request_duration_seconds" {
+			if m.InstrumentType != "histogram" || len(m.BucketBoundaries) != 2 {
+				t.Errorf("unexpected histogram: %+v", m)
+			}
+		}
+	}
+}
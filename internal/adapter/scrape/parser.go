@@ -0,0 +1,224 @@
+package scrape
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MetricFamily is what a scrape reveals about one metric: the declared
+// metadata plus every label key seen on its samples.
+type MetricFamily struct {
+	Name    string
+	Type    string
+	Help    string
+	Unit    string
+	Labels  []string
+	Buckets []float64
+	// Total is set when counter samples carried the _total suffix
+	Total bool
+}
+
+// Suffixes samples may carry on top of their family name, per type.
+var typeSuffixes = map[string][]string{
+	"counter":        {"_total", "_created"},
+	"histogram":      {"_bucket", "_count", "_sum", "_created"},
+	"gaugehistogram": {"_bucket", "_gcount", "_gsum"},
+	"summary":        {"_count", "_sum", "_created"},
+	"info":           {"_info"},
+}
+
+// sampleSuffixes lists every suffix in typeSuffixes, so a sample name maps to
+// a handful of candidate family names.
+var sampleSuffixes = []string{"_total", "_bucket", "_count", "_sum", "_created", "_gcount", "_gsum", "_info"}
+
+// ParseExposition reads the Prometheus text format or OpenMetrics and returns
+// the families in the order they first appear.
+func ParseExposition(r io.Reader) ([]*MetricFamily, error) {
+	p := &expositionParser{families: make(map[string]*MetricFamily)}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			if line == "# EOF" {
+				break
+			}
+			p.parseComment(line)
+			continue
+		}
+		if err := p.parseSample(line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNo, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read exposition: %w", err)
+	}
+
+	for _, f := range p.order {
+		sort.Strings(f.Labels)
+		sort.Float64s(f.Buckets)
+	}
+
+	return p.order, nil
+}
+
+type expositionParser struct {
+	families map[string]*MetricFamily
+	order    []*MetricFamily
+}
+
+func (p *expositionParser) family(name string) *MetricFamily {
+	if f, ok := p.families[name]; ok {
+		return f
+	}
+	f := &MetricFamily{Name: name}
+	p.families[name] = f
+	p.order = append(p.order, f)
+	return f
+}
+
+func (p *expositionParser) parseComment(line string) {
+	fields := strings.SplitN(strings.TrimSpace(strings.TrimPrefix(line, "#")), " ", 3)
+	if len(fields) < 3 {
+		return
+	}
+
+	keyword, name, rest := fields[0], fields[1], strings.TrimSpace(fields[2])
+	switch keyword {
+	case "HELP":
+		p.family(name).Help = unescapeHelp(rest)
+	case "TYPE":
+		p.family(name).Type = strings.ToLower(rest)
+	case "UNIT":
+		p.family(name).Unit = rest
+	}
+}
+
+func (p *expositionParser) parseSample(line string) error {
+	nameEnd := strings.IndexAny(line, "{ \t")
+	if nameEnd == -1 {
+		return fmt.Errorf("sample without value: %q", line)
+	}
+	name := line[:nameEnd]
+
+	var labels map[string]string
+	if line[nameEnd] == '{' {
+		parsed, err := parseLabels(line[nameEnd+1:])
+		if err != nil {
+			return err
+		}
+		labels = parsed
+	}
+
+	f, suffix := p.sampleFamily(name)
+
+	for key, value := range labels {
+		switch {
+		case key == "le" && suffix == "_bucket":
+			if bound, err := strconv.ParseFloat(value, 64); err == nil && !isInf(value) && !slices.Contains(f.Buckets, bound) {
+				f.Buckets = append(f.Buckets, bound)
+			}
+		case key == "quantile" && f.Type == "summary":
+		default:
+			if !slices.Contains(f.Labels, key) {
+				f.Labels = append(f.Labels, key)
+			}
+		}
+	}
+
+	if suffix == "_total" {
+		f.Total = true
+	}
+
+	return nil
+}
+
+// sampleFamily resolves a sample name to its family, stripping the suffix
+// its declared type allows.
+func (p *expositionParser) sampleFamily(name string) (*MetricFamily, string) {
+	if f, ok := p.families[name]; ok && f.Type != "" {
+		return f, ""
+	}
+
+	for _, suffix := range sampleSuffixes {
+		base, ok := strings.CutSuffix(name, suffix)
+		if !ok {
+			continue
+		}
+		if f, ok := p.families[base]; ok && slices.Contains(typeSuffixes[f.Type], suffix) {
+			return f, suffix
+		}
+	}
+
+	return p.family(name), ""
+}
+
+func parseLabels(s string) (map[string]string, error) {
+	labels := make(map[string]string)
+
+	i := 0
+	for {
+		for i < len(s) && (s[i] == ' ' || s[i] == ',') {
+			i++
+		}
+		if i >= len(s) {
+			return nil, fmt.Errorf("unterminated label set")
+		}
+		if s[i] == '}' {
+			return labels, nil
+		}
+
+		eq := strings.IndexByte(s[i:], '=')
+		if eq == -1 {
+			return nil, fmt.Errorf("label without value")
+		}
+		key := strings.TrimSpace(s[i : i+eq])
+		i += eq + 1
+
+		if i >= len(s) || s[i] != '"' {
+			return nil, fmt.Errorf("label %q value is not quoted", key)
+		}
+		i++
+
+		var value strings.Builder
+		for ; i < len(s) && s[i] != '"'; i++ {
+			if s[i] == '\\' && i+1 < len(s) {
+				i++
+				switch s[i] {
+				case 'n':
+					value.WriteByte('\n')
+				default:
+					value.WriteByte(s[i])
+				}
+				continue
+			}
+			value.WriteByte(s[i])
+		}
+		if i >= len(s) {
+			return nil, fmt.Errorf("unterminated value for label %q", key)
+		}
+		i++
+
+		labels[key] = value.String()
+	}
+}
+
+func unescapeHelp(s string) string {
+	return strings.NewReplacer(`\\`, `\`, `\n`, "\n", `\"`, `"`).Replace(s)
+}
+
+func isInf(s string) bool {
+	return strings.TrimPrefix(s, "+") == "Inf"
+}
@@ -0,0 +1,140 @@
+package scrape
+
+import (
+	"strings"
+	"testing"
+)
+
+const prometheusText = `# HELP http_requests_total Total HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="GET",code="200"} 1027 1395066363000
+http_requests_total{method="POST",code="400",path="/api"} 3
+# HELP request_duration_seconds Request latency.
+# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{handler="/",le="0.1"} 5
+request_duration_seconds_bucket{handler="/",le="0.5"} 8
+request_duration_seconds_bucket{handler="/",le="+Inf"} 9
+request_duration_seconds_sum{handler="/"} 2.5
+request_duration_seconds_count{handler="/"} 9
+# HELP rpc_latency RPC latency with an escaped \\ and\nnewline.
+# TYPE rpc_latency summary
+rpc_latency{service="a",quantile="0.5"} 0.1
+rpc_latency_sum{service="a"} 10
+rpc_latency_count{service="a"} 100
+untyped_metric{label="with \"quotes\", and commas"} 1
+`
+
+const openMetricsText = `# TYPE process_cpu_seconds counter
+# UNIT process_cpu_seconds seconds
+# HELP process_cpu_seconds Total user and system CPU time.
+process_cpu_seconds_total 4.2
+process_cpu_seconds_created 1.7e9
+# TYPE build info
+# HELP build Build information.
+build_info{version="1.2.3",revision="abc"} 1
+# TYPE queue_size gauge
+queue_size 3
+# EOF
+ignored_after_eof 1
+`
+
+func parse(t *testing.T, input string) map[string]*MetricFamily {
+	t.Helper()
+
+	families, err := ParseExposition(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseExposition failed: %v", err)
+	}
+
+	byName := make(map[string]*MetricFamily, len(families))
+	for _, f := range families {
+		byName[f.Name] = f
+	}
+	return byName
+}
+
+func TestParseExposition_Prometheus(t *testing.T) {
+	families := parse(t, prometheusText)
+
+	if len(families) != 4 {
+		t.Fatalf("expected 4 families, got %d", len(families))
+	}
+
+	counter := families["http_requests_total"]
+	if counter.Type != "counter" || counter.Help != "Total HTTP requests." {
+		t.Errorf("unexpected counter metadata: %+v", counter)
+	}
+	if strings.Join(counter.Labels, ",") != "code,method,path" {
+		t.Errorf("expected label union code,method,path, got %v", counter.Labels)
+	}
+
+	histogram := families["request_duration_seconds"]
+	if strings.Join(histogram.Labels, ",") != "handler" {
+		t.Errorf("expected le to be excluded from histogram labels, got %v", histogram.Labels)
+	}
+	if len(histogram.Buckets) != 2 || histogram.Buckets[0] != 0.1 || histogram.Buckets[1] != 0.5 {
+		t.Errorf("expected buckets [0.1 0.5], got %v", histogram.Buckets)
+	}
+
+	summary := families["rpc_latency"]
+	if strings.Join(summary.Labels, ",") != "service" {
+		t.Errorf("expected quantile to be excluded from summary labels, got %v", summary.Labels)
+	}
+	if summary.Help != "RPC latency with an escaped \\ and\nnewline." {
+		t.Errorf("expected help to be unescaped, got %q", summary.Help)
+	}
+
+	untyped := families["untyped_metric"]
+	if untyped == nil || untyped.Type != "" || strings.Join(untyped.Labels, ",") != "label" {
+		t.Errorf("expected untyped family with label key, got %+v", untyped)
+	}
+}
+
+func TestParseExposition_OpenMetrics(t *testing.T) {
+	families := parse(t, openMetricsText)
+
+	if _, ok := families["ignored_after_eof"]; ok {
+		t.Error("expected samples after # EOF to be ignored")
+	}
+
+	cpu := families["process_cpu_seconds"]
+	if cpu == nil {
+		t.Fatal("expected process_cpu_seconds family")
+	}
+	if cpu.Unit != "seconds" || !cpu.Total {
+		t.Errorf("expected unit seconds and _total samples, got %+v", cpu)
+	}
+
+	build := families["build"]
+	if build == nil || build.Type != "info" || strings.Join(build.Labels, ",") != "revision,version" {
+		t.Errorf("expected info family with label keys, got %+v", build)
+	}
+
+	if _, ok := families["build_info"]; ok {
+		t.Error("expected build_info samples to resolve to the build family")
+	}
+}
+
+func TestParseExposition_Malformed(t *testing.T) {
+	_, err := ParseExposition(strings.NewReader(`broken{label="unterminated} 1`))
+	if err == nil {
+		t.Error("expected error for unterminated label value")
+	}
+}
+
+func TestParseExposition_SuffixNeedsType(t *testing.T) {
+	families := parse(t, `# TYPE queue_size gauge
+queue_size 3
+queue_size_total 7
+# TYPE jobs counter
+jobs_total 1
+jobs_created 1.7e9
+`)
+
+	if _, ok := families["queue_size_total"]; !ok {
+		t.Error("expected _total on a gauge to start its own family")
+	}
+	if jobs := families["jobs"]; jobs == nil || !jobs.Total || len(families) != 3 {
+		t.Errorf("expected jobs_total and jobs_created to resolve to jobs, got %v", families)
+	}
+}
@@ -0,0 +1,255 @@
+package telegraf
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/base-14/metric-library/internal/fetcher"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const repoURL = "https://github.com/influxdata/telegraf"
+
+var invalidNameRe = regexp.MustCompile(`[^a-zA-Z0-9_:]`)
+
+type Adapter struct {
+	fetcher *fetcher.GitFetcher
+}
+
+func init() {
+	adapter.Register(adapter.Registration{
+		Name:        "vendor-telegraf",
+		Category:    domain.SourceVendor,
+		Description: "Telegraf input plugin fields",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"vendor", "telegraf"},
+		New: func(opts adapter.Options) (adapter.Adapter, error) {
+			return NewAdapter(opts.CacheDir), nil
+		},
+	})
+}
+
+func NewAdapter(cacheDir string) *Adapter {
+	return &Adapter{
+		fetcher: fetcher.NewGitFetcher(cacheDir),
+	}
+}
+
+func (a *Adapter) Name() string {
+	return "vendor-telegraf"
+}
+
+func (a *Adapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceVendor
+}
+
+func (a *Adapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceDerived
+}
+
+func (a *Adapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionHybrid
+}
+
+func (a *Adapter) RepoURL() string {
+	return repoURL
+}
+
+func (a *Adapter) Fetch(ctx context.Context, opts adapter.FetchOptions) (*adapter.FetchResult, error) {
+	fetchOpts := fetcher.FetchOptions{
+		RepoURL: repoURL,
+		Commit:  opts.Commit,
+		Shallow: true,
+		Depth:   1,
+		Force:   opts.Force,
+	}
+
+	result, err := a.fetcher.Fetch(ctx, fetchOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adapter.FetchResult{
+		RepoPath:  result.RepoPath,
+		Commit:    result.Commit,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func (a *Adapter) Extract(ctx context.Context, result *adapter.FetchResult) ([]*adapter.RawMetric, error) {
+	inputsDir := filepath.Join(result.RepoPath, "plugins", "inputs")
+
+	entries, err := os.ReadDir(inputsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics []*adapter.RawMetric
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		pluginDir := filepath.Join(inputsDir, entry.Name())
+		relPath, _ := filepath.Rel(result.RepoPath, pluginDir)
+
+		for _, m := range extractPlugin(pluginDir) {
+			m.ComponentName = entry.Name()
+			m.Path = relPath
+			metrics = append(metrics, m)
+		}
+	}
+
+	return metrics, nil
+}
+
+// pluginMetric accumulates what the README and the source say about one
+// measurement field.
+type pluginMetric struct {
+	measurement string
+	field       Field
+	metricType  string
+	tags        []Field
+}
+
+func extractPlugin(pluginDir string) []*adapter.RawMetric {
+	var order []string
+	byName := make(map[string]*pluginMetric)
+
+	get := func(measurement, field string) *pluginMetric {
+		name := PrometheusName(measurement, field)
+		pm, ok := byName[name]
+		if !ok {
+			pm = &pluginMetric{measurement: measurement, field: Field{Name: field}}
+			byName[name] = pm
+			order = append(order, name)
+		}
+		return pm
+	}
+
+	if measurements, err := ParseReadme(filepath.Join(pluginDir, "README.md")); err == nil {
+		for _, ms := range measurements {
+			for _, f := range ms.Fields {
+				pm := get(ms.Name, f.Name)
+				pm.field = f
+				pm.tags = mergeTags(pm.tags, ms.Tags)
+			}
+		}
+	}
+
+	_ = filepath.WalkDir(pluginDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		if d.IsDir() {
+			if d.Name() == "testdata" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		calls, err := ParseSourceFile(path)
+		if err != nil {
+			return nil
+		}
+		for _, call := range calls {
+			tags := make([]Field, 0, len(call.Tags))
+			for _, t := range call.Tags {
+				tags = append(tags, Field{Name: t})
+			}
+			for _, f := range call.Fields {
+				pm := get(call.Measurement, f)
+				if pm.metricType == "" || pm.metricType == "untyped" {
+					pm.metricType = call.Type
+				}
+				pm.tags = mergeTags(pm.tags, tags)
+			}
+		}
+		return nil
+	})
+
+	metrics := make([]*adapter.RawMetric, 0, len(order))
+	for _, name := range order {
+		pm := byName[name]
+		// The Prometheus serializer drops string fields
+		if pm.field.Type == "string" {
+			continue
+		}
+
+		attrs := make([]domain.Attribute, 0, len(pm.tags))
+		for _, t := range pm.tags {
+			attrs = append(attrs, domain.Attribute{Name: t.Name, Type: "string", Description: t.Description})
+		}
+
+		metrics = append(metrics, &adapter.RawMetric{
+			Name:             name,
+			Description:      pm.field.Description,
+			Unit:             pm.field.Unit,
+			InstrumentType:   instrumentType(pm.metricType),
+			ValueType:        valueType(pm.field.Type),
+			Attributes:       attrs,
+			EnabledByDefault: true,
+			ComponentType:    string(domain.ComponentReceiver),
+			SourceLocation:   pm.measurement,
+		})
+	}
+	return metrics
+}
+
+// PrometheusName joins measurement and field as Telegraf's Prometheus
+// serializer does, where a field named "value" takes the measurement's name.
+func PrometheusName(measurement, field string) string {
+	name := measurement
+	if field != "value" {
+		name += "_" + field
+	}
+	return invalidNameRe.ReplaceAllString(name, "_")
+}
+
+func mergeTags(existing, extra []Field) []Field {
+	for _, t := range extra {
+		found := false
+		for i := range existing {
+			if existing[i].Name == t.Name {
+				if existing[i].Description == "" {
+					existing[i].Description = t.Description
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			existing = append(existing, t)
+		}
+	}
+	return existing
+}
+
+func instrumentType(metricType string) string {
+	switch metricType {
+	case "counter", "histogram", "summary":
+		return metricType
+	default:
+		return string(domain.InstrumentGauge)
+	}
+}
+
+func valueType(fieldType string) string {
+	switch fieldType {
+	case "integer", "int", "unsigned", "uint":
+		return string(domain.ValueTypeInt)
+	case "float":
+		return string(domain.ValueTypeDouble)
+	default:
+		return ""
+	}
+}
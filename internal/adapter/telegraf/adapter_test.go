@@ -0,0 +1,146 @@
+package telegraf
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const cpuSource = `package cpu
+
+func (c *CPU) Gather(acc telegraf.Accumulator) error {
+	acc.AddCounter("cpu", map[string]interface{}{"time_user": 1.0, "time_system": 2.0}, map[string]string{"cpu": "cpu0"})
+	return nil
+}
+`
+
+func writePluginFile(t *testing.T, repo, plugin, name, content string) {
+	t.Helper()
+
+	dir := filepath.Join(repo, "plugins", "inputs", plugin)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+}
+
+func extract(t *testing.T, repo string) map[string]*adapter.RawMetric {
+	t.Helper()
+
+	metrics, err := NewAdapter("/tmp/cache").Extract(context.Background(), &adapter.FetchResult{RepoPath: repo})
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*adapter.RawMetric, len(metrics))
+	for _, m := range metrics {
+		byName[m.Name] = m
+	}
+	return byName
+}
+
+func TestTelegrafAdapter_Name(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.Name() != "vendor-telegraf" {
+		t.Errorf("expected name 'vendor-telegraf', got %q", a.Name())
+	}
+}
+
+func TestTelegrafAdapter_ExtractionMethod(t *testing.T) {
+	a := NewAdapter("/tmp/cache")
+	if a.ExtractionMethod() != domain.ExtractionHybrid {
+		t.Errorf("expected extraction method 'hybrid', got %q", a.ExtractionMethod())
+	}
+}
+
+func TestTelegrafAdapter_ImplementsAdapter(t *testing.T) {
+	var _ adapter.Adapter = NewAdapter("/tmp/cache")
+}
+
+func TestTelegrafAdapter_Extract_MergesReadmeAndSource(t *testing.T) {
+	repo := t.TempDir()
+	writePluginFile(t, repo, "cpu", "README.md", cpuReadme)
+	writePluginFile(t, repo, "cpu", "cpu.go", cpuSource)
+
+	metrics := extract(t, repo)
+
+	timeUser := metrics["cpu_time_user"]
+	if timeUser == nil {
+		t.Fatal("expected cpu_time_user")
+	}
+	if timeUser.InstrumentType != "counter" || timeUser.Unit != "s" || timeUser.ValueType != "double" {
+		t.Errorf("expected the README unit on the source counter, got %+v", timeUser)
+	}
+	if len(timeUser.Attributes) != 1 || timeUser.Attributes[0].Description == "" {
+		t.Errorf("expected the documented cpu tag, got %+v", timeUser.Attributes)
+	}
+
+	if _, ok := metrics["cpu_time_system"]; !ok {
+		t.Error("expected the source-only field")
+	}
+	if idle := metrics["cpu_usage_idle"]; idle == nil || idle.InstrumentType != "gauge" {
+		t.Errorf("expected the README-only field as a gauge, got %+v", idle)
+	}
+}
+
+func TestTelegrafAdapter_Extract_SkipsStringFields(t *testing.T) {
+	repo := t.TempDir()
+	writePluginFile(t, repo, "cpu", "README.md", cpuReadme)
+
+	if _, ok := extract(t, repo)["cpu_label"]; ok {
+		t.Error("expected string fields to be skipped")
+	}
+}
+
+func TestTelegrafAdapter_Extract_ComponentPerPlugin(t *testing.T) {
+	repo := t.TempDir()
+	writePluginFile(t, repo, "cpu", "cpu.go", cpuSource)
+	writePluginFile(t, repo, "mem", "mem.go", memSource)
+
+	metrics := extract(t, repo)
+	if len(metrics) != 6 {
+		t.Fatalf("expected 6 metrics, got %d", len(metrics))
+	}
+
+	faults := metrics["mem_events_faults"]
+	if faults == nil || faults.InstrumentType != "counter" {
+		t.Fatalf("expected a counter from source, got %+v", faults)
+	}
+	if faults.ComponentName != "mem" || faults.ComponentType != string(domain.ComponentReceiver) {
+		t.Errorf("unexpected component: %s/%s", faults.ComponentType, faults.ComponentName)
+	}
+	if faults.Path != filepath.Join("plugins", "inputs", "mem") {
+		t.Errorf("expected the plugin directory as path, got %q", faults.Path)
+	}
+}
+
+func TestTelegrafAdapter_Extract_SkipsTestFiles(t *testing.T) {
+	repo := t.TempDir()
+	writePluginFile(t, repo, "mem", "mem_test.go", memSource)
+
+	if metrics := extract(t, repo); len(metrics) != 0 {
+		t.Errorf("expected test files to be skipped, got %d metrics", len(metrics))
+	}
+}
+
+func TestPrometheusName(t *testing.T) {
+	tests := []struct {
+		measurement, field, expected string
+	}{
+		{"cpu", "usage_idle", "cpu_usage_idle"},
+		{"net", "value", "net"},
+		{"disk.io", "read-bytes", "disk_io_read_bytes"},
+	}
+
+	for _, tt := range tests {
+		if got := PrometheusName(tt.measurement, tt.field); got != tt.expected {
+			t.Errorf("PrometheusName(%q, %q) = %q, expected %q", tt.measurement, tt.field, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package telegraf
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// Measurement is what a plugin documents or emits under one measurement name.
+type Measurement struct {
+	Name   string
+	Tags   []Field
+	Fields []Field
+}
+
+// Field is a documented field or tag.
+type Field struct {
+	Name        string
+	Type        string // integer, float, boolean, string; empty for tags
+	Unit        string
+	Description string
+}
+
+var (
+	headingRe = regexp.MustCompile(`^(#+)\s+(.*)$`)
+	itemRe    = regexp.MustCompile("^(\\s*)[-*]\\s+`?([A-Za-z0-9_.\\-:/]+)`?\\s*(?:\\(([^)]*)\\))?\\s*[:\\-]?\\s*(.*)$")
+)
+
+var valueTypes = map[string]bool{
+	"integer":  true,
+	"int":      true,
+	"float":    true,
+	"boolean":  true,
+	"bool":     true,
+	"string":   true,
+	"unsigned": true,
+	"uint":     true,
+}
+
+// Units spelled out in README field annotations such as "(float, percent)"
+var readmeUnits = map[string]string{
+	"bytes":        "By",
+	"seconds":      "s",
+	"milliseconds": "ms",
+	"microseconds": "us",
+	"nanoseconds":  "ns",
+	"percent":      "%",
+}
+
+func ParseReadme(path string) ([]Measurement, error) {
+	f, err := os.Open(path) //nolint:gosec // Reading READMEs from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var (
+		measurements []Measurement
+		current      *Measurement
+		section      string // "tags" or "fields" within a measurement
+		metricsLevel int    // heading depth of the Metrics section, 0 outside it
+		inCode       bool
+	)
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			continue
+		}
+
+		if m := headingRe.FindStringSubmatch(line); m != nil {
+			level := len(m[1])
+			switch {
+			case strings.HasPrefix(strings.ToLower(m[2]), "metrics"):
+				metricsLevel = level
+			case metricsLevel > 0 && level <= metricsLevel:
+				metricsLevel = 0
+			}
+			current = nil
+			continue
+		}
+		if metricsLevel == 0 {
+			continue
+		}
+
+		m := itemRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		indent, name, annotation, description := len(m[1]), m[2], m[3], strings.TrimSpace(m[4])
+
+		switch {
+		case indent == 0:
+			measurements = append(measurements, Measurement{Name: name})
+			current = &measurements[len(measurements)-1]
+			section = ""
+		case current == nil:
+			continue
+		case strings.TrimSuffix(name, ":") == "tags" || strings.TrimSuffix(name, ":") == "fields":
+			section = strings.TrimSuffix(name, ":")
+		case section == "tags":
+			current.Tags = append(current.Tags, Field{Name: name, Description: firstNonEmpty(description, annotation)})
+		case section == "fields":
+			field := Field{Name: name, Description: description}
+			for _, part := range strings.Split(annotation, ",") {
+				part = strings.ToLower(strings.TrimSpace(part))
+				if valueTypes[part] && field.Type == "" {
+					field.Type = part
+				} else if u, ok := readmeUnits[part]; ok && field.Unit == "" {
+					field.Unit = u
+				}
+			}
+			current.Fields = append(current.Fields, field)
+		}
+	}
+
+	return measurements, scanner.Err()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,53 @@
+package telegraf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const cpuReadme = "# CPU Input Plugin\n\n" +
+	"## Configuration\n\n```toml\n[[inputs.cpu]]\n  percpu = true\n```\n\n" +
+	"## Metrics\n\n" +
+	"On Linux, consult `man proc` for details on the meanings of these values.\n\n" +
+	"- cpu\n" +
+	"  - tags:\n" +
+	"    - cpu (CPU ID or `cpu-total`)\n" +
+	"  - fields:\n" +
+	"    - time_user (float, seconds)\n" +
+	"    - usage_idle (float, percent): Idle time share\n" +
+	"    - label (string)\n\n" +
+	"## Example Output\n\n" +
+	"- not_a_metric\n"
+
+func TestParseReadme(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "README.md")
+	if err := os.WriteFile(path, []byte(cpuReadme), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	measurements, err := ParseReadme(path)
+	if err != nil {
+		t.Fatalf("ParseReadme failed: %v", err)
+	}
+	if len(measurements) != 1 {
+		t.Fatalf("expected 1 measurement, got %+v", measurements)
+	}
+
+	cpu := measurements[0]
+	if cpu.Name != "cpu" || len(cpu.Tags) != 1 || len(cpu.Fields) != 3 {
+		t.Fatalf("unexpected measurement: %+v", cpu)
+	}
+	if cpu.Tags[0].Name != "cpu" || cpu.Tags[0].Description != "CPU ID or `cpu-total`" {
+		t.Errorf("unexpected tag: %+v", cpu.Tags[0])
+	}
+
+	timeUser := cpu.Fields[0]
+	if timeUser.Type != "float" || timeUser.Unit != "s" {
+		t.Errorf("unexpected field: %+v", timeUser)
+	}
+	idle := cpu.Fields[1]
+	if idle.Unit != "%" || idle.Description != "Idle time share" {
+		t.Errorf("unexpected field: %+v", idle)
+	}
+}
@@ -0,0 +1,175 @@
+package telegraf
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/base-14/metric-library/internal/adapter/prometheus/astparser"
+)
+
+// Accumulator methods and the value type each records
+var accumulatorMethods = map[string]string{
+	"AddFields":    "untyped",
+	"AddGauge":     "gauge",
+	"AddCounter":   "counter",
+	"AddSummary":   "summary",
+	"AddHistogram": "histogram",
+}
+
+// AccumulatorCall is a measurement a plugin records through telegraf.Accumulator.
+type AccumulatorCall struct {
+	Measurement string
+	Type        string
+	Fields      []string
+	Tags        []string
+}
+
+func ParseSourceFile(path string) ([]AccumulatorCall, error) {
+	src, err := os.ReadFile(path) //nolint:gosec // Reading Go source files from cloned repos is intentional
+	if err != nil {
+		return nil, err
+	}
+	return ParseSource(filepath.Base(path), src)
+}
+
+// ParseSource finds acc.AddFields/AddGauge/AddCounter calls whose
+// measurement resolves statically, reading field and tag keys from map
+// literals passed inline or built up in the same function.
+func ParseSource(filename string, src []byte) ([]AccumulatorCall, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	constants := astparser.Constants(f)
+	var calls []AccumulatorCall
+
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		mapKeys := collectMapKeys(fn.Body, constants)
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) < 2 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			metricType, ok := accumulatorMethods[sel.Sel.Name]
+			if !ok {
+				return true
+			}
+
+			measurement := astparser.ResolveString(call.Args[0], constants)
+			if measurement == "" {
+				return true
+			}
+
+			ac := AccumulatorCall{
+				Measurement: measurement,
+				Type:        metricType,
+				Fields:      keysOf(call.Args[1], mapKeys, constants),
+			}
+			if len(call.Args) > 2 {
+				ac.Tags = keysOf(call.Args[2], mapKeys, constants)
+			}
+			calls = append(calls, ac)
+			return true
+		})
+	}
+
+	return calls, nil
+}
+
+// collectMapKeys gathers, per local variable, the string keys assigned to it
+// through map literals or index assignments.
+func collectMapKeys(body *ast.BlockStmt, constants map[string]string) map[string][]string {
+	keys := make(map[string][]string)
+	add := func(name string, newKeys ...string) {
+		for _, k := range newKeys {
+			if k != "" && !contains(keys[name], k) {
+				keys[name] = append(keys[name], k)
+			}
+		}
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for i, lhs := range node.Lhs {
+				switch target := lhs.(type) {
+				case *ast.Ident:
+					if i < len(node.Rhs) {
+						add(target.Name, literalKeys(node.Rhs[i], constants)...)
+					}
+				case *ast.IndexExpr:
+					if ident, ok := target.X.(*ast.Ident); ok {
+						add(ident.Name, astparser.ResolveString(target.Index, constants))
+					}
+				}
+			}
+		case *ast.ValueSpec:
+			for i, name := range node.Names {
+				if i < len(node.Values) {
+					add(name.Name, literalKeys(node.Values[i], constants)...)
+				}
+			}
+		}
+		return true
+	})
+
+	return keys
+}
+
+func keysOf(expr ast.Expr, mapKeys map[string][]string, constants map[string]string) []string {
+	var keys []string
+	if ident, ok := expr.(*ast.Ident); ok {
+		keys = append(keys, mapKeys[ident.Name]...)
+	} else {
+		keys = literalKeys(expr, constants)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func literalKeys(expr ast.Expr, constants map[string]string) []string {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return nil
+	}
+	if _, isMap := lit.Type.(*ast.MapType); !isMap {
+		return nil
+	}
+
+	var keys []string
+	for _, elt := range lit.Elts {
+		kv, ok := elt.(*ast.KeyValueExpr)
+		if !ok {
+			continue
+		}
+		if k := astparser.ResolveString(kv.Key, constants); k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+func contains(s []string, v string) bool {
+	for _, x := range s {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
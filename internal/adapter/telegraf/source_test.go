@@ -0,0 +1,57 @@
+package telegraf
+
+import (
+	"reflect"
+	"testing"
+)
+
+const memSource = `package mem
+
+import "github.com/influxdata/telegraf"
+
+const measurement = "mem"
+
+type Mem struct{}
+
+func (m *Mem) Gather(acc telegraf.Accumulator) error {
+	fields := map[string]interface{}{
+		"total":     1,
+		"available": 2,
+	}
+	if true {
+		fields["swap_cached"] = 3
+	}
+	acc.AddGauge(measurement, fields, nil)
+
+	acc.AddCounter("mem_events", map[string]interface{}{"faults": 1}, map[string]string{"kind": "major"})
+
+	name := dynamicName()
+	acc.AddFields(name, fields, nil)
+	return nil
+}
+
+func dynamicName() string { return "x" }
+`
+
+func TestParseSource(t *testing.T) {
+	calls, err := ParseSource("mem.go", []byte(memSource))
+	if err != nil {
+		t.Fatalf("ParseSource failed: %v", err)
+	}
+	if len(calls) != 2 {
+		t.Fatalf("expected 2 resolvable calls, got %+v", calls)
+	}
+
+	gauge := calls[0]
+	if gauge.Measurement != "mem" || gauge.Type != "gauge" {
+		t.Errorf("unexpected call: %+v", gauge)
+	}
+	if !reflect.DeepEqual(gauge.Fields, []string{"available", "swap_cached", "total"}) {
+		t.Errorf("unexpected fields: %v", gauge.Fields)
+	}
+
+	counter := calls[1]
+	if counter.Type != "counter" || !reflect.DeepEqual(counter.Fields, []string{"faults"}) || !reflect.DeepEqual(counter.Tags, []string{"kind"}) {
+		t.Errorf("unexpected call: %+v", counter)
+	}
+}
@@ -1,16 +1,24 @@
 package api
 
 import (
+	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/internal/catalog"
+	"github.com/base-14/metric-library/internal/lint"
 	"github.com/base-14/metric-library/internal/store"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type SearchResponse struct {
@@ -31,18 +39,55 @@ type FacetResponse struct {
 	Units            map[string]int `json:"units"`
 }
 
+type AdaptersResponse struct {
+	Adapters []adapter.Registration `json:"adapters"`
+}
+
+type CatalogResponse struct {
+	Catalog       string `json:"catalog"`
+	Component     string `json:"component"`
+	MetricsStored int    `json:"metrics_stored"`
+}
+
 type ErrorResponse struct {
 	Error   string `json:"error"`
 	Message string `json:"message,omitempty"`
 }
 
+// Options configure the API. The zero value allows any origin and needs no
+// token.
+type Options struct {
+	// AllowedOrigins lists the origins CORS responses allow; empty or "*"
+	// allows any
+	AllowedOrigins []string
+	// Tokens, when set, are the bearer tokens /api requests must carry
+	Tokens []string
+	// WriteTokens are the bearer tokens catalog submissions must carry;
+	// without any, submissions are refused
+	WriteTokens []string
+	// Linter checks metrics for /api/metrics/{id}/lint, with the default
+	// rules when nil
+	Linter *lint.Linter
+	// ReservedSources are source names catalogs may not take besides those
+	// of adapters and earlier extractions, such as the OTLP receiver's
+	ReservedSources []string
+}
+
 type Handler struct {
 	store  store.Store
+	opts   Options
 	router chi.Router
 }
 
 func NewHandler(s store.Store) *Handler {
-	h := &Handler{store: s}
+	return NewHandlerWithOptions(s, Options{})
+}
+
+func NewHandlerWithOptions(s store.Store, opts Options) *Handler {
+	if opts.Linter == nil {
+		opts.Linter = lint.Default()
+	}
+	h := &Handler{store: s, opts: opts}
 	h.setupRoutes()
 	return h
 }
@@ -51,18 +96,31 @@ func (h *Handler) setupRoutes() {
 	r := chi.NewRouter()
 	r.Use(middleware.Logger)
 	r.Use(middleware.Recoverer)
-	r.Use(corsMiddleware)
+	r.Use(corsMiddleware(h.opts.AllowedOrigins))
 
 	r.Get("/health", h.healthCheck)
 	r.Route("/api", func(r chi.Router) {
+		if len(h.opts.Tokens) > 0 {
+			// A write token must also get a submission past the read check
+			r.Use(authMiddleware(slices.Concat(h.opts.Tokens, h.opts.WriteTokens)))
+		}
 		r.Group(func(r chi.Router) {
-			r.Use(cacheMiddleware(86400)) // 24 hours
+			r.Use(cacheMiddleware(86400, len(h.opts.Tokens) > 0)) // 24 hours
 			r.Get("/metrics", h.searchMetrics)
 			r.Get("/metrics/{id}", h.getMetric)
+			r.Get("/components/{type}/{name}", h.getComponent)
+			r.Get("/adapters", h.listAdapters)
 		})
 		r.Group(func(r chi.Router) {
-			r.Use(cacheMiddleware(300)) // 5 minutes
+			r.Use(cacheMiddleware(300, len(h.opts.Tokens) > 0)) // 5 minutes
 			r.Get("/facets", h.getFacets)
+			// A resubmitted catalog keeps its metric IDs, so a verdict must
+			// not outlive the submission by a day
+			r.Get("/metrics/{id}/lint", h.lintMetric)
+		})
+		r.Group(func(r chi.Router) {
+			r.Use(writeAuthMiddleware(h.opts.WriteTokens))
+			r.Post("/catalogs/{name}/metrics", h.submitCatalogMetrics)
 		})
 	})
 
@@ -114,6 +172,46 @@ func (h *Handler) searchMetrics(w http.ResponseWriter, r *http.Request) {
 		query.SemconvMatches = []domain.SemconvMatch{domain.SemconvMatch(sm)}
 	}
 
+	if vt := r.URL.Query().Get("value_type"); vt != "" {
+		query.ValueTypes = []domain.ValueType{domain.ValueType(vt)}
+	}
+
+	if at := r.URL.Query().Get("temporality"); at != "" {
+		query.AggregationTemporalities = []domain.AggregationTemporality{domain.AggregationTemporality(at)}
+	}
+
+	if mono, err := strconv.ParseBool(r.URL.Query().Get("monotonic")); err == nil {
+		query.Monotonic = &mono
+	}
+
+	if hb, err := strconv.ParseBool(r.URL.Query().Get("has_buckets")); err == nil {
+		query.HasBucketBoundaries = hb
+	}
+
+	if sg := r.URL.Query().Get("signal"); sg != "" {
+		query.Signals = []domain.SignalKind{domain.SignalKind(sg)}
+	}
+
+	if st := r.URL.Query().Get("stability"); st != "" {
+		query.Stabilities = []domain.StabilityLevel{domain.StabilityLevel(st)}
+	}
+
+	if o := r.URL.Query().Get("owner"); o != "" {
+		query.Owners = []string{o}
+	}
+
+	if an := r.URL.Query().Get("attribute"); an != "" {
+		query.AttributeNames = []string{an}
+	}
+
+	if st := r.URL.Query().Get("statistic"); st != "" {
+		query.Statistics = []string{st}
+	}
+
+	if cs := r.URL.Query().Get("component_stability"); cs != "" {
+		query.ComponentStabilities = []domain.StabilityLevel{domain.StabilityLevel(cs)}
+	}
+
 	result, err := h.store.Search(r.Context(), query)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "search_failed", err.Error())
@@ -151,6 +249,106 @@ func (h *Handler) getMetric(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, metric)
 }
 
+func (h *Handler) lintMetric(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+
+	metric, err := h.store.GetMetric(r.Context(), id)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "get_metric_failed", err.Error())
+		return
+	}
+
+	if metric == nil {
+		writeError(w, http.StatusNotFound, "not_found", "metric not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, h.opts.Linter.Lint(metric))
+}
+
+func (h *Handler) getComponent(w http.ResponseWriter, r *http.Request) {
+	componentType := chi.URLParam(r, "type")
+	name := chi.URLParam(r, "name")
+
+	component, err := h.store.GetComponent(r.Context(), componentType, name, r.URL.Query().Get("source_name"))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "get_component_failed", err.Error())
+		return
+	}
+
+	if component == nil {
+		writeError(w, http.StatusNotFound, "not_found", "component not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, component)
+}
+
+func (h *Handler) listAdapters(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, AdaptersResponse{Adapters: adapter.Registrations()})
+}
+
+// maxCatalogBody bounds a submitted metadata document.
+const maxCatalogBody = 1 << 20
+
+func (h *Handler) submitCatalogMetrics(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "name")
+	taken, err := h.sourceTaken(r.Context(), name)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "store_failed", err.Error())
+		return
+	}
+	if taken {
+		writeError(w, http.StatusConflict, "catalog_conflict", fmt.Sprintf("%s is the source name of an adapter or extraction", name))
+		return
+	}
+
+	content, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxCatalogBody))
+	if err != nil {
+		writeError(w, http.StatusRequestEntityTooLarge, "body_too_large", err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	entries, err := catalog.Build(content, catalog.Submission{
+		Catalog:   name,
+		Component: q.Get("component"),
+		Category:  domain.SourceCategory(q.Get("category")),
+		Owner:     q.Get("owner"),
+		Repo:      q.Get("repo"),
+	})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_metadata", err.Error())
+		return
+	}
+
+	if err := h.store.ReplaceComponentMetrics(r.Context(), entries.Component, entries.Metrics); err != nil {
+		writeError(w, http.StatusInternalServerError, "store_failed", err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, CatalogResponse{
+		Catalog:       name,
+		Component:     entries.Component.Name,
+		MetricsStored: len(entries.Metrics),
+	})
+}
+
+// sourceTaken reports whether name belongs to something other than a
+// catalog: a registered adapter, a reserved source or any source an
+// extraction has stored under, which covers declarative adapters and the
+// names given to weaver and otlp.
+func (h *Handler) sourceTaken(ctx context.Context, name string) (bool, error) {
+	if _, ok := adapter.Lookup(name); ok || slices.Contains(h.opts.ReservedSources, name) {
+		return true, nil
+	}
+	run, err := h.store.GetLatestExtractionRun(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	return run != nil, nil
+}
+
 func (h *Handler) getFacets(w http.ResponseWriter, r *http.Request) {
 	var facets *store.FacetCounts
 	var err error
@@ -212,26 +410,92 @@ func writeError(w http.ResponseWriter, status int, errCode, message string) {
 	writeJSON(w, status, ErrorResponse{Error: errCode, Message: message})
 }
 
-func corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+func corsMiddleware(allowed []string) func(http.Handler) http.Handler {
+	anyOrigin := len(allowed) == 0 || slices.Contains(allowed, "*")
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if anyOrigin {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Add("Vary", "Origin")
+				if origin := r.Header.Get("Origin"); slices.Contains(allowed, origin) {
+					w.Header().Set("Access-Control-Allow-Origin", origin)
+				}
+			}
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusOK)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// authMiddleware requires one of tokens as a bearer token. Preflight
+// requests never get here; corsMiddleware answers them.
+func authMiddleware(tokens []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if len(tokens) == 0 {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || !validToken(tokens, given) {
+				writeError(w, http.StatusUnauthorized, "unauthorized", "a valid bearer token is required")
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
 
-		if r.Method == http.MethodOptions {
-			w.WriteHeader(http.StatusOK)
-			return
+// writeAuthMiddleware guards submissions, which stay off until write tokens
+// are configured.
+func writeAuthMiddleware(tokens []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if len(tokens) == 0 {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				writeError(w, http.StatusForbidden, "submissions_disabled", "catalog submissions need write tokens configured")
+			})
 		}
+		return authMiddleware(tokens)(next)
+	}
+}
 
-		next.ServeHTTP(w, r)
-	})
+// RequireWriteToken guards another write path, such as the OTLP receiver,
+// with the same check as catalog submissions.
+func RequireWriteToken(tokens []string, next http.Handler) http.Handler {
+	return writeAuthMiddleware(tokens)(next)
 }
 
-func cacheMiddleware(maxAge int) func(http.Handler) http.Handler {
+func validToken(tokens []string, given string) bool {
+	for _, token := range tokens {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(given)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheMiddleware lets clients cache reads for maxAge seconds. Behind
+// tokens a response is only for its caller, so shared caches must not keep
+// it and must key what they do keep by the token.
+func cacheMiddleware(maxAge int, authenticated bool) func(http.Handler) http.Handler {
+	scope := "public"
+	if authenticated {
+		scope = "private"
+	}
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.Method == http.MethodGet {
-				w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", maxAge))
+				w.Header().Set("Cache-Control", fmt.Sprintf("%s, max-age=%d", scope, maxAge))
+				if authenticated {
+					w.Header().Add("Vary", "Authorization")
+				}
 			}
 			next.ServeHTTP(w, r)
 		})
@@ -5,15 +5,21 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/internal/lint"
 	"github.com/base-14/metric-library/internal/store"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type mockStore struct {
-	metrics []*domain.CanonicalMetric
+	metrics    []*domain.CanonicalMetric
+	components []*domain.Component
+	runs       []*store.ExtractionRun
+	lastQuery  store.SearchQuery
 }
 
 func (m *mockStore) UpsertMetric(ctx context.Context, metric *domain.CanonicalMetric) error {
@@ -21,6 +27,12 @@ func (m *mockStore) UpsertMetric(ctx context.Context, metric *domain.CanonicalMe
 }
 
 func (m *mockStore) UpsertMetrics(ctx context.Context, metrics []*domain.CanonicalMetric) error {
+	m.metrics = append(m.metrics, metrics...)
+	return nil
+}
+
+func (m *mockStore) MergeMetrics(ctx context.Context, metrics []*domain.CanonicalMetric) error {
+	m.metrics = append(m.metrics, metrics...)
 	return nil
 }
 
@@ -42,6 +54,8 @@ func (m *mockStore) DeleteMetricsBySource(ctx context.Context, sourceName string
 }
 
 func (m *mockStore) Search(ctx context.Context, query store.SearchQuery) (*store.SearchResult, error) {
+	m.lastQuery = query
+
 	var results []*domain.CanonicalMetric
 	for _, metric := range m.metrics {
 		if len(query.InstrumentTypes) > 0 {
@@ -118,10 +132,41 @@ func (m *mockStore) GetExtractionRun(ctx context.Context, id string) (*store.Ext
 }
 
 func (m *mockStore) GetLatestExtractionRun(ctx context.Context, adapterName string) (*store.ExtractionRun, error) {
+	for _, run := range m.runs {
+		if run.AdapterName == adapterName {
+			return run, nil
+		}
+	}
 	return nil, nil
 }
 
-func (m *mockStore) GetSemconvMetrics(ctx context.Context) ([]*domain.CanonicalMetric, error) {
+func (m *mockStore) UpsertComponents(ctx context.Context, components []*domain.Component) error {
+	m.components = append(m.components, components...)
+	return nil
+}
+
+func (m *mockStore) ReplaceComponentMetrics(ctx context.Context, component *domain.Component, metrics []*domain.CanonicalMetric) error {
+	kept := m.metrics[:0]
+	for _, metric := range m.metrics {
+		if metric.SourceName != component.SourceName || metric.ComponentName != component.Name {
+			kept = append(kept, metric)
+		}
+	}
+	m.metrics = append(kept, metrics...)
+	m.components = append(m.components, component)
+	return nil
+}
+
+func (m *mockStore) GetComponent(ctx context.Context, componentType, name, sourceName string) (*domain.Component, error) {
+	for _, c := range m.components {
+		if string(c.Type) == componentType && c.Name == name && (sourceName == "" || c.SourceName == sourceName) {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockStore) GetSemconvMetrics(ctx context.Context, sourceNames ...string) ([]*domain.CanonicalMetric, error) {
 	return nil, nil
 }
 
@@ -212,6 +257,34 @@ func TestAPI_SearchMetrics_WithFilters(t *testing.T) {
 	}
 }
 
+func TestAPI_SearchMetrics_AggregationFilters(t *testing.T) {
+	ms := &mockStore{metrics: newTestMetrics()}
+	handler := NewHandler(ms)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/metrics?value_type=double&temporality=delta&monotonic=true&has_buckets=true", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	q := ms.lastQuery
+	if len(q.ValueTypes) != 1 || q.ValueTypes[0] != domain.ValueTypeDouble {
+		t.Errorf("expected value type filter [double], got %v", q.ValueTypes)
+	}
+	if len(q.AggregationTemporalities) != 1 || q.AggregationTemporalities[0] != domain.TemporalityDelta {
+		t.Errorf("expected temporality filter [delta], got %v", q.AggregationTemporalities)
+	}
+	if q.Monotonic == nil || !*q.Monotonic {
+		t.Errorf("expected monotonic filter true, got %v", q.Monotonic)
+	}
+	if !q.HasBucketBoundaries {
+		t.Error("expected has_buckets filter to be set")
+	}
+}
+
 func TestAPI_SearchMetrics_Pagination(t *testing.T) {
 	ms := &mockStore{metrics: newTestMetrics()}
 	handler := NewHandler(ms)
@@ -272,6 +345,169 @@ func TestAPI_GetMetric_NotFound(t *testing.T) {
 	}
 }
 
+func TestAPI_LintMetric(t *testing.T) {
+	metrics := append(newTestMetrics(), &domain.CanonicalMetric{
+		ID:             "metric3",
+		MetricName:     "queue_depth_total",
+		InstrumentType: domain.InstrumentGauge,
+		Description:    "Jobs waiting",
+		SourceCategory: domain.SourcePrometheus,
+		SourceName:     "exporter",
+	})
+	handler := NewHandler(&mockStore{metrics: metrics})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/metrics/metric3/lint", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	var result lint.Result
+	if err := json.NewDecoder(w.Body).Decode(&result); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if result.MetricID != "metric3" || result.Style != lint.StylePrometheus || result.Score != 0 {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if len(result.Violations) != 1 || result.Violations[0].Rule != "prom-total-suffix" || result.Violations[0].Severity != lint.SeverityError {
+		t.Errorf("unexpected violations %+v", result.Violations)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/metrics/nonexistent/lint", nil)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", w.Code)
+	}
+}
+
+func TestAPI_SearchMetrics_ComponentFilters(t *testing.T) {
+	ms := &mockStore{metrics: newTestMetrics()}
+	handler := NewHandler(ms)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/metrics?component_stability=beta&signal=event&attribute=k8s.pod.name&stability=stable&statistic=Sum", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	q := ms.lastQuery
+	if len(q.ComponentStabilities) != 1 || q.ComponentStabilities[0] != domain.StabilityBeta {
+		t.Errorf("expected component stability filter [beta], got %v", q.ComponentStabilities)
+	}
+	if len(q.Signals) != 1 || q.Signals[0] != domain.SignalKindEvent {
+		t.Errorf("expected signal filter [event], got %v", q.Signals)
+	}
+	if len(q.Stabilities) != 1 || q.Stabilities[0] != domain.StabilityStable {
+		t.Errorf("expected stability filter [stable], got %v", q.Stabilities)
+	}
+	if len(q.AttributeNames) != 1 || q.AttributeNames[0] != "k8s.pod.name" {
+		t.Errorf("expected attribute filter [k8s.pod.name], got %v", q.AttributeNames)
+	}
+	if len(q.Statistics) != 1 || q.Statistics[0] != "Sum" {
+		t.Errorf("expected statistic filter [Sum], got %v", q.Statistics)
+	}
+}
+
+func TestAPI_GetComponent(t *testing.T) {
+	ms := &mockStore{components: []*domain.Component{
+		{
+			Type:          domain.ComponentReceiver,
+			Name:          "mysqlreceiver",
+			SourceName:    "otel-collector-contrib",
+			Stability:     map[string]domain.StabilityLevel{"metrics": domain.StabilityBeta},
+			Distributions: []string{"contrib"},
+			Warnings: []domain.ComponentWarning{
+				{Metric: "mysql.commands", Kind: "if_enabled_not_set", Message: "Will be enabled by default"},
+			},
+		},
+	}}
+	handler := NewHandler(ms)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/components/receiver/mysqlreceiver", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	var component domain.Component
+	if err := json.NewDecoder(w.Body).Decode(&component); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if component.Stability["metrics"] != domain.StabilityBeta {
+		t.Errorf("unexpected stability: %v", component.Stability)
+	}
+	if len(component.Warnings) != 1 || component.Warnings[0].Kind != "if_enabled_not_set" {
+		t.Errorf("unexpected warnings: %v", component.Warnings)
+	}
+}
+
+func TestAPI_GetComponent_NotFound(t *testing.T) {
+	ms := &mockStore{}
+	handler := NewHandler(ms)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/components/receiver/nonexistent", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", w.Code)
+	}
+}
+
+func TestAPI_ListAdapters(t *testing.T) {
+	adapter.Register(adapter.Registration{
+		Name:        "api-test-adapter",
+		Category:    domain.SourceVendor,
+		Description: "Registered by the API tests",
+		Schedule:    adapter.ScheduleDaily,
+		Tags:        []string{"test"},
+		New:         func(adapter.Options) (adapter.Adapter, error) { return nil, nil },
+	})
+	handler := NewHandler(&mockStore{})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/adapters", nil)
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+
+	var resp AdaptersResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	var found *adapter.Registration
+	for i := range resp.Adapters {
+		if resp.Adapters[i].Name == "api-test-adapter" {
+			found = &resp.Adapters[i]
+		}
+	}
+	if found == nil {
+		t.Fatalf("test adapter not listed: %v", resp.Adapters)
+	}
+	if found.Category != domain.SourceVendor || found.Schedule != adapter.ScheduleDaily {
+		t.Errorf("unexpected registration: %+v", found)
+	}
+	if len(found.Tags) != 1 || found.Tags[0] != "test" {
+		t.Errorf("unexpected tags: %v", found.Tags)
+	}
+}
+
 func TestAPI_GetFacets(t *testing.T) {
 	ms := &mockStore{metrics: newTestMetrics()}
 	handler := NewHandler(ms)
@@ -308,3 +544,245 @@ func TestAPI_HealthCheck(t *testing.T) {
 		t.Errorf("expected status 200, got %d", w.Code)
 	}
 }
+
+func TestAPI_RequiresToken(t *testing.T) {
+	handler := NewHandlerWithOptions(&mockStore{metrics: newTestMetrics()}, Options{Tokens: []string{"secret"}})
+
+	tests := []struct {
+		name          string
+		path          string
+		authorization string
+		want          int
+	}{
+		{"no token", "/api/metrics", "", http.StatusUnauthorized},
+		{"wrong token", "/api/metrics", "Bearer nope", http.StatusUnauthorized},
+		{"valid token", "/api/metrics", "Bearer secret", http.StatusOK},
+		{"health is open", "/health", "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestAPI_CacheControl(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		tokens       []string
+		cacheControl string
+		vary         string
+	}{
+		{"open", "/api/metrics", nil, "public, max-age=86400", ""},
+		{"behind tokens", "/api/metrics", []string{"secret"}, "private, max-age=86400", "Authorization"},
+		{"lint", "/api/metrics/metric3/lint", nil, "public, max-age=300", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewHandlerWithOptions(&mockStore{metrics: newTestMetrics()}, Options{Tokens: tt.tokens})
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer secret")
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if got := w.Header().Get("Cache-Control"); got != tt.cacheControl {
+				t.Errorf("expected Cache-Control %q, got %q", tt.cacheControl, got)
+			}
+			if got := w.Header().Get("Vary"); got != tt.vary {
+				t.Errorf("expected Vary %q, got %q", tt.vary, got)
+			}
+		})
+	}
+}
+
+func TestRequireWriteToken(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusAccepted) })
+
+	tests := []struct {
+		name          string
+		tokens        []string
+		authorization string
+		want          int
+	}{
+		{"no write tokens", nil, "Bearer writer", http.StatusForbidden},
+		{"no token", []string{"writer"}, "", http.StatusUnauthorized},
+		{"valid token", []string{"writer"}, "Bearer writer", http.StatusAccepted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/v1/metrics", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			RequireWriteToken(tt.tokens, ok).ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d", tt.want, w.Code)
+			}
+		})
+	}
+}
+
+func TestAPI_AllowedOrigins(t *testing.T) {
+	handler := NewHandlerWithOptions(&mockStore{}, Options{AllowedOrigins: []string{"https://play.base14.io"}})
+
+	for origin, want := range map[string]string{
+		"https://play.base14.io": "https://play.base14.io",
+		"https://evil.example":   "",
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/health", nil)
+		req.Header.Set("Origin", origin)
+		w := httptest.NewRecorder()
+
+		handler.ServeHTTP(w, req)
+
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != want {
+			t.Errorf("origin %s: expected allow-origin %q, got %q", origin, want, got)
+		}
+	}
+
+	w := httptest.NewRecorder()
+	NewHandler(&mockStore{}).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+	if got := w.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("expected any origin by default, got %q", got)
+	}
+}
+
+const checkoutMetadata = `
+type: checkout
+status:
+  codeowners:
+    active: [payments-team]
+metrics:
+  checkout.orders:
+    enabled: true
+    description: Orders placed
+    unit: "{order}"
+    sum:
+      value_type: int
+      monotonic: true
+      aggregation_temporality: cumulative
+`
+
+func TestAPI_SubmitCatalogMetrics(t *testing.T) {
+	ms := &mockStore{}
+	handler := NewHandlerWithOptions(ms, Options{WriteTokens: []string{"writer"}})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/catalogs/shop/metrics?repo=https://github.com/example/checkout", strings.NewReader(checkoutMetadata))
+	req.Header.Set("Authorization", "Bearer writer")
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", w.Code, w.Body.String())
+	}
+
+	var resp CatalogResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Catalog != "shop" || resp.Component != "checkout" || resp.MetricsStored != 1 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	if len(ms.metrics) != 1 {
+		t.Fatalf("expected 1 stored metric, got %d", len(ms.metrics))
+	}
+	m := ms.metrics[0]
+	if m.SourceCategory != domain.SourceInternal || m.Owner != "payments-team" || m.Repo != "https://github.com/example/checkout" {
+		t.Errorf("unexpected metric: %+v", m)
+	}
+	if len(ms.components) != 1 || ms.components[0].Type != domain.ComponentService {
+		t.Errorf("expected a service component, got %v", ms.components)
+	}
+}
+
+func TestAPI_SubmitCatalogMetrics_ReplacesComponent(t *testing.T) {
+	ms := &mockStore{metrics: []*domain.CanonicalMetric{
+		{MetricName: "checkout.retired", SourceName: "shop", ComponentName: "checkout"},
+		{MetricName: "cart.items", SourceName: "shop", ComponentName: "cart"},
+	}}
+	handler := NewHandlerWithOptions(ms, Options{WriteTokens: []string{"writer"}})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/catalogs/shop/metrics", strings.NewReader(checkoutMetadata))
+	req.Header.Set("Authorization", "Bearer writer")
+	w := httptest.NewRecorder()
+
+	handler.ServeHTTP(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", w.Code, w.Body.String())
+	}
+	names := make([]string, 0, len(ms.metrics))
+	for _, m := range ms.metrics {
+		names = append(names, m.MetricName)
+	}
+	if len(names) != 2 || names[0] != "cart.items" || names[1] != "checkout.orders" {
+		t.Errorf("expected the checkout metrics replaced and cart's kept, got %v", names)
+	}
+}
+
+func TestAPI_SubmitCatalogMetrics_Rejected(t *testing.T) {
+	adapter.Register(adapter.Registration{
+		Name: "api-test-catalog-clash",
+		New:  func(adapter.Options) (adapter.Adapter, error) { return nil, nil },
+	})
+
+	tests := []struct {
+		name          string
+		opts          Options
+		path          string
+		body          string
+		authorization string
+		want          int
+	}{
+		{"disabled without write tokens", Options{}, "/api/catalogs/shop/metrics", checkoutMetadata, "", http.StatusForbidden},
+		{"missing token", Options{WriteTokens: []string{"writer"}}, "/api/catalogs/shop/metrics", checkoutMetadata, "", http.StatusUnauthorized},
+		{"read token", Options{Tokens: []string{"reader"}, WriteTokens: []string{"writer"}}, "/api/catalogs/shop/metrics", checkoutMetadata, "Bearer reader", http.StatusUnauthorized},
+		{"adapter name", Options{WriteTokens: []string{"writer"}}, "/api/catalogs/api-test-catalog-clash/metrics", checkoutMetadata, "Bearer writer", http.StatusConflict},
+		{"extracted source", Options{WriteTokens: []string{"writer"}}, "/api/catalogs/acme-semconv/metrics", checkoutMetadata, "Bearer writer", http.StatusConflict},
+		{"reserved source", Options{WriteTokens: []string{"writer"}, ReservedSources: []string{"fleet"}}, "/api/catalogs/fleet/metrics", checkoutMetadata, "Bearer writer", http.StatusConflict},
+		{"no metrics", Options{WriteTokens: []string{"writer"}}, "/api/catalogs/shop/metrics", "type: checkout\n", "Bearer writer", http.StatusBadRequest},
+		{"bad category", Options{WriteTokens: []string{"writer"}}, "/api/catalogs/shop/metrics?category=cloud", checkoutMetadata, "Bearer writer", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms := &mockStore{runs: []*store.ExtractionRun{{AdapterName: "acme-semconv"}}}
+			handler := NewHandlerWithOptions(ms, tt.opts)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body))
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			if w.Code != tt.want {
+				t.Errorf("expected status %d, got %d: %s", tt.want, w.Code, w.Body.String())
+			}
+			if len(ms.metrics) != 0 {
+				t.Errorf("expected nothing stored, got %d metrics", len(ms.metrics))
+			}
+		})
+	}
+}
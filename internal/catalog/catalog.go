@@ -0,0 +1,113 @@
+// Package catalog turns the metric definitions teams submit for their own
+// services, written in the collector's metadata.yaml schema, into catalog
+// entries.
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/base-14/metric-library/internal/extractor"
+	"github.com/base-14/metric-library/internal/parser"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+var (
+	ErrInvalidName     = errors.New("catalog names are lowercase letters, digits, dots, dashes and underscores")
+	ErrInvalidCategory = errors.New("catalog category must be internal or vendor")
+	ErrNoComponent     = errors.New("a component name is required when the metadata has no type")
+	ErrNoMetrics       = errors.New("the metadata defines no metrics or events")
+)
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// Submission describes where submitted metadata goes.
+type Submission struct {
+	// Catalog is the source name the metrics are stored under
+	Catalog string
+	// Component defaults to the metadata's type
+	Component string
+	// Category is SourceInternal, the default, or SourceVendor
+	Category domain.SourceCategory
+	// Owner defaults to the first active codeowner
+	Owner string
+	Repo  string
+}
+
+// Entries are the metrics and component record built from a submission.
+type Entries struct {
+	Metrics   []*domain.CanonicalMetric
+	Component *domain.Component
+}
+
+// Build parses content, YAML or JSON in the metadata.yaml schema, into
+// catalog entries. Metrics from a team describing its own service are taken
+// as authoritative; vendor submissions are vendor claimed.
+func Build(content []byte, sub Submission) (*Entries, error) {
+	if !namePattern.MatchString(sub.Catalog) {
+		return nil, ErrInvalidName
+	}
+
+	confidence := domain.ConfidenceAuthoritative
+	switch sub.Category {
+	case "", domain.SourceInternal:
+		sub.Category = domain.SourceInternal
+	case domain.SourceVendor:
+		confidence = domain.ConfidenceVendorClaimed
+	default:
+		return nil, ErrInvalidCategory
+	}
+
+	meta, err := parser.NewMetadataParser().Parse(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(meta.Metrics) == 0 && len(meta.Events) == 0 && len(meta.Telemetry.Metrics) == 0 {
+		return nil, ErrNoMetrics
+	}
+
+	if sub.Component == "" {
+		sub.Component = meta.Type
+	}
+	if sub.Component == "" {
+		return nil, ErrNoComponent
+	}
+	if sub.Owner == "" && len(meta.Status.Codeowners.Active) > 0 {
+		sub.Owner = meta.Status.Codeowners.Active[0]
+	}
+
+	ext := extractor.NewMetricExtractor(sub.Catalog, sub.Component, string(domain.ComponentService))
+	metrics, err := ext.Extract(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	submittedAt := time.Now()
+	for _, m := range metrics {
+		m.SourceCategory = sub.Category
+		m.SourceConfidence = confidence
+		m.Repo = sub.Repo
+		m.Owner = sub.Owner
+		m.ExtractedAt = submittedAt
+		// The extractor's ID assumed the OTel category
+		m.ID = ""
+		m.EnsureID()
+		if err := m.Validate(); err != nil {
+			return nil, fmt.Errorf("%s: %w", m.MetricName, err)
+		}
+	}
+
+	component := ext.ExtractComponent(meta)
+	component.Repo = sub.Repo
+	component.ExtractedAt = submittedAt
+	if len(component.Codeowners) == 0 && sub.Owner != "" {
+		component.Codeowners = []string{sub.Owner}
+	}
+	if err := component.Validate(); err != nil {
+		return nil, fmt.Errorf("status: %w", err)
+	}
+
+	return &Entries{Metrics: metrics, Component: component}, nil
+}
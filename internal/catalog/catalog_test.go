@@ -0,0 +1,142 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+const checkoutMetadata = `
+type: checkout
+status:
+  class: service
+  stability:
+    beta: [metrics]
+  codeowners:
+    active: [payments-team, oncall]
+attributes:
+  payment.method:
+    description: How the order was paid
+    type: string
+    enum: [card, wallet]
+metrics:
+  checkout.orders:
+    enabled: true
+    description: Orders placed
+    unit: "{order}"
+    sum:
+      value_type: int
+      monotonic: true
+      aggregation_temporality: cumulative
+    attributes: [payment.method]
+  checkout.latency:
+    enabled: true
+    description: Time to place an order
+    unit: ms
+    histogram:
+      value_type: double
+`
+
+func TestBuild(t *testing.T) {
+	entries, err := Build([]byte(checkoutMetadata), Submission{
+		Catalog: "shop",
+		Repo:    "https://github.com/example/checkout",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(entries.Metrics) != 2 {
+		t.Fatalf("expected 2 metrics, got %d", len(entries.Metrics))
+	}
+
+	byName := make(map[string]*domain.CanonicalMetric)
+	for _, m := range entries.Metrics {
+		byName[m.MetricName] = m
+	}
+
+	orders := byName["checkout.orders"]
+	if orders == nil {
+		t.Fatal("checkout.orders missing")
+	}
+	if orders.SourceName != "shop" || orders.SourceCategory != domain.SourceInternal {
+		t.Errorf("unexpected source: %s / %s", orders.SourceName, orders.SourceCategory)
+	}
+	if orders.ComponentType != domain.ComponentService || orders.ComponentName != "checkout" {
+		t.Errorf("unexpected component: %s / %s", orders.ComponentType, orders.ComponentName)
+	}
+	if orders.Owner != "payments-team" {
+		t.Errorf("expected owner from codeowners, got %q", orders.Owner)
+	}
+	if orders.Repo != "https://github.com/example/checkout" {
+		t.Errorf("unexpected repo: %s", orders.Repo)
+	}
+	if orders.SourceConfidence != domain.ConfidenceAuthoritative {
+		t.Errorf("unexpected confidence: %s", orders.SourceConfidence)
+	}
+	if orders.InstrumentType != domain.InstrumentCounter {
+		t.Errorf("unexpected instrument type: %s", orders.InstrumentType)
+	}
+	if len(orders.Attributes) != 1 || len(orders.Attributes[0].Enum) != 2 {
+		t.Errorf("unexpected attributes: %+v", orders.Attributes)
+	}
+	if orders.ID != orders.GenerateID() {
+		t.Error("expected the ID to reflect the catalog category")
+	}
+
+	if entries.Component.Type != domain.ComponentService || entries.Component.Stability["metrics"] != domain.StabilityBeta {
+		t.Errorf("unexpected component: %+v", entries.Component)
+	}
+}
+
+func TestBuild_JSONAndOverrides(t *testing.T) {
+	content := `{"metrics": {"acme.queue.depth": {"enabled": true, "description": "Queued jobs", "unit": "{job}", "gauge": {"value_type": "int"}}}}`
+
+	entries, err := Build([]byte(content), Submission{
+		Catalog:   "acme",
+		Component: "acme-agent",
+		Category:  domain.SourceVendor,
+		Owner:     "acme-support",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	m := entries.Metrics[0]
+	if m.ComponentName != "acme-agent" || m.Owner != "acme-support" {
+		t.Errorf("unexpected component or owner: %s / %s", m.ComponentName, m.Owner)
+	}
+	if m.SourceCategory != domain.SourceVendor || m.SourceConfidence != domain.ConfidenceVendorClaimed {
+		t.Errorf("unexpected category or confidence: %s / %s", m.SourceCategory, m.SourceConfidence)
+	}
+	if len(entries.Component.Codeowners) != 1 || entries.Component.Codeowners[0] != "acme-support" {
+		t.Errorf("expected the owner as codeowner, got %v", entries.Component.Codeowners)
+	}
+}
+
+func TestBuild_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		sub     Submission
+		want    error
+	}{
+		{"bad name", checkoutMetadata, Submission{Catalog: "Shop Team"}, ErrInvalidName},
+		{"bad category", checkoutMetadata, Submission{Catalog: "shop", Category: domain.SourceCloud}, ErrInvalidCategory},
+		{"no metrics", "type: checkout\n", Submission{Catalog: "shop"}, ErrNoMetrics},
+		{"no component", "metrics:\n  a.b:\n    gauge: {}\n", Submission{Catalog: "shop"}, ErrNoComponent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Build([]byte(tt.content), tt.sub); !errors.Is(err, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+
+	if _, err := Build([]byte("metrics: ["), Submission{Catalog: "shop"}); err == nil {
+		t.Error("expected a parse error")
+	}
+}
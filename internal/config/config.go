@@ -0,0 +1,301 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/base-14/metric-library/internal/lint"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// DefaultFile is read when no config path is given and it exists.
+const DefaultFile = "glossary.yaml"
+
+// Config is the glossary.yaml file. Every setting has a default, so an empty
+// file, or none, is a valid config.
+type Config struct {
+	Server     Server                   `yaml:"server"`
+	Adapters   map[string]AdapterConfig `yaml:"adapters"`
+	Enrichment Enrichment               `yaml:"enrichment"`
+	Auth       Auth                     `yaml:"auth"`
+	CORS       CORS                     `yaml:"cors"`
+	Lint       Lint                     `yaml:"lint"`
+
+	// File is where the config was read from, empty for defaults only
+	File string `yaml:"-"`
+}
+
+type Server struct {
+	Port         string `yaml:"port"`
+	DatabasePath string `yaml:"database_path"`
+	CacheDir     string `yaml:"cache_dir"`
+	AdaptersDir  string `yaml:"adapters_dir"`
+	// OTLPReceiverAddr starts the OTLP/HTTP receiver when set. Pushes write
+	// to the catalog, so they need one of the auth write tokens
+	OTLPReceiverAddr string `yaml:"otlp_receiver_addr"`
+	OTLPSourceName   string `yaml:"otlp_source_name"`
+}
+
+// AdapterConfig tunes one adapter. Adapters without an entry are enabled
+// with their registered defaults.
+type AdapterConfig struct {
+	Enabled *bool `yaml:"enabled"`
+	// Ref pins the source to a commit, tag or branch
+	Ref string `yaml:"ref"`
+	// Schedule overrides the registered refresh schedule, as a cron
+	// expression
+	Schedule string `yaml:"schedule"`
+	// Include and Exclude are component name globs; a component must match
+	// an Include pattern, when there are any, and no Exclude pattern. The
+	// metric and path lists work the same way on metric names and source
+	// files, and ** in a pattern spans slashes.
+	Include        []string `yaml:"include"`
+	Exclude        []string `yaml:"exclude"`
+	IncludeMetrics []string `yaml:"include_metrics"`
+	ExcludeMetrics []string `yaml:"exclude_metrics"`
+	IncludePaths   []string `yaml:"include_paths"`
+	ExcludePaths   []string `yaml:"exclude_paths"`
+	// EnabledByDefault keeps only metrics whose enabled by default flag
+	// equals it
+	EnabledByDefault *bool `yaml:"enabled_by_default"`
+}
+
+// Patterns lists every glob the adapter's filters use.
+func (a AdapterConfig) Patterns() []string {
+	return slices.Concat(a.Include, a.Exclude, a.IncludeMetrics, a.ExcludeMetrics, a.IncludePaths, a.ExcludePaths)
+}
+
+// IsEnabled reports whether the adapter may run.
+func (a AdapterConfig) IsEnabled() bool {
+	return a.Enabled == nil || *a.Enabled
+}
+
+type Enrichment struct {
+	// AfterExtract runs semconv enrichment at the end of every extract
+	AfterExtract bool `yaml:"after_extract"`
+	// DefaultStability is assumed for semconv metrics that don't state one
+	DefaultStability domain.StabilityLevel `yaml:"default_stability"`
+	// Registries are the source names of imported Weaver registries matched
+	// alongside the OpenTelemetry semantic conventions
+	Registries []string `yaml:"registries"`
+}
+
+// Auth protects the API. With no tokens the API is open to reads;
+// catalog submissions need one of the write tokens and are refused when
+// there are none.
+type Auth struct {
+	Tokens      []string `yaml:"tokens"`
+	WriteTokens []string `yaml:"write_tokens"`
+}
+
+type CORS struct {
+	AllowedOrigins []string `yaml:"allowed_origins"`
+}
+
+// Lint overrides rule severities, keyed by rule ID; off disables a rule.
+type Lint struct {
+	Rules map[string]lint.Severity `yaml:"rules"`
+}
+
+// Default returns the settings used when nothing overrides them.
+func Default() *Config {
+	return &Config{
+		Server: Server{
+			Port:           "8080",
+			DatabasePath:   "./data/metric-library.db",
+			CacheDir:       "./.cache",
+			AdaptersDir:    "./adapters.d",
+			OTLPSourceName: "otlp",
+		},
+		Adapters: map[string]AdapterConfig{},
+		Enrichment: Enrichment{
+			DefaultStability: domain.StabilityStable,
+		},
+		CORS: CORS{AllowedOrigins: []string{"*"}},
+	}
+}
+
+// Load reads the config in file over the defaults, then applies the
+// environment. An empty file reads $GLOSSARY_CONFIG, or glossary.yaml when
+// it exists; a file that was asked for must exist.
+func Load(file string) (*Config, error) {
+	cfg := Default()
+
+	if file == "" {
+		file = os.Getenv("GLOSSARY_CONFIG")
+	}
+	if file == "" {
+		if _, err := os.Stat(DefaultFile); err == nil {
+			file = DefaultFile
+		}
+	}
+
+	if file != "" {
+		data, err := os.ReadFile(filepath.Clean(file))
+		if err != nil {
+			return nil, err
+		}
+		if err := cfg.parse(data); err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		cfg.File = file
+	}
+
+	cfg.ApplyEnv(os.Getenv)
+	return cfg, nil
+}
+
+// parse overlays YAML onto the config, rejecting unknown keys so that a
+// misspelt setting isn't silently ignored.
+func (c *Config) parse(data []byte) error {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	if c.Adapters == nil {
+		c.Adapters = map[string]AdapterConfig{}
+	}
+	return nil
+}
+
+// ApplyEnv overrides file settings with the environment variables the
+// binary has always read, plus CORS_ORIGINS, API_TOKENS and
+// API_WRITE_TOKENS as comma separated lists.
+func (c *Config) ApplyEnv(getenv func(string) string) {
+	for env, field := range map[string]*string{
+		"PORT":               &c.Server.Port,
+		"DATABASE_PATH":      &c.Server.DatabasePath,
+		"CACHE_DIR":          &c.Server.CacheDir,
+		"ADAPTERS_DIR":       &c.Server.AdaptersDir,
+		"OTLP_RECEIVER_ADDR": &c.Server.OTLPReceiverAddr,
+		"OTLP_SOURCE_NAME":   &c.Server.OTLPSourceName,
+	} {
+		if v := getenv(env); v != "" {
+			*field = v
+		}
+	}
+	if v := getenv("CORS_ORIGINS"); v != "" {
+		c.CORS.AllowedOrigins = splitList(v)
+	}
+	if v := getenv("API_TOKENS"); v != "" {
+		c.Auth.Tokens = splitList(v)
+	}
+	if v := getenv("API_WRITE_TOKENS"); v != "" {
+		c.Auth.WriteTokens = splitList(v)
+	}
+}
+
+// Adapter returns the settings for the named adapter.
+func (c *Config) Adapter(name string) AdapterConfig {
+	return c.Adapters[name]
+}
+
+// Validate reports every problem in the config. known, when set, says
+// whether an adapter name can be built.
+func (c *Config) Validate(known func(name string) bool) error {
+	var errs []error
+
+	if port, err := strconv.Atoi(c.Server.Port); err != nil || port < 1 || port > 65535 {
+		errs = append(errs, fmt.Errorf("server.port: %q is not a port", c.Server.Port))
+	}
+	if c.Server.DatabasePath == "" {
+		errs = append(errs, errors.New("server.database_path is required"))
+	}
+	if c.Server.OTLPReceiverAddr != "" && len(c.Auth.WriteTokens) == 0 {
+		errs = append(errs, errors.New("server.otlp_receiver_addr: the receiver writes to the catalog and needs auth.write_tokens"))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(c.Adapters)) {
+		a := c.Adapters[name]
+		if known != nil && !known(name) {
+			errs = append(errs, fmt.Errorf("adapters.%s: unknown adapter", name))
+		}
+		if a.Ref != "" && !ValidRef(a.Ref) {
+			errs = append(errs, fmt.Errorf("adapters.%s.ref: %q is not a commit, tag or branch", name, a.Ref))
+		}
+		if a.Schedule != "" && !ValidSchedule(a.Schedule) {
+			errs = append(errs, fmt.Errorf("adapters.%s.schedule: %q is not a cron expression", name, a.Schedule))
+		}
+		for _, pattern := range a.Patterns() {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errs = append(errs, fmt.Errorf("adapters.%s: bad glob %q", name, pattern))
+			}
+		}
+	}
+
+	if !c.Enrichment.DefaultStability.IsValid() {
+		errs = append(errs, fmt.Errorf("enrichment.default_stability: unknown stability %q", c.Enrichment.DefaultStability))
+	}
+	for _, token := range slices.Concat(c.Auth.Tokens, c.Auth.WriteTokens) {
+		if strings.TrimSpace(token) == "" {
+			errs = append(errs, errors.New("auth.tokens: empty token"))
+		}
+	}
+	if _, err := lint.New(c.Lint.Rules); err != nil {
+		errs = append(errs, fmt.Errorf("lint.rules: %w", err))
+	}
+	for _, origin := range c.CORS.AllowedOrigins {
+		if origin != "*" && !strings.HasPrefix(origin, "http://") && !strings.HasPrefix(origin, "https://") {
+			errs = append(errs, fmt.Errorf("cors.allowed_origins: %q is not an origin", origin))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+var cronField = regexp.MustCompile(`^[0-9*,/-]+$`)
+
+// ValidSchedule accepts five-field cron expressions and the @hourly,
+// @daily, @weekly and @monthly shorthands.
+func ValidSchedule(schedule string) bool {
+	switch schedule {
+	case "@hourly", "@daily", "@weekly", "@monthly":
+		return true
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return false
+	}
+	for _, f := range fields {
+		if !cronField.MatchString(f) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidRef applies git's ref name rules, which commit hashes also pass: no
+// whitespace, control or glob characters, no "..", and no leading dash.
+func ValidRef(ref string) bool {
+	if strings.HasPrefix(ref, "-") || strings.HasPrefix(ref, "/") || strings.HasSuffix(ref, "/") ||
+		strings.HasSuffix(ref, ".") || strings.HasSuffix(ref, ".lock") ||
+		strings.Contains(ref, "..") || strings.Contains(ref, "@{") || strings.Contains(ref, "//") {
+		return false
+	}
+	return !strings.ContainsFunc(ref, func(r rune) bool {
+		return r <= ' ' || r == 0x7f || strings.ContainsRune("~^:?*[\\", r)
+	})
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,204 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/base-14/metric-library/internal/lint"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "glossary.yaml")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad_OverlaysDefaults(t *testing.T) {
+	path := writeConfig(t, `
+server:
+  port: "9090"
+adapters:
+  otel-collector-contrib:
+    ref: v0.115.0
+    include: ["*receiver"]
+    exclude_paths: ["**/testdata/**"]
+    enabled_by_default: true
+  codingagent-gemini:
+    enabled: false
+enrichment:
+  after_extract: true
+  registries: [acme-semconv]
+cors:
+  allowed_origins: ["https://play.base14.io"]
+lint:
+  rules:
+    prom-counter-total: off
+`)
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.File != path {
+		t.Errorf("expected file %s, got %s", path, cfg.File)
+	}
+	if cfg.Server.Port != "9090" {
+		t.Errorf("expected port from file, got %s", cfg.Server.Port)
+	}
+	if cfg.Server.DatabasePath != "./data/metric-library.db" {
+		t.Errorf("expected default database path, got %s", cfg.Server.DatabasePath)
+	}
+	if cfg.Enrichment.DefaultStability != domain.StabilityStable || !cfg.Enrichment.AfterExtract || len(cfg.Enrichment.Registries) != 1 {
+		t.Errorf("unexpected enrichment: %+v", cfg.Enrichment)
+	}
+	if cfg.Lint.Rules["prom-counter-total"] != lint.SeverityOff {
+		t.Errorf("expected rule turned off, got %v", cfg.Lint.Rules)
+	}
+	if len(cfg.CORS.AllowedOrigins) != 1 || cfg.CORS.AllowedOrigins[0] != "https://play.base14.io" {
+		t.Errorf("expected file origins to replace the default, got %v", cfg.CORS.AllowedOrigins)
+	}
+
+	contrib := cfg.Adapter("otel-collector-contrib")
+	if contrib.Ref != "v0.115.0" || !contrib.IsEnabled() || len(contrib.Include) != 1 {
+		t.Errorf("unexpected adapter config: %+v", contrib)
+	}
+	if len(contrib.ExcludePaths) != 1 || contrib.EnabledByDefault == nil || !*contrib.EnabledByDefault {
+		t.Errorf("unexpected adapter filters: %+v", contrib)
+	}
+	if cfg.Adapter("codingagent-gemini").IsEnabled() {
+		t.Error("expected codingagent-gemini to be disabled")
+	}
+	if !cfg.Adapter("unlisted").IsEnabled() {
+		t.Error("expected adapters without an entry to be enabled")
+	}
+}
+
+func TestLoad_RejectsUnknownKeys(t *testing.T) {
+	path := writeConfig(t, "server:\n  prot: 9090\n")
+
+	if _, err := Load(path); err == nil {
+		t.Error("expected an error for a misspelt key")
+	}
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	if _, err := Load(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("expected an error for a config file that doesn't exist")
+	}
+}
+
+func TestLoad_EmptyFile(t *testing.T) {
+	cfg, err := Load(writeConfig(t, ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := cfg.Validate(nil); err != nil {
+		t.Errorf("expected defaults to validate, got %v", err)
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	cfg := Default()
+	env := map[string]string{
+		"PORT":          "9000",
+		"DATABASE_PATH": "/app/data/metric-library.db",
+		"CORS_ORIGINS":  "https://a.example, https://b.example",
+		"API_TOKENS":    "secret",
+	}
+	cfg.ApplyEnv(func(key string) string { return env[key] })
+
+	if cfg.Server.Port != "9000" || cfg.Server.DatabasePath != "/app/data/metric-library.db" {
+		t.Errorf("expected env to override server settings, got %+v", cfg.Server)
+	}
+	if cfg.Server.CacheDir != "./.cache" {
+		t.Errorf("expected unset env to keep the default, got %s", cfg.Server.CacheDir)
+	}
+	if len(cfg.CORS.AllowedOrigins) != 2 || cfg.CORS.AllowedOrigins[1] != "https://b.example" {
+		t.Errorf("unexpected origins: %v", cfg.CORS.AllowedOrigins)
+	}
+	if len(cfg.Auth.Tokens) != 1 || cfg.Auth.Tokens[0] != "secret" {
+		t.Errorf("unexpected tokens: %v", cfg.Auth.Tokens)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := Default()
+	cfg.Server.Port = "http"
+	cfg.Adapters["otel-collector-contrib"] = AdapterConfig{Schedule: "daily", Include: []string{"[receiver"}}
+	cfg.Adapters["no-such-adapter"] = AdapterConfig{ExcludeMetrics: []string{"go_[*"}, Ref: "--upload-pack=x"}
+	cfg.Enrichment.DefaultStability = "solid"
+	cfg.CORS.AllowedOrigins = []string{"play.base14.io"}
+	cfg.Lint.Rules = map[string]lint.Severity{"no-such-rule": lint.SeverityError}
+	cfg.Server.OTLPReceiverAddr = ":4318"
+
+	err := cfg.Validate(func(name string) bool { return name == "otel-collector-contrib" })
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+
+	for _, want := range []string{
+		"server.port",
+		"adapters.no-such-adapter: unknown adapter",
+		"adapters.otel-collector-contrib.schedule",
+		`bad glob "[receiver"`,
+		`adapters.no-such-adapter: bad glob "go_[*"`,
+		"adapters.no-such-adapter.ref",
+		"enrichment.default_stability",
+		"cors.allowed_origins",
+		"lint.rules: unknown lint rule: no-such-rule",
+		"server.otlp_receiver_addr",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in %v", want, err)
+		}
+	}
+}
+
+func TestValidRef(t *testing.T) {
+	tests := []struct {
+		ref   string
+		valid bool
+	}{
+		{"v0.112.0", true},
+		{"release/v1.2", true},
+		{"3f2c1a9e8b7d6c5f4e3d2c1b0a9f8e7d6c5b4a39", true},
+		{"-main", false},
+		{"v1..v2", false},
+		{"main branch", false},
+		{"HEAD~1", false},
+		{"release/", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidRef(tt.ref); got != tt.valid {
+			t.Errorf("ValidRef(%q) = %v, want %v", tt.ref, got, tt.valid)
+		}
+	}
+}
+
+func TestValidSchedule(t *testing.T) {
+	tests := []struct {
+		schedule string
+		valid    bool
+	}{
+		{"0 2 * * *", true},
+		{"*/15 * * * 1-5", true},
+		{"@weekly", true},
+		{"0 2 * *", false},
+		{"0 2 * * MON", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := ValidSchedule(tt.schedule); got != tt.valid {
+			t.Errorf("ValidSchedule(%q) = %v, want %v", tt.schedule, got, tt.valid)
+		}
+	}
+}
@@ -1,19 +1,41 @@
 package discovery
 
 import (
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"gopkg.in/yaml.v3"
 )
 
+// packageComponentType is used for metadata under shared package roots,
+// which isn't tied to a pipeline component and mostly declares self-telemetry.
+const packageComponentType = "telemetry"
+
 type MetadataFile struct {
 	Path          string
 	ComponentName string
 	ComponentType string
+	// Subcomponent names the directory of a metadata.yaml nested below its
+	// component, e.g. "cpuscraper" under hostmetricsreceiver. Empty for the
+	// component's own metadata.
+	Subcomponent string
+}
+
+// nesting holds the mdatagen fields that tell a subcomponent, such as a
+// hostmetrics scraper, from a component kept in a grouping directory like
+// extension/observer.
+type nesting struct {
+	Parent string `yaml:"parent"`
+	Status struct {
+		Class string `yaml:"class"`
+	} `yaml:"status"`
 }
 
 type MetadataDiscovery struct {
 	componentDirs []string
+	packageDirs   []string
 }
 
 func NewMetadataDiscovery() *MetadataDiscovery {
@@ -25,6 +47,12 @@ func NewMetadataDiscovery() *MetadataDiscovery {
 			"extension",
 			"connector",
 		},
+		packageDirs: []string{
+			"pkg",
+			"internal",
+			"scraper",
+			"service",
+		},
 	}
 }
 
@@ -32,34 +60,91 @@ func (d *MetadataDiscovery) FindMetadataFiles(repoPath string) ([]MetadataFile,
 	var files []MetadataFile
 
 	for _, componentDir := range d.componentDirs {
-		dirPath := filepath.Join(repoPath, componentDir)
+		files = append(files, d.walk(repoPath, componentDir, componentDir)...)
+	}
+	for _, packageDir := range d.packageDirs {
+		files = append(files, d.walk(repoPath, packageDir, packageComponentType)...)
+	}
 
-		if _, err := os.Stat(dirPath); os.IsNotExist(err) {
-			continue
-		}
+	return files, nil
+}
 
-		entries, err := os.ReadDir(dirPath)
+// walk finds every metadata.yaml below root. Files directly under a
+// component directory belong to it; deeper ones are subcomponents of that
+// directory when they name a parent or a class other than componentType,
+// and components of their own otherwise.
+func (d *MetadataDiscovery) walk(repoPath, root, componentType string) []MetadataFile {
+	dirPath := filepath.Join(repoPath, root)
+	if _, err := os.Stat(dirPath); os.IsNotExist(err) {
+		return nil
+	}
+
+	var files []MetadataFile
+	_ = filepath.WalkDir(dirPath, func(path string, entry fs.DirEntry, err error) error {
 		if err != nil {
-			continue
+			return nil
 		}
-
-		for _, entry := range entries {
-			if !entry.IsDir() {
-				continue
+		if entry.IsDir() {
+			if path != dirPath && skipDir(entry.Name()) {
+				return filepath.SkipDir
 			}
+			return nil
+		}
+		if entry.Name() != "metadata.yaml" {
+			return nil
+		}
 
-			metadataPath := filepath.Join(dirPath, entry.Name(), "metadata.yaml")
-			if _, err := os.Stat(metadataPath); err == nil {
-				files = append(files, MetadataFile{
-					Path:          metadataPath,
-					ComponentName: entry.Name(),
-					ComponentType: componentDir,
-				})
+		rel, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			return nil
+		}
+		parts := strings.Split(filepath.ToSlash(rel), "/")
+
+		file := MetadataFile{
+			Path:          path,
+			ComponentType: componentType,
+		}
+		switch {
+		case len(parts) == 1:
+			// Only shared package roots (e.g. service/) carry their own metadata
+			if componentType != packageComponentType {
+				return nil
 			}
+			file.ComponentName = root
+		case len(parts) == 2:
+			file.ComponentName = parts[0]
+		case isSubcomponent(path, componentType):
+			file.ComponentName = parts[0]
+			file.Subcomponent = parts[len(parts)-2]
+		default:
+			file.ComponentName = parts[len(parts)-2]
 		}
+
+		files = append(files, file)
+		return nil
+	})
+
+	return files
+}
+
+func isSubcomponent(path, componentType string) bool {
+	content, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return false
+	}
+	var n nesting
+	if err := yaml.Unmarshal(content, &n); err != nil {
+		return false
 	}
+	return n.Parent != "" || (n.Status.Class != "" && n.Status.Class != componentType)
+}
 
-	return files, nil
+func skipDir(name string) bool {
+	switch name {
+	case "testdata", "vendor", "node_modules":
+		return true
+	}
+	return strings.HasPrefix(name, ".")
 }
 
 func (d *MetadataDiscovery) ComponentTypeFromPath(path string) string {
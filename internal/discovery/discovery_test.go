@@ -147,6 +147,109 @@ func TestMetadataDiscovery_FindMetadataFiles_AllComponentTypes(t *testing.T) {
 	}
 }
 
+func TestMetadataDiscovery_FindMetadataFiles_Nested(t *testing.T) {
+	tmpDir := setupTestRepo(t)
+
+	nested := map[string]string{
+		"receiver/hostmetrics/internal/scraper/cpuscraper/metadata.yaml":    "type: cpu\nparent: hostmetrics",
+		"receiver/hostmetrics/internal/scraper/memoryscraper/metadata.yaml": "type: memory\nparent: hostmetrics",
+		"receiver/hostmetrics/testdata/metadata.yaml":                       "type: test",
+		"pkg/stanza/metadata.yaml":                                          "type: test",
+		"service/metadata.yaml":                                             "type: test",
+	}
+	writeFiles(t, tmpDir, nested)
+
+	discovery := NewMetadataDiscovery()
+	files, err := discovery.FindMetadataFiles(tmpDir)
+	if err != nil {
+		t.Fatalf("FindMetadataFiles failed: %v", err)
+	}
+
+	byPath := make(map[string]MetadataFile)
+	for _, f := range files {
+		rel, _ := filepath.Rel(tmpDir, f.Path)
+		byPath[filepath.ToSlash(rel)] = f
+	}
+
+	if len(files) != 7 {
+		t.Errorf("expected 7 metadata files, got %d: %v", len(files), byPath)
+	}
+
+	cpu, ok := byPath["receiver/hostmetrics/internal/scraper/cpuscraper/metadata.yaml"]
+	if !ok {
+		t.Fatal("expected nested cpuscraper metadata to be found")
+	}
+	if cpu.ComponentName != "hostmetrics" || cpu.ComponentType != "receiver" || cpu.Subcomponent != "cpuscraper" {
+		t.Errorf("unexpected attribution for cpuscraper: %+v", cpu)
+	}
+
+	if parent := byPath["receiver/hostmetrics/metadata.yaml"]; parent.Subcomponent != "" {
+		t.Errorf("expected top-level metadata to have no subcomponent, got %q", parent.Subcomponent)
+	}
+
+	if _, ok := byPath["receiver/hostmetrics/testdata/metadata.yaml"]; ok {
+		t.Error("expected testdata to be skipped")
+	}
+
+	if stanza := byPath["pkg/stanza/metadata.yaml"]; stanza.ComponentName != "stanza" || stanza.ComponentType != "telemetry" {
+		t.Errorf("unexpected attribution for pkg/stanza: %+v", stanza)
+	}
+	if service := byPath["service/metadata.yaml"]; service.ComponentName != "service" || service.ComponentType != "telemetry" {
+		t.Errorf("unexpected attribution for service: %+v", service)
+	}
+}
+
+func TestMetadataDiscovery_FindMetadataFiles_GroupingDirectory(t *testing.T) {
+	tmpDir := setupTestRepo(t)
+	writeFiles(t, tmpDir, map[string]string{
+		"extension/observer/dockerobserver/metadata.yaml":     "type: docker_observer\nstatus:\n  class: extension",
+		"extension/observer/k8sobserver/metadata.yaml":        "type: k8s_observer\nstatus:\n  class: extension",
+		"extension/storage/filestorage/metadata.yaml":         "type: file_storage",
+		"receiver/hostmetrics/internal/scraper/metadata.yaml": "type: scraperhelper\nstatus:\n  class: scraper",
+	})
+
+	discovery := NewMetadataDiscovery()
+	files, err := discovery.FindMetadataFiles(tmpDir)
+	if err != nil {
+		t.Fatalf("FindMetadataFiles failed: %v", err)
+	}
+
+	byPath := make(map[string]MetadataFile)
+	for _, f := range files {
+		rel, _ := filepath.Rel(tmpDir, f.Path)
+		byPath[filepath.ToSlash(rel)] = f
+	}
+
+	for path, name := range map[string]string{
+		"extension/observer/dockerobserver/metadata.yaml": "dockerobserver",
+		"extension/observer/k8sobserver/metadata.yaml":    "k8sobserver",
+		"extension/storage/filestorage/metadata.yaml":     "filestorage",
+	} {
+		f := byPath[path]
+		if f.ComponentName != name || f.ComponentType != "extension" || f.Subcomponent != "" {
+			t.Errorf("expected %s to be its own extension %q, got %+v", path, name, f)
+		}
+	}
+
+	if scraper := byPath["receiver/hostmetrics/internal/scraper/metadata.yaml"]; scraper.ComponentName != "hostmetrics" || scraper.Subcomponent != "scraper" {
+		t.Errorf("expected a scraper class file to stay under hostmetrics, got %+v", scraper)
+	}
+}
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for path, content := range files {
+		fullPath := filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0750); err != nil {
+			t.Fatalf("failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0600); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+	}
+}
+
 func setupTestRepo(t *testing.T) string {
 	t.Helper()
 
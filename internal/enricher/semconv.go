@@ -3,7 +3,7 @@ package enricher
 import (
 	"strings"
 
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type SemconvMetric struct {
@@ -1,10 +1,11 @@
 package extractor
 
 import (
+	"sort"
 	"time"
 
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/parser"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type MetricExtractor struct {
@@ -21,17 +22,27 @@ func NewMetricExtractor(sourceName, componentName, componentType string) *Metric
 	}
 }
 
+// Extract returns the component's metrics and events, each carrying the
+// component's resource attributes, followed by its internal telemetry
+// metrics under the telemetry component type.
 func (e *MetricExtractor) Extract(meta *parser.Metadata) ([]*domain.CanonicalMetric, error) {
 	var metrics []*domain.CanonicalMetric
 
 	attrDefs := meta.Attributes
+	resourceAttrs := e.extractResourceAttributes(meta.ResourceAttributes)
 
 	for name, def := range meta.Metrics {
+		m := e.newMetric(name, def, domain.ComponentType(e.componentType))
+		m.Attributes = mergeResourceAttributes(e.extractAttributes(def.Attributes, attrDefs), resourceAttrs)
+		m.EnsureID()
+
+		metrics = append(metrics, m)
+	}
+
+	for name, def := range meta.Events {
 		m := &domain.CanonicalMetric{
 			MetricName:       name,
 			Description:      def.Description,
-			Unit:             def.Unit,
-			InstrumentType:   e.mapInstrumentType(def),
 			EnabledByDefault: def.Enabled,
 			ComponentType:    domain.ComponentType(e.componentType),
 			ComponentName:    e.componentName,
@@ -40,8 +51,16 @@ func (e *MetricExtractor) Extract(meta *parser.Metadata) ([]*domain.CanonicalMet
 			ExtractionMethod: domain.ExtractionMetadata,
 			SourceConfidence: domain.ConfidenceAuthoritative,
 			ExtractedAt:      time.Now(),
+			Signal:           domain.SignalKindEvent,
 		}
+		m.Attributes = mergeResourceAttributes(e.extractAttributes(def.Attributes, attrDefs), resourceAttrs)
+		m.EnsureID()
+
+		metrics = append(metrics, m)
+	}
 
+	for name, def := range meta.Telemetry.Metrics {
+		m := e.newMetric(name, def, domain.ComponentTelemetry)
 		m.Attributes = e.extractAttributes(def.Attributes, attrDefs)
 		m.EnsureID()
 
@@ -51,6 +70,83 @@ func (e *MetricExtractor) Extract(meta *parser.Metadata) ([]*domain.CanonicalMet
 	return metrics, nil
 }
 
+func (e *MetricExtractor) newMetric(name string, def parser.MetricDefinition, componentType domain.ComponentType) *domain.CanonicalMetric {
+	return &domain.CanonicalMetric{
+		MetricName:       name,
+		Description:      def.Description,
+		Unit:             def.Unit,
+		InstrumentType:   e.mapInstrumentType(def),
+		EnabledByDefault: def.Enabled,
+		ComponentType:    componentType,
+		ComponentName:    e.componentName,
+		SourceCategory:   domain.SourceOTEL,
+		SourceName:       e.sourceName,
+		ExtractionMethod: domain.ExtractionMetadata,
+		SourceConfidence: domain.ConfidenceAuthoritative,
+		ExtractedAt:      time.Now(),
+		Signal:           domain.SignalKindMetric,
+
+		ValueType:              domain.ValueType(def.ValueType()),
+		Monotonic:              def.Monotonic(),
+		AggregationTemporality: domain.AggregationTemporality(def.AggregationTemporality()),
+		BucketBoundaries:       def.BucketBoundaries(),
+	}
+}
+
+// ExtractComponent collects the component-level status block along with the
+// warnings declared on individual metrics.
+func (e *MetricExtractor) ExtractComponent(meta *parser.Metadata) *domain.Component {
+	component := &domain.Component{
+		Type:               domain.ComponentType(e.componentType),
+		Name:               e.componentName,
+		SourceName:         e.sourceName,
+		Class:              meta.Status.Class,
+		Stability:          make(map[string]domain.StabilityLevel),
+		Distributions:      meta.Status.Distributions,
+		Codeowners:         meta.Status.Codeowners.Active,
+		EmeritusCodeowners: meta.Status.Codeowners.Emeritus,
+		ExtractedAt:        time.Now(),
+	}
+
+	for signal, level := range meta.Status.Stability.Signals() {
+		component.Stability[signal] = domain.StabilityLevel(level)
+	}
+
+	for _, message := range meta.Status.Warnings {
+		component.Warnings = append(component.Warnings, domain.ComponentWarning{
+			Kind:    "status",
+			Message: message,
+		})
+	}
+
+	names := make([]string, 0, len(meta.Metrics))
+	for name := range meta.Metrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		w := meta.Metrics[name].Warnings
+		for _, warning := range []struct{ kind, message string }{
+			{"if_enabled", w.IfEnabled},
+			{"if_enabled_not_set", w.IfEnabledNotSet},
+			{"if_configured", w.IfConfigured},
+			{"if_configured_not_set", w.IfConfiguredNotSet},
+		} {
+			if warning.message == "" {
+				continue
+			}
+			component.Warnings = append(component.Warnings, domain.ComponentWarning{
+				Metric:  name,
+				Kind:    warning.kind,
+				Message: warning.message,
+			})
+		}
+	}
+
+	return component
+}
+
 func (e *MetricExtractor) mapInstrumentType(def parser.MetricDefinition) domain.InstrumentType {
 	if def.Sum != nil {
 		if def.Sum.Monotonic {
@@ -67,6 +163,46 @@ func (e *MetricExtractor) mapInstrumentType(def parser.MetricDefinition) domain.
 	return domain.InstrumentGauge
 }
 
+func (e *MetricExtractor) extractResourceAttributes(defs map[string]parser.ResourceAttributeDefinition) []domain.Attribute {
+	names := make([]string, 0, len(defs))
+	for name := range defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attrs := make([]domain.Attribute, 0, len(names))
+	for _, name := range names {
+		def := defs[name]
+		attrs = append(attrs, domain.Attribute{
+			Name:        name,
+			Type:        def.Type,
+			Description: def.Description,
+			Enum:        def.Enum,
+			Resource:    true,
+		})
+	}
+
+	return attrs
+}
+
+// mergeResourceAttributes appends resource attributes, letting a data point
+// attribute of the same name win.
+func mergeResourceAttributes(attrs, resourceAttrs []domain.Attribute) []domain.Attribute {
+	for _, ra := range resourceAttrs {
+		duplicate := false
+		for _, a := range attrs {
+			if a.Name == ra.Name {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			attrs = append(attrs, ra)
+		}
+	}
+	return attrs
+}
+
 func (e *MetricExtractor) extractAttributes(
 	attrNames []string,
 	attrDefs map[string]parser.AttributeDefinition,
@@ -3,8 +3,8 @@ package extractor
 import (
 	"testing"
 
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/parser"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 func TestMetricExtractor_Extract(t *testing.T) {
@@ -94,6 +94,18 @@ func TestMetricExtractor_Extract(t *testing.T) {
 	if len(m.Attributes) != 1 {
 		t.Errorf("expected 1 attribute, got %d", len(m.Attributes))
 	}
+
+	if m.ValueType != domain.ValueTypeInt {
+		t.Errorf("expected value type 'int', got %q", m.ValueType)
+	}
+
+	if m.Monotonic == nil || *m.Monotonic {
+		t.Errorf("expected monotonic false, got %v", m.Monotonic)
+	}
+
+	if m.AggregationTemporality != domain.TemporalityCumulative {
+		t.Errorf("expected temporality 'cumulative', got %q", m.AggregationTemporality)
+	}
 }
 
 func TestMetricExtractor_Extract_Counter(t *testing.T) {
@@ -309,3 +321,144 @@ func TestMetricExtractor_Extract_GeneratesID(t *testing.T) {
 		t.Error("expected ID to be generated")
 	}
 }
+
+func TestMetricExtractor_ExtractComponent(t *testing.T) {
+	meta := &parser.Metadata{
+		Type: "kafkametrics",
+		Status: parser.StatusDefinition{
+			Class:         "receiver",
+			Stability:     parser.StabilityDefinition{Beta: []string{"metrics"}, Alpha: []string{"logs"}},
+			Distributions: []string{"contrib"},
+			Warnings:      []string{"Requires broker admin access"},
+			Codeowners:    parser.CodeownersDefinition{Active: []string{"dmitryax"}, Emeritus: []string{"someone"}},
+		},
+		Metrics: map[string]parser.MetricDefinition{
+			"kafka.topic.partitions": {
+				Gauge:    &parser.GaugeDefinition{ValueType: "int"},
+				Warnings: parser.WarningsDefinition{IfEnabledNotSet: "Will be disabled by default"},
+			},
+			"kafka.brokers": {
+				Gauge:    &parser.GaugeDefinition{ValueType: "int"},
+				Warnings: parser.WarningsDefinition{IfEnabled: "Deprecated", IfConfigured: "Expensive"},
+			},
+		},
+	}
+
+	extractor := NewMetricExtractor("otel-collector-contrib", "kafkametrics", "receiver")
+	component := extractor.ExtractComponent(meta)
+
+	if component.Name != "kafkametrics" || component.Type != domain.ComponentReceiver {
+		t.Errorf("unexpected component identity: %s/%s", component.Type, component.Name)
+	}
+	if component.SourceName != "otel-collector-contrib" {
+		t.Errorf("unexpected source name: %q", component.SourceName)
+	}
+	if component.Stability["metrics"] != domain.StabilityBeta || component.Stability["logs"] != domain.StabilityAlpha {
+		t.Errorf("unexpected stability: %v", component.Stability)
+	}
+	if len(component.Distributions) != 1 || component.Distributions[0] != "contrib" {
+		t.Errorf("unexpected distributions: %v", component.Distributions)
+	}
+	if len(component.Codeowners) != 1 || len(component.EmeritusCodeowners) != 1 {
+		t.Errorf("unexpected codeowners: %v / %v", component.Codeowners, component.EmeritusCodeowners)
+	}
+
+	want := []domain.ComponentWarning{
+		{Kind: "status", Message: "Requires broker admin access"},
+		{Metric: "kafka.brokers", Kind: "if_enabled", Message: "Deprecated"},
+		{Metric: "kafka.brokers", Kind: "if_configured", Message: "Expensive"},
+		{Metric: "kafka.topic.partitions", Kind: "if_enabled_not_set", Message: "Will be disabled by default"},
+	}
+	if len(component.Warnings) != len(want) {
+		t.Fatalf("expected %d warnings, got %v", len(want), component.Warnings)
+	}
+	for i, w := range want {
+		if component.Warnings[i] != w {
+			t.Errorf("warning %d = %+v, want %+v", i, component.Warnings[i], w)
+		}
+	}
+}
+
+func TestMetricExtractor_Extract_ResourceAttributesEventsAndTelemetry(t *testing.T) {
+	meta := &parser.Metadata{
+		Type: "k8s_cluster",
+		ResourceAttributes: map[string]parser.ResourceAttributeDefinition{
+			"k8s.pod.name":       {Description: "The k8s pod name.", Type: "string", Enabled: true},
+			"k8s.namespace.name": {Description: "The k8s namespace name.", Type: "string", Enabled: true},
+		},
+		Attributes: map[string]parser.AttributeDefinition{
+			"reason": {Description: "The reason.", Type: "string"},
+		},
+		Metrics: map[string]parser.MetricDefinition{
+			"k8s.pod.phase": {
+				Enabled: true,
+				Gauge:   &parser.GaugeDefinition{ValueType: "int"},
+			},
+		},
+		Events: map[string]parser.EventDefinition{
+			"k8s.pod.evicted": {
+				Enabled:     true,
+				Description: "A pod was evicted.",
+				Attributes:  []string{"reason"},
+			},
+		},
+		Telemetry: parser.TelemetryDefinition{
+			Metrics: map[string]parser.MetricDefinition{
+				"otelcol_receiver_k8s_cluster_watch_errors": {
+					Enabled: true,
+					Sum:     &parser.SumDefinition{ValueType: "int", Monotonic: true},
+				},
+			},
+		},
+	}
+
+	extractor := NewMetricExtractor("test-source", "k8sclusterreceiver", "receiver")
+	metrics, err := extractor.Extract(meta)
+	if err != nil {
+		t.Fatalf("Extract failed: %v", err)
+	}
+
+	byName := make(map[string]*domain.CanonicalMetric)
+	for _, m := range metrics {
+		byName[m.MetricName] = m
+	}
+	if len(byName) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(byName))
+	}
+
+	phase := byName["k8s.pod.phase"]
+	if phase.Signal != domain.SignalKindMetric {
+		t.Errorf("expected metric signal, got %q", phase.Signal)
+	}
+	if len(phase.Attributes) != 2 {
+		t.Fatalf("expected 2 resource attributes, got %v", phase.Attributes)
+	}
+	if phase.Attributes[0].Name != "k8s.namespace.name" || !phase.Attributes[0].Resource {
+		t.Errorf("unexpected resource attribute: %+v", phase.Attributes[0])
+	}
+
+	event := byName["k8s.pod.evicted"]
+	if event.Signal != domain.SignalKindEvent {
+		t.Errorf("expected event signal, got %q", event.Signal)
+	}
+	if event.InstrumentType != "" {
+		t.Errorf("expected no instrument type for event, got %q", event.InstrumentType)
+	}
+	if len(event.Attributes) != 3 || event.Attributes[0].Name != "reason" || event.Attributes[0].Resource {
+		t.Errorf("unexpected event attributes: %+v", event.Attributes)
+	}
+	if err := event.Validate(); err != nil {
+		t.Errorf("expected event to validate, got %v", err)
+	}
+
+	telemetry := byName["otelcol_receiver_k8s_cluster_watch_errors"]
+	if telemetry.ComponentType != domain.ComponentTelemetry {
+		t.Errorf("expected telemetry component type, got %q", telemetry.ComponentType)
+	}
+	if telemetry.ComponentName != "k8sclusterreceiver" {
+		t.Errorf("expected telemetry to keep the component name, got %q", telemetry.ComponentName)
+	}
+	if len(telemetry.Attributes) != 0 {
+		t.Errorf("expected no resource attributes on telemetry, got %v", telemetry.Attributes)
+	}
+}
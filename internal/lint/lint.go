@@ -0,0 +1,242 @@
+// Package lint checks metrics against naming and unit conventions: the
+// OpenTelemetry naming guidelines for dotted names, Prometheus practice for
+// snake_case ones, and checks that apply to both.
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+type Severity string
+
+const (
+	SeverityOff     Severity = "off"
+	SeverityInfo    Severity = "info"
+	SeverityWarning Severity = "warning"
+	SeverityError   Severity = "error"
+)
+
+var ErrUnknownRule = errors.New("unknown lint rule")
+
+func (s Severity) IsValid() bool {
+	switch s {
+	case SeverityOff, SeverityInfo, SeverityWarning, SeverityError:
+		return true
+	}
+	return false
+}
+
+func (s Severity) rank() int {
+	switch s {
+	case SeverityInfo:
+		return 1
+	case SeverityWarning:
+		return 2
+	case SeverityError:
+		return 3
+	}
+	return 0
+}
+
+// AtLeast reports whether s is as severe as min.
+func (s Severity) AtLeast(min Severity) bool {
+	return s.rank() >= min.rank()
+}
+
+// Style is the naming convention a metric is held to.
+type Style string
+
+const (
+	StyleOTel       Style = "otel"
+	StylePrometheus Style = "prometheus"
+)
+
+// StyleOf picks the convention from the name: dotted names follow OTel and
+// the rest Prometheus. Cloud provider metrics keep their provider's naming,
+// so only the rules common to every style apply to them.
+func StyleOf(m *domain.CanonicalMetric) Style {
+	switch {
+	case m.SourceCategory == domain.SourceCloud:
+		return ""
+	case strings.Contains(m.MetricName, "."):
+		return StyleOTel
+	case m.SourceCategory == domain.SourceOTEL && !strings.Contains(m.MetricName, "_"):
+		return StyleOTel
+	default:
+		return StylePrometheus
+	}
+}
+
+// Rule is one convention. A rule with a style only applies to metrics of
+// that style.
+type Rule struct {
+	ID          string   `json:"id"`
+	Style       Style    `json:"style,omitempty"`
+	Severity    Severity `json:"severity"`
+	Description string   `json:"description"`
+
+	// check returns why m breaks the rule, or nothing
+	check func(m *domain.CanonicalMetric) string
+}
+
+type Violation struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+type Result struct {
+	MetricID   string      `json:"metric_id"`
+	MetricName string      `json:"metric_name"`
+	SourceName string      `json:"source_name"`
+	Style      Style       `json:"style,omitempty"`
+	Violations []Violation `json:"violations"`
+	// Score is 100 for a metric without errors or warnings, 50 with only
+	// warnings and 0 with any error
+	Score float64 `json:"score"`
+}
+
+// Has reports whether any violation is at least min.
+func (r *Result) Has(min Severity) bool {
+	for _, v := range r.Violations {
+		if v.Severity.AtLeast(min) {
+			return true
+		}
+	}
+	return false
+}
+
+// SourceScore sums up a source's results; Score is the mean metric score.
+type SourceScore struct {
+	SourceName string  `json:"source_name"`
+	Metrics    int     `json:"metrics"`
+	Errors     int     `json:"errors"`
+	Warnings   int     `json:"warnings"`
+	Infos      int     `json:"infos"`
+	Score      float64 `json:"score"`
+}
+
+type Linter struct {
+	rules []Rule
+}
+
+// Default lints with every rule at its default severity.
+func Default() *Linter {
+	return &Linter{rules: defaultRules()}
+}
+
+// New lints with the severities in overrides, keyed by rule ID, in place of
+// the defaults; off disables a rule.
+func New(overrides map[string]Severity) (*Linter, error) {
+	rules := defaultRules()
+
+	var errs []error
+	ids := make([]string, 0, len(overrides))
+	for id := range overrides {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		severity := overrides[id]
+		i := sort.Search(len(rules), func(i int) bool { return rules[i].ID >= id })
+		if i == len(rules) || rules[i].ID != id {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrUnknownRule, id))
+			continue
+		}
+		if !severity.IsValid() {
+			errs = append(errs, fmt.Errorf("%s: unknown severity %q", id, severity))
+			continue
+		}
+		rules[i].Severity = severity
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return &Linter{rules: rules}, nil
+}
+
+// Rules lists the rules with the severities in effect.
+func (l *Linter) Rules() []Rule {
+	return append([]Rule(nil), l.rules...)
+}
+
+func (l *Linter) Lint(m *domain.CanonicalMetric) *Result {
+	style := StyleOf(m)
+	result := &Result{
+		MetricID:   m.ID,
+		MetricName: m.MetricName,
+		SourceName: m.SourceName,
+		Style:      style,
+		Violations: []Violation{},
+		Score:      100,
+	}
+
+	for _, rule := range l.rules {
+		if rule.Severity == SeverityOff || (rule.Style != "" && rule.Style != style) {
+			continue
+		}
+		if message := rule.check(m); message != "" {
+			result.Violations = append(result.Violations, Violation{
+				Rule:     rule.ID,
+				Severity: rule.Severity,
+				Message:  message,
+			})
+		}
+	}
+
+	switch {
+	case result.Has(SeverityError):
+		result.Score = 0
+	case result.Has(SeverityWarning):
+		result.Score = 50
+	}
+
+	return result
+}
+
+// LintAll lints every metric and scores each source, sources sorted by
+// name.
+func (l *Linter) LintAll(metrics []*domain.CanonicalMetric) ([]*Result, []SourceScore) {
+	results := make([]*Result, 0, len(metrics))
+	bySource := make(map[string]*SourceScore)
+
+	for _, m := range metrics {
+		r := l.Lint(m)
+		results = append(results, r)
+
+		s, ok := bySource[r.SourceName]
+		if !ok {
+			s = &SourceScore{SourceName: r.SourceName}
+			bySource[r.SourceName] = s
+		}
+		s.Metrics++
+		s.Score += r.Score
+		for _, v := range r.Violations {
+			switch v.Severity {
+			case SeverityError:
+				s.Errors++
+			case SeverityWarning:
+				s.Warnings++
+			case SeverityInfo:
+				s.Infos++
+			}
+		}
+	}
+
+	scores := make([]SourceScore, 0, len(bySource))
+	for _, s := range bySource {
+		s.Score = math.Round(s.Score/float64(s.Metrics)*10) / 10
+		scores = append(scores, *s)
+	}
+	sort.Slice(scores, func(i, j int) bool { return scores[i].SourceName < scores[j].SourceName })
+
+	return results, scores
+}
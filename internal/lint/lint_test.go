@@ -0,0 +1,161 @@
+package lint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func metric(name string, instrument domain.InstrumentType, unit string) *domain.CanonicalMetric {
+	return &domain.CanonicalMetric{
+		ID:             name,
+		MetricName:     name,
+		InstrumentType: instrument,
+		Unit:           unit,
+		Description:    "A metric.",
+		SourceCategory: domain.SourceOTEL,
+		SourceName:     "test",
+	}
+}
+
+func rules(r *Result) map[string]Severity {
+	found := make(map[string]Severity)
+	for _, v := range r.Violations {
+		found[v.Rule] = v.Severity
+	}
+	return found
+}
+
+func TestStyleOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		category domain.SourceCategory
+		want     Style
+	}{
+		{"http.server.request.duration", domain.SourceOTEL, StyleOTel},
+		{"uptime", domain.SourceOTEL, StyleOTel},
+		{"process_cpu_seconds_total", domain.SourceOTEL, StylePrometheus},
+		{"node_cpu_seconds_total", domain.SourcePrometheus, StylePrometheus},
+		{"CPUUtilization", domain.SourceCloud, ""},
+	}
+
+	for _, tt := range tests {
+		m := metric(tt.name, domain.InstrumentGauge, "")
+		m.SourceCategory = tt.category
+		if got := StyleOf(m); got != tt.want {
+			t.Errorf("StyleOf(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name   string
+		metric *domain.CanonicalMetric
+		want   []string
+	}{
+		{"clean otel", metric("http.server.request.duration", domain.InstrumentHistogram, "s"), nil},
+		{"clean prometheus", metric("http_requests_total", domain.InstrumentCounter, "{request}"), nil},
+		{"otel uppercase", metric("http.Server.duration", domain.InstrumentHistogram, "s"), []string{"otel-name-format"}},
+		{"otel unit in name", metric("db.query.duration_seconds", domain.InstrumentHistogram, "s"), []string{"otel-unit-in-name"}},
+		{"otel count on counter", metric("app.request.count", domain.InstrumentCounter, "{request}"), []string{"otel-count-suffix"}},
+		{"otel count on updowncounter", metric("system.process.count", domain.InstrumentUpDownCounter, "{process}"), nil},
+		{"otel total", metric("app.requests.total", domain.InstrumentCounter, "{request}"), []string{"otel-total-suffix"}},
+		{"otel spelled unit", metric("app.queue.latency", domain.InstrumentHistogram, "seconds"), []string{"otel-ucum-unit"}},
+		{"prometheus total on gauge", metric("queue_depth_total", domain.InstrumentGauge, ""), []string{"prom-total-suffix"}},
+		{"prometheus counter without total", metric("http_requests", domain.InstrumentCounter, ""), []string{"prom-counter-total"}},
+		{"prometheus milliseconds", metric("request_duration_milliseconds", domain.InstrumentHistogram, "ms"), []string{"prom-base-units"}},
+		{"prometheus camel case", metric("httpRequests_total", domain.InstrumentCounter, ""), []string{"prom-name-format"}},
+		{"unit disagrees with name", metric("request_duration_seconds", domain.InstrumentHistogram, "ms"), []string{"unit-name-mismatch"}},
+		{"percent counter", metric("app.cpu.utilization", domain.InstrumentCounter, "%"), []string{"unit-instrument"}},
+		{"histogram without unit", metric("app.payload.size", domain.InstrumentHistogram, ""), []string{"unit-instrument"}},
+		{"no description", func() *domain.CanonicalMetric {
+			m := metric("app.jobs.active", domain.InstrumentUpDownCounter, "{job}")
+			m.Description = " "
+			return m
+		}(), []string{"missing-description"}},
+		{"cloud naming", func() *domain.CanonicalMetric {
+			m := metric("CPUUtilization", domain.InstrumentGauge, "%")
+			m.SourceCategory = domain.SourceCloud
+			return m
+		}(), nil},
+	}
+
+	l := Default()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			found := rules(l.Lint(tt.metric))
+			if len(found) != len(tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, found)
+			}
+			for _, id := range tt.want {
+				if _, ok := found[id]; !ok {
+					t.Errorf("expected %s, got %v", id, found)
+				}
+			}
+		})
+	}
+}
+
+func TestLintScore(t *testing.T) {
+	l := Default()
+
+	if r := l.Lint(metric("http.server.request.duration", domain.InstrumentHistogram, "s")); r.Score != 100 {
+		t.Errorf("expected clean metric to score 100, got %v", r.Score)
+	}
+	if r := l.Lint(metric("app.requests.total", domain.InstrumentCounter, "{request}")); r.Score != 50 {
+		t.Errorf("expected warnings to score 50, got %v", r.Score)
+	}
+	if r := l.Lint(metric("queue_depth_total", domain.InstrumentGauge, "")); r.Score != 0 || !r.Has(SeverityError) {
+		t.Errorf("expected errors to score 0, got %v", r.Score)
+	}
+}
+
+func TestLintAll(t *testing.T) {
+	clean := metric("http.server.request.duration", domain.InstrumentHistogram, "s")
+	warned := metric("app.requests.total", domain.InstrumentCounter, "{request}")
+	other := metric("queue_depth_total", domain.InstrumentGauge, "")
+	other.SourceName = "exporter"
+
+	results, scores := Default().LintAll([]*domain.CanonicalMetric{clean, warned, other})
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if len(scores) != 2 || scores[0].SourceName != "exporter" || scores[1].SourceName != "test" {
+		t.Fatalf("expected sources sorted by name, got %+v", scores)
+	}
+	if scores[1].Metrics != 2 || scores[1].Warnings != 1 || scores[1].Score != 75 {
+		t.Errorf("unexpected score %+v", scores[1])
+	}
+	if scores[0].Errors != 1 || scores[0].Score != 0 {
+		t.Errorf("unexpected score %+v", scores[0])
+	}
+}
+
+func TestNew(t *testing.T) {
+	l, err := New(map[string]Severity{
+		"otel-total-suffix":   SeverityOff,
+		"missing-description": SeverityError,
+	})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	if found := rules(l.Lint(metric("app.requests.total", domain.InstrumentCounter, "{request}"))); len(found) != 0 {
+		t.Errorf("expected disabled rule to be skipped, got %v", found)
+	}
+
+	m := metric("app.jobs.active", domain.InstrumentUpDownCounter, "{job}")
+	m.Description = ""
+	if found := rules(l.Lint(m)); found["missing-description"] != SeverityError {
+		t.Errorf("expected overridden severity, got %v", found)
+	}
+
+	if _, err := New(map[string]Severity{"no-such-rule": SeverityError}); !errors.Is(err, ErrUnknownRule) {
+		t.Errorf("expected unknown rule error, got %v", err)
+	}
+	if _, err := New(map[string]Severity{"missing-description": "fatal"}); err == nil {
+		t.Error("expected error for unknown severity")
+	}
+}
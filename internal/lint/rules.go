@@ -0,0 +1,248 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+var (
+	otelName       = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z0-9][a-z0-9_]*)*$`)
+	prometheusName = regexp.MustCompile(`^[a-z_:][a-z0-9_:]*$`)
+)
+
+// unitWords maps unit words seen in names to the UCUM unit they imply.
+var unitWords = map[string]string{
+	"seconds":      "s",
+	"milliseconds": "ms",
+	"microseconds": "us",
+	"nanoseconds":  "ns",
+	"minutes":      "min",
+	"hours":        "h",
+	"days":         "d",
+	"bytes":        "By",
+	"kilobytes":    "kBy",
+	"megabytes":    "MBy",
+	"gigabytes":    "GBy",
+	"percent":      "%",
+}
+
+// baseUnits are the unit words Prometheus prefers: seconds and bytes, with
+// ratios instead of percentages.
+var baseUnits = map[string]bool{
+	"seconds": true,
+	"bytes":   true,
+}
+
+// spelledUnits are units written out instead of as their UCUM symbol.
+var spelledUnits = map[string]string{
+	"second":       "s",
+	"seconds":      "s",
+	"sec":          "s",
+	"millisecond":  "ms",
+	"milliseconds": "ms",
+	"microsecond":  "us",
+	"microseconds": "us",
+	"nanosecond":   "ns",
+	"nanoseconds":  "ns",
+	"byte":         "By",
+	"bytes":        "By",
+	"percent":      "%",
+}
+
+func defaultRules() []Rule {
+	rules := []Rule{
+		{
+			ID:          "otel-name-format",
+			Style:       StyleOTel,
+			Severity:    SeverityWarning,
+			Description: "Names are lowercase, dot-separated namespaces",
+			check: func(m *domain.CanonicalMetric) string {
+				if otelName.MatchString(m.MetricName) {
+					return ""
+				}
+				return "name is not lowercase and dot-separated"
+			},
+		},
+		{
+			ID:          "otel-unit-in-name",
+			Style:       StyleOTel,
+			Severity:    SeverityWarning,
+			Description: "The unit goes in the unit field, not the name",
+			check: func(m *domain.CanonicalMetric) string {
+				segments := strings.Split(m.MetricName, ".")
+				tokens := strings.Split(segments[len(segments)-1], "_")
+				if word := tokens[len(tokens)-1]; isUnitWord(word) {
+					return fmt.Sprintf("name ends in the unit %q", word)
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "otel-count-suffix",
+			Style:       StyleOTel,
+			Severity:    SeverityWarning,
+			Description: ".count names the number of things present, recorded by an updowncounter or gauge",
+			check: func(m *domain.CanonicalMetric) string {
+				if !strings.HasSuffix(m.MetricName, ".count") {
+					return ""
+				}
+				switch m.InstrumentType {
+				case domain.InstrumentCounter, domain.InstrumentHistogram, domain.InstrumentSummary:
+					return fmt.Sprintf(".count on a %s; name it after what it counts, or record the current count with an updowncounter", m.InstrumentType)
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "otel-total-suffix",
+			Style:       StyleOTel,
+			Severity:    SeverityWarning,
+			Description: "Names leave out _total, which Prometheus exporters add to counters",
+			check: func(m *domain.CanonicalMetric) string {
+				if strings.HasSuffix(m.MetricName, ".total") || strings.HasSuffix(m.MetricName, "_total") {
+					return "name ends in total"
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "otel-ucum-unit",
+			Style:       StyleOTel,
+			Severity:    SeverityInfo,
+			Description: "Units use UCUM symbols, such as s and By",
+			check: func(m *domain.CanonicalMetric) string {
+				if symbol, ok := spelledUnits[strings.ToLower(m.Unit)]; ok {
+					return fmt.Sprintf("unit %q is written %q in UCUM", m.Unit, symbol)
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "prom-name-format",
+			Style:       StylePrometheus,
+			Severity:    SeverityWarning,
+			Description: "Names are lowercase snake_case",
+			check: func(m *domain.CanonicalMetric) string {
+				if prometheusName.MatchString(m.MetricName) {
+					return ""
+				}
+				return "name is not lowercase snake_case"
+			},
+		},
+		{
+			ID:          "prom-total-suffix",
+			Style:       StylePrometheus,
+			Severity:    SeverityError,
+			Description: "Only counters end in _total",
+			check: func(m *domain.CanonicalMetric) string {
+				if strings.HasSuffix(m.MetricName, "_total") && m.InstrumentType != "" && m.InstrumentType != domain.InstrumentCounter {
+					return fmt.Sprintf("_total on a %s", m.InstrumentType)
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "prom-counter-total",
+			Style:       StylePrometheus,
+			Severity:    SeverityWarning,
+			Description: "Counters end in _total",
+			check: func(m *domain.CanonicalMetric) string {
+				if m.InstrumentType == domain.InstrumentCounter && !strings.HasSuffix(m.MetricName, "_total") {
+					return "counter without _total"
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "prom-base-units",
+			Style:       StylePrometheus,
+			Severity:    SeverityWarning,
+			Description: "Names use base units: seconds, bytes and ratios",
+			check: func(m *domain.CanonicalMetric) string {
+				for _, token := range strings.Split(m.MetricName, "_") {
+					if isUnitWord(token) && !baseUnits[token] {
+						return fmt.Sprintf("%q is not a base unit", token)
+					}
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "unit-name-mismatch",
+			Severity:    SeverityError,
+			Description: "A unit named in the metric name agrees with the unit field",
+			check: func(m *domain.CanonicalMetric) string {
+				word := nameUnit(m.MetricName)
+				implied := unitWords[word]
+				unit := canonicalUnit(m.Unit)
+				if implied == "" || unit == "" || unit == implied {
+					return ""
+				}
+				return fmt.Sprintf("name says %s but unit is %q", word, m.Unit)
+			},
+		},
+		{
+			ID:          "unit-instrument",
+			Severity:    SeverityWarning,
+			Description: "The unit suits the instrument: no percentages or rates on sums, and histograms have a unit",
+			check: func(m *domain.CanonicalMetric) string {
+				switch m.InstrumentType {
+				case domain.InstrumentCounter, domain.InstrumentUpDownCounter:
+					if m.Unit == "%" {
+						return fmt.Sprintf("percentages don't add up; record a %s as a gauge", m.InstrumentType)
+					}
+					if strings.Contains(m.Unit, "/s") {
+						return fmt.Sprintf("%s records a total, but unit %q is a rate", m.InstrumentType, m.Unit)
+					}
+				case domain.InstrumentHistogram, domain.InstrumentSummary:
+					if m.Unit == "" {
+						return fmt.Sprintf("%s without a unit", m.InstrumentType)
+					}
+				}
+				return ""
+			},
+		},
+		{
+			ID:          "missing-description",
+			Severity:    SeverityWarning,
+			Description: "Every metric has a description",
+			check: func(m *domain.CanonicalMetric) string {
+				if strings.TrimSpace(m.Description) == "" {
+					return "no description"
+				}
+				return ""
+			},
+		},
+	}
+
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+func isUnitWord(word string) bool {
+	_, ok := unitWords[word]
+	return ok
+}
+
+// nameUnit finds the last unit word in a dotted or snake_case name.
+func nameUnit(name string) string {
+	tokens := strings.FieldsFunc(name, func(r rune) bool { return r == '.' || r == '_' })
+	for i := len(tokens) - 1; i >= 0; i-- {
+		if isUnitWord(tokens[i]) {
+			return tokens[i]
+		}
+	}
+	return ""
+}
+
+// canonicalUnit reads UCUM symbols and their spelled-out forms alike.
+func canonicalUnit(unit string) string {
+	if symbol, ok := spelledUnits[strings.ToLower(unit)]; ok {
+		return symbol
+	}
+	return unit
+}
@@ -0,0 +1,70 @@
+package orchestrator
+
+import (
+	"path/filepath"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+// Filter narrows what an extraction keeps. Patterns are globs in which **
+// spans slashes. A field with Include patterns keeps only what matches one
+// of them, and anything an Exclude pattern matches is dropped.
+type Filter struct {
+	IncludeComponents []string
+	ExcludeComponents []string
+	IncludeMetrics    []string
+	ExcludeMetrics    []string
+	// IncludePaths and ExcludePaths match the source file a metric was
+	// found in, relative to the repository
+	IncludePaths []string
+	ExcludePaths []string
+	// EnabledByDefault, when set, keeps only metrics whose enabled by
+	// default flag equals it
+	EnabledByDefault *bool
+}
+
+// KeepMetric reports whether raw, extracted from the checkout at repoPath,
+// passes the filter.
+func (f Filter) KeepMetric(raw *adapter.RawMetric, repoPath string) bool {
+	if f.EnabledByDefault != nil && raw.EnabledByDefault != *f.EnabledByDefault {
+		return false
+	}
+	return f.KeepComponent(raw.ComponentName) &&
+		keep(raw.Name, f.IncludeMetrics, f.ExcludeMetrics) &&
+		keep(relativePath(raw.Path, repoPath), f.IncludePaths, f.ExcludePaths)
+}
+
+// relativePath makes a path some adapters report in full relative to the
+// repository, so path patterns match it the same way as everyone else's.
+func relativePath(path, repoPath string) string {
+	if repoPath == "" || !filepath.IsAbs(path) {
+		return filepath.ToSlash(path)
+	}
+	rel, err := filepath.Rel(repoPath, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+// KeepComponent reports whether the named component passes the component
+// patterns.
+func (f Filter) KeepComponent(name string) bool {
+	return keep(name, f.IncludeComponents, f.ExcludeComponents)
+}
+
+func keep(name string, include, exclude []string) bool {
+	if len(include) > 0 && !matchAny(include, name) {
+		return false
+	}
+	return !matchAny(exclude, name)
+}
+
+func matchAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if adapter.MatchGlob(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
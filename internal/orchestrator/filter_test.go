@@ -0,0 +1,51 @@
+package orchestrator
+
+import (
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+func TestFilter_KeepMetric(t *testing.T) {
+	enabled := true
+	metrics := map[string]*adapter.RawMetric{
+		"mysql":    {Name: "mysql.connections", ComponentName: "mysqlreceiver", Path: "receiver/mysqlreceiver/metadata.yaml", EnabledByDefault: true},
+		"optional": {Name: "mysql.query.slow", ComponentName: "mysqlreceiver", Path: "receiver/mysqlreceiver/metadata.yaml"},
+		"fake":     {Name: "container_fake_total", ComponentName: "cadvisor", Path: "container/prometheus_fake.go", EnabledByDefault: true},
+		"gcp":      {Name: "compute.googleapis.com/instance/cpu/utilization", Path: "gcp/compute.yaml", EnabledByDefault: true},
+		"absolute": {Name: "go_goroutines", ComponentName: "go", Path: "/cache/repo/prometheus/go_collector.go", EnabledByDefault: true},
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		kept   []string
+	}{
+		{"zero filter keeps everything", Filter{}, []string{"mysql", "optional", "fake", "gcp", "absolute"}},
+		{"exclude path", Filter{ExcludePaths: []string{"**/*_fake.go"}}, []string{"mysql", "optional", "gcp", "absolute"}},
+		{"include path", Filter{IncludePaths: []string{"receiver/**"}}, []string{"mysql", "optional"}},
+		{"absolute path made relative", Filter{IncludePaths: []string{"prometheus/*.go"}}, []string{"absolute"}},
+		{"include metrics across slashes", Filter{IncludeMetrics: []string{"compute.googleapis.com/**"}}, []string{"gcp"}},
+		{"exclude metrics", Filter{ExcludeMetrics: []string{"mysql.query.*"}}, []string{"mysql", "fake", "gcp", "absolute"}},
+		{"enabled by default", Filter{EnabledByDefault: &enabled}, []string{"mysql", "fake", "gcp", "absolute"}},
+		{
+			"fields combine",
+			Filter{IncludeComponents: []string{"mysql*"}, EnabledByDefault: &enabled},
+			[]string{"mysql"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := make(map[string]bool, len(tt.kept))
+			for _, k := range tt.kept {
+				want[k] = true
+			}
+			for key, m := range metrics {
+				if got := tt.filter.KeepMetric(m, "/cache/repo"); got != want[key] {
+					t.Errorf("%s: KeepMetric = %v, want %v", key, got, want[key])
+				}
+			}
+		})
+	}
+}
@@ -3,11 +3,12 @@ package orchestrator
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/store"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type Adapter interface {
@@ -24,19 +25,34 @@ type Options struct {
 	Commit   string
 	CacheDir string
 	Force    bool
+	Filter   Filter
+
+	// merge adds pushed attributes to the stored ones instead of replacing
+	// them
+	merge bool
 }
 
+// PushRunWindow is how long pushes to Ingest share one extraction run.
+const PushRunWindow = time.Hour
+
 type Result struct {
 	AdapterName      string
 	Commit           string
 	MetricsExtracted int
+	MetricsFiltered  int
 	MetricsStored    int
+	ComponentsStored int
 	Duration         time.Duration
 }
 
 type Extractor struct {
 	adapter Adapter
 	store   store.Store
+
+	// pushRun is the run Ingest records pushes under until PushRunWindow
+	// has passed
+	mu      sync.Mutex
+	pushRun *store.ExtractionRun
 }
 
 func NewExtractor(adp Adapter, st store.Store) *Extractor {
@@ -49,14 +65,9 @@ func NewExtractor(adp Adapter, st store.Store) *Extractor {
 func (e *Extractor) Run(ctx context.Context, opts Options) (*Result, error) {
 	startTime := time.Now()
 
-	run := &store.ExtractionRun{
-		ID:          fmt.Sprintf("%s-%d", e.adapter.Name(), startTime.UnixNano()),
-		AdapterName: e.adapter.Name(),
-		StartedAt:   startTime,
-		Status:      "running",
-	}
-	if err := e.store.CreateExtractionRun(ctx, run); err != nil {
-		return nil, fmt.Errorf("failed to create extraction run: %w", err)
+	run, err := e.startRun(ctx, startTime)
+	if err != nil {
+		return nil, err
 	}
 
 	fetchOpts := adapter.FetchOptions{
@@ -67,11 +78,7 @@ func (e *Extractor) Run(ctx context.Context, opts Options) (*Result, error) {
 
 	fetchResult, err := e.adapter.Fetch(ctx, fetchOpts)
 	if err != nil {
-		run.Status = "failed"
-		run.ErrorMessage = err.Error()
-		completedAt := time.Now()
-		run.CompletedAt = &completedAt
-		_ = e.store.UpdateExtractionRun(ctx, run)
+		e.failRun(ctx, run, err)
 		return nil, fmt.Errorf("fetch failed: %w", err)
 	}
 
@@ -79,16 +86,68 @@ func (e *Extractor) Run(ctx context.Context, opts Options) (*Result, error) {
 
 	rawMetrics, err := e.adapter.Extract(ctx, fetchResult)
 	if err != nil {
-		run.Status = "failed"
-		run.ErrorMessage = err.Error()
-		completedAt := time.Now()
-		run.CompletedAt = &completedAt
-		_ = e.store.UpdateExtractionRun(ctx, run)
+		e.failRun(ctx, run, err)
 		return nil, fmt.Errorf("extraction failed: %w", err)
 	}
 
+	return e.persist(ctx, run, startTime, rawMetrics, fetchResult, opts)
+}
+
+// Ingest stores metrics that were already decoded, as a push receiver does.
+// Their attributes are merged into what earlier pushes stored, and pushes
+// within PushRunWindow of each other are recorded as a single run.
+func (e *Extractor) Ingest(ctx context.Context, rawMetrics []*adapter.RawMetric, fetchResult *adapter.FetchResult) (*Result, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	startTime := time.Now()
+
+	if e.pushRun == nil || startTime.Sub(e.pushRun.StartedAt) >= PushRunWindow {
+		run, err := e.startRun(ctx, startTime)
+		if err != nil {
+			return nil, err
+		}
+		e.pushRun = run
+	}
+	e.pushRun.Commit = fetchResult.Commit
+
+	result, err := e.persist(ctx, e.pushRun, startTime, rawMetrics, fetchResult, Options{merge: true})
+	if err != nil {
+		// The failure is recorded on the run; the next push starts afresh
+		e.pushRun = nil
+	}
+	return result, err
+}
+
+func (e *Extractor) startRun(ctx context.Context, startTime time.Time) (*store.ExtractionRun, error) {
+	run := &store.ExtractionRun{
+		ID:          fmt.Sprintf("%s-%d", e.adapter.Name(), startTime.UnixNano()),
+		AdapterName: e.adapter.Name(),
+		StartedAt:   startTime,
+		Status:      "running",
+	}
+	if err := e.store.CreateExtractionRun(ctx, run); err != nil {
+		return nil, fmt.Errorf("failed to create extraction run: %w", err)
+	}
+	return run, nil
+}
+
+func (e *Extractor) failRun(ctx context.Context, run *store.ExtractionRun, err error) {
+	run.Status = "failed"
+	run.ErrorMessage = err.Error()
+	completedAt := time.Now()
+	run.CompletedAt = &completedAt
+	_ = e.store.UpdateExtractionRun(ctx, run)
+}
+
+func (e *Extractor) persist(ctx context.Context, run *store.ExtractionRun, startTime time.Time, rawMetrics []*adapter.RawMetric, fetchResult *adapter.FetchResult, opts Options) (*Result, error) {
 	canonicalMetrics := make([]*domain.CanonicalMetric, 0, len(rawMetrics))
+	filtered := 0
 	for _, raw := range rawMetrics {
+		if !opts.Filter.KeepMetric(raw, fetchResult.RepoPath) {
+			filtered++
+			continue
+		}
 		canonical := e.convertToCanonical(raw, fetchResult)
 		if err := canonical.Validate(); err != nil {
 			continue
@@ -97,18 +156,25 @@ func (e *Extractor) Run(ctx context.Context, opts Options) (*Result, error) {
 		canonicalMetrics = append(canonicalMetrics, canonical)
 	}
 
-	if err := e.store.UpsertMetrics(ctx, canonicalMetrics); err != nil {
-		run.Status = "failed"
-		run.ErrorMessage = err.Error()
-		completedAt := time.Now()
-		run.CompletedAt = &completedAt
-		_ = e.store.UpdateExtractionRun(ctx, run)
+	upsert := e.store.UpsertMetrics
+	if opts.merge {
+		upsert = e.store.MergeMetrics
+	}
+	if err := upsert(ctx, canonicalMetrics); err != nil {
+		e.failRun(ctx, run, err)
 		return nil, fmt.Errorf("failed to store metrics: %w", err)
 	}
 
+	componentsStored, err := e.storeComponents(ctx, fetchResult, opts)
+	if err != nil {
+		e.failRun(ctx, run, err)
+		return nil, err
+	}
+
 	completedAt := time.Now()
 	run.CompletedAt = &completedAt
-	run.MetricsCount = len(canonicalMetrics)
+	// A shared push run counts every push it covers
+	run.MetricsCount += len(canonicalMetrics)
 	run.Status = "completed"
 	_ = e.store.UpdateExtractionRun(ctx, run)
 
@@ -116,15 +182,58 @@ func (e *Extractor) Run(ctx context.Context, opts Options) (*Result, error) {
 		AdapterName:      e.adapter.Name(),
 		Commit:           fetchResult.Commit,
 		MetricsExtracted: len(rawMetrics),
+		MetricsFiltered:  filtered,
 		MetricsStored:    len(canonicalMetrics),
+		ComponentsStored: componentsStored,
 		Duration:         time.Since(startTime),
 	}, nil
 }
 
+// storeComponents persists component metadata for adapters that provide it.
+func (e *Extractor) storeComponents(ctx context.Context, fetchResult *adapter.FetchResult, opts Options) (int, error) {
+	ce, ok := e.adapter.(adapter.ComponentExtractor)
+	if !ok {
+		return 0, nil
+	}
+
+	extracted, err := ce.ExtractComponents(ctx, fetchResult)
+	if err != nil {
+		return 0, fmt.Errorf("component extraction failed: %w", err)
+	}
+
+	components := make([]*domain.Component, 0, len(extracted))
+	for _, c := range extracted {
+		if !opts.Filter.KeepComponent(c.Name) {
+			continue
+		}
+		c.SourceName = e.adapter.Name()
+		c.Repo = e.adapter.RepoURL()
+		c.Commit = fetchResult.Commit
+		c.ExtractedAt = fetchResult.Timestamp
+		if err := c.Validate(); err != nil {
+			continue
+		}
+		components = append(components, c)
+	}
+
+	if err := e.store.UpsertComponents(ctx, components); err != nil {
+		return 0, fmt.Errorf("failed to store components: %w", err)
+	}
+
+	return len(components), nil
+}
+
 func (e *Extractor) convertToCanonical(raw *adapter.RawMetric, fetchResult *adapter.FetchResult) *domain.CanonicalMetric {
+	instrumentType := domain.InstrumentType(raw.InstrumentType)
+
+	signal := domain.SignalKind(raw.Signal)
+	if signal == "" {
+		signal = domain.SignalKindMetric
+	}
+
 	return &domain.CanonicalMetric{
 		MetricName:       raw.Name,
-		InstrumentType:   domain.InstrumentType(raw.InstrumentType),
+		InstrumentType:   instrumentType,
 		Description:      raw.Description,
 		Unit:             raw.Unit,
 		Attributes:       raw.Attributes,
@@ -140,5 +249,37 @@ func (e *Extractor) convertToCanonical(raw *adapter.RawMetric, fetchResult *adap
 		Path:             raw.Path,
 		Commit:           fetchResult.Commit,
 		ExtractedAt:      fetchResult.Timestamp,
+		Signal:           signal,
+		Stability:        domain.StabilityLevel(raw.Stability),
+
+		ValueType:              domain.ValueType(raw.ValueType),
+		Monotonic:              monotonicity(raw.Monotonic, instrumentType),
+		AggregationTemporality: domain.AggregationTemporality(raw.AggregationTemporality),
+		BucketBoundaries:       raw.BucketBoundaries,
+
+		Statistics:           raw.Statistics,
+		RecommendedStatistic: raw.RecommendedStatistic,
+		Period:               raw.Period,
+
+		Orientation: raw.Orientation,
+	}
+}
+
+// monotonicity falls back to what the instrument type implies when the
+// adapter didn't say; gauges and histograms stay unknown.
+func monotonicity(monotonic *bool, instrumentType domain.InstrumentType) *bool {
+	if monotonic != nil {
+		return monotonic
+	}
+
+	var implied bool
+	switch instrumentType {
+	case domain.InstrumentCounter:
+		implied = true
+	case domain.InstrumentUpDownCounter:
+		implied = false
+	default:
+		return nil
 	}
+	return &implied
 }
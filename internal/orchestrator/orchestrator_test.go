@@ -2,12 +2,13 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
-	"github.com/base-14/metric-library/internal/adapter"
-	"github.com/base-14/metric-library/internal/domain"
 	"github.com/base-14/metric-library/internal/store"
+	"github.com/base-14/metric-library/pkg/adapter"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type mockAdapter struct {
@@ -42,9 +43,20 @@ func (m *mockAdapter) Extract(ctx context.Context, result *adapter.FetchResult)
 	return m.rawMetrics, nil
 }
 
+type mockComponentAdapter struct {
+	mockAdapter
+	components []*domain.Component
+}
+
+func (m *mockComponentAdapter) ExtractComponents(ctx context.Context, result *adapter.FetchResult) ([]*domain.Component, error) {
+	return m.components, nil
+}
+
 type mockStore struct {
-	metrics []*domain.CanonicalMetric
-	runs    []*store.ExtractionRun
+	metrics    []*domain.CanonicalMetric
+	merged     []*domain.CanonicalMetric
+	components []*domain.Component
+	runs       []*store.ExtractionRun
 }
 
 func (m *mockStore) UpsertMetric(ctx context.Context, metric *domain.CanonicalMetric) error {
@@ -57,6 +69,11 @@ func (m *mockStore) UpsertMetrics(ctx context.Context, metrics []*domain.Canonic
 	return nil
 }
 
+func (m *mockStore) MergeMetrics(ctx context.Context, metrics []*domain.CanonicalMetric) error {
+	m.merged = append(m.merged, metrics...)
+	return nil
+}
+
 func (m *mockStore) GetMetric(ctx context.Context, id string) (*domain.CanonicalMetric, error) {
 	return nil, nil
 }
@@ -104,7 +121,22 @@ func (m *mockStore) GetLatestExtractionRun(ctx context.Context, adapterName stri
 	return nil, nil
 }
 
-func (m *mockStore) GetSemconvMetrics(ctx context.Context) ([]*domain.CanonicalMetric, error) {
+func (m *mockStore) UpsertComponents(ctx context.Context, components []*domain.Component) error {
+	m.components = append(m.components, components...)
+	return nil
+}
+
+func (m *mockStore) ReplaceComponentMetrics(ctx context.Context, component *domain.Component, metrics []*domain.CanonicalMetric) error {
+	m.metrics = append(m.metrics, metrics...)
+	m.components = append(m.components, component)
+	return nil
+}
+
+func (m *mockStore) GetComponent(ctx context.Context, componentType, name, sourceName string) (*domain.Component, error) {
+	return nil, nil
+}
+
+func (m *mockStore) GetSemconvMetrics(ctx context.Context, sourceNames ...string) ([]*domain.CanonicalMetric, error) {
 	return nil, nil
 }
 
@@ -182,6 +214,15 @@ func TestExtractor_Run(t *testing.T) {
 	if metric.ID == "" {
 		t.Error("expected metric to have an ID")
 	}
+	if metric.Monotonic == nil || !*metric.Monotonic {
+		t.Errorf("expected counter to be monotonic, got %v", metric.Monotonic)
+	}
+	if metric.Signal != domain.SignalKindMetric {
+		t.Errorf("expected signal to default to 'metric', got '%s'", metric.Signal)
+	}
+	if mockSt.metrics[1].Monotonic != nil {
+		t.Errorf("expected histogram monotonicity to be unknown, got %v", *mockSt.metrics[1].Monotonic)
+	}
 }
 
 func TestExtractor_TracksExtractionRun(t *testing.T) {
@@ -232,3 +273,147 @@ func TestExtractor_TracksExtractionRun(t *testing.T) {
 		t.Errorf("expected metrics count 1, got %d", run.MetricsCount)
 	}
 }
+
+func TestExtractor_StoresComponents(t *testing.T) {
+	mockAdp := &mockComponentAdapter{
+		mockAdapter: mockAdapter{
+			name:           "test-adapter",
+			sourceCategory: domain.SourceOTEL,
+			confidence:     domain.ConfidenceAuthoritative,
+			extraction:     domain.ExtractionMetadata,
+			repoURL:        "https://github.com/test/repo",
+			fetchResult: &adapter.FetchResult{
+				RepoPath:  "/tmp/repo",
+				Commit:    "abc123",
+				Timestamp: time.Now(),
+			},
+		},
+		components: []*domain.Component{
+			{
+				Type:      domain.ComponentReceiver,
+				Name:      "mysqlreceiver",
+				Stability: map[string]domain.StabilityLevel{"metrics": domain.StabilityBeta},
+			},
+			{
+				Type: "invalid",
+				Name: "broken",
+			},
+		},
+	}
+
+	mockSt := &mockStore{}
+
+	ext := NewExtractor(mockAdp, mockSt)
+	result, err := ext.Run(context.Background(), Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.ComponentsStored != 1 {
+		t.Errorf("expected 1 component stored, got %d", result.ComponentsStored)
+	}
+	if len(mockSt.components) != 1 {
+		t.Fatalf("expected 1 component in store, got %d", len(mockSt.components))
+	}
+
+	c := mockSt.components[0]
+	if c.SourceName != "test-adapter" {
+		t.Errorf("expected source name 'test-adapter', got '%s'", c.SourceName)
+	}
+	if c.Commit != "abc123" || c.Repo != "https://github.com/test/repo" {
+		t.Errorf("expected provenance to be stamped, got commit %q repo %q", c.Commit, c.Repo)
+	}
+}
+
+func TestExtractor_Ingest(t *testing.T) {
+	mockAdp := &mockAdapter{
+		name:           "fleet",
+		sourceCategory: domain.SourceOTEL,
+		confidence:     domain.ConfidenceDerived,
+		extraction:     domain.ExtractionScrape,
+		fetchErr:       errors.New("ingest must not fetch"),
+	}
+
+	mockSt := &mockStore{}
+	ext := NewExtractor(mockAdp, mockSt)
+
+	push := func(commit string) *Result {
+		t.Helper()
+		result, err := ext.Ingest(context.Background(), []*adapter.RawMetric{
+			{
+				Name:           "http.server.request.duration",
+				InstrumentType: "histogram",
+				ComponentType:  "platform",
+				ComponentName:  "checkout",
+			},
+		}, &adapter.FetchResult{Commit: commit, Timestamp: time.Now()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return result
+	}
+
+	result := push("otlp-1")
+	push("otlp-2")
+
+	if result.MetricsStored != 1 || len(mockSt.merged) != 2 || len(mockSt.metrics) != 0 {
+		t.Fatalf("expected each push merged into the store, got %d merged and %d replaced", len(mockSt.merged), len(mockSt.metrics))
+	}
+	if mockSt.merged[0].SourceName != "fleet" || mockSt.merged[0].SourceConfidence != domain.ConfidenceDerived {
+		t.Errorf("expected derived metric under source 'fleet', got %s/%s", mockSt.merged[0].SourceName, mockSt.merged[0].SourceConfidence)
+	}
+	if len(mockSt.runs) != 1 {
+		t.Fatalf("expected pushes to share one extraction run, got %d", len(mockSt.runs))
+	}
+	if run := mockSt.runs[0]; run.Status != "completed" || run.Commit != "otlp-2" || run.MetricsCount != 2 {
+		t.Errorf("expected a completed run covering both pushes, got %+v", run)
+	}
+}
+
+func TestExtractor_ComponentGlobs(t *testing.T) {
+	metric := func(name, component string) *adapter.RawMetric {
+		return &adapter.RawMetric{Name: name, InstrumentType: "gauge", ComponentType: "receiver", ComponentName: component}
+	}
+	mockAdp := &mockComponentAdapter{
+		mockAdapter: mockAdapter{
+			name:           "test-adapter",
+			sourceCategory: domain.SourceOTEL,
+			confidence:     domain.ConfidenceAuthoritative,
+			extraction:     domain.ExtractionMetadata,
+			repoURL:        "https://github.com/test/repo",
+			fetchResult:    &adapter.FetchResult{Commit: "abc123", Timestamp: time.Now()},
+			rawMetrics: []*adapter.RawMetric{
+				metric("mysql.connections", "mysqlreceiver"),
+				metric("postgresql.backends", "postgresqlreceiver"),
+				metric("postgresql.test", "postgresqltestreceiver"),
+				metric("redis.clients", "redisreceiver"),
+			},
+		},
+		components: []*domain.Component{
+			{Type: domain.ComponentReceiver, Name: "mysqlreceiver"},
+			{Type: domain.ComponentReceiver, Name: "redisreceiver"},
+		},
+	}
+
+	mockSt := &mockStore{}
+	ext := NewExtractor(mockAdp, mockSt)
+	result, err := ext.Run(context.Background(), Options{Filter: Filter{
+		IncludeComponents: []string{"mysql*", "postgresql*"},
+		ExcludeComponents: []string{"*test*"},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if result.MetricsStored != 2 || result.MetricsFiltered != 2 {
+		t.Fatalf("expected 2 metrics stored and 2 filtered, got %d and %d", result.MetricsStored, result.MetricsFiltered)
+	}
+	for _, m := range mockSt.metrics {
+		if m.ComponentName != "mysqlreceiver" && m.ComponentName != "postgresqlreceiver" {
+			t.Errorf("unexpected component kept: %s", m.ComponentName)
+		}
+	}
+	if len(mockSt.components) != 1 || mockSt.components[0].Name != "mysqlreceiver" {
+		t.Errorf("expected only mysqlreceiver stored, got %v", mockSt.components)
+	}
+}
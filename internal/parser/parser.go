@@ -8,23 +8,53 @@ import (
 )
 
 type Metadata struct {
-	Type       string                         `yaml:"type"`
-	Status     StatusDefinition               `yaml:"status"`
-	Attributes map[string]AttributeDefinition `yaml:"attributes"`
-	Metrics    map[string]MetricDefinition    `yaml:"metrics"`
+	Type               string                                 `yaml:"type"`
+	Status             StatusDefinition                       `yaml:"status"`
+	ResourceAttributes map[string]ResourceAttributeDefinition `yaml:"resource_attributes"`
+	Attributes         map[string]AttributeDefinition         `yaml:"attributes"`
+	Metrics            map[string]MetricDefinition            `yaml:"metrics"`
+	Events             map[string]EventDefinition             `yaml:"events"`
+	Telemetry          TelemetryDefinition                    `yaml:"telemetry"`
 }
 
 type StatusDefinition struct {
-	Class      string               `yaml:"class"`
-	Stability  StabilityDefinition  `yaml:"stability"`
-	Codeowners CodeownersDefinition `yaml:"codeowners"`
+	Class         string               `yaml:"class"`
+	Stability     StabilityDefinition  `yaml:"stability"`
+	Distributions []string             `yaml:"distributions"`
+	Warnings      []string             `yaml:"warnings"`
+	Codeowners    CodeownersDefinition `yaml:"codeowners"`
 }
 
 type StabilityDefinition struct {
-	Beta        []string `yaml:"beta"`
-	Alpha       []string `yaml:"alpha"`
-	Development []string `yaml:"development"`
-	Stable      []string `yaml:"stable"`
+	Beta         []string `yaml:"beta"`
+	Alpha        []string `yaml:"alpha"`
+	Development  []string `yaml:"development"`
+	Stable       []string `yaml:"stable"`
+	Deprecated   []string `yaml:"deprecated"`
+	Unmaintained []string `yaml:"unmaintained"`
+}
+
+// Signals maps each signal to the stability level it is listed under.
+func (s StabilityDefinition) Signals() map[string]string {
+	levels := []struct {
+		level   string
+		signals []string
+	}{
+		{"development", s.Development},
+		{"alpha", s.Alpha},
+		{"beta", s.Beta},
+		{"stable", s.Stable},
+		{"deprecated", s.Deprecated},
+		{"unmaintained", s.Unmaintained},
+	}
+
+	signals := make(map[string]string)
+	for _, l := range levels {
+		for _, signal := range l.signals {
+			signals[signal] = l.level
+		}
+	}
+	return signals
 }
 
 type CodeownersDefinition struct {
@@ -38,6 +68,26 @@ type AttributeDefinition struct {
 	Enum        []string `yaml:"enum"`
 }
 
+type ResourceAttributeDefinition struct {
+	Description string   `yaml:"description"`
+	Type        string   `yaml:"type"`
+	Enabled     bool     `yaml:"enabled"`
+	Enum        []string `yaml:"enum"`
+}
+
+type EventDefinition struct {
+	Enabled               bool               `yaml:"enabled"`
+	Description           string             `yaml:"description"`
+	ExtendedDocumentation string             `yaml:"extended_documentation"`
+	Attributes            []string           `yaml:"attributes"`
+	Warnings              WarningsDefinition `yaml:"warnings"`
+}
+
+// TelemetryDefinition describes the metrics a component reports about itself.
+type TelemetryDefinition struct {
+	Metrics map[string]MetricDefinition `yaml:"metrics"`
+}
+
 type MetricDefinition struct {
 	Enabled     bool                 `yaml:"enabled"`
 	Description string               `yaml:"description"`
@@ -60,7 +110,8 @@ type GaugeDefinition struct {
 }
 
 type HistogramDefinition struct {
-	ValueType string `yaml:"value_type"`
+	ValueType        string    `yaml:"value_type"`
+	BucketBoundaries []float64 `yaml:"bucket_boundaries"`
 }
 
 type WarningsDefinition struct {
@@ -86,6 +137,28 @@ func (m MetricDefinition) InstrumentType() string {
 	return "gauge"
 }
 
+func (m MetricDefinition) Monotonic() *bool {
+	if m.Sum != nil {
+		monotonic := m.Sum.Monotonic
+		return &monotonic
+	}
+	return nil
+}
+
+func (m MetricDefinition) AggregationTemporality() string {
+	if m.Sum != nil {
+		return m.Sum.AggregationTemporality
+	}
+	return ""
+}
+
+func (m MetricDefinition) BucketBoundaries() []float64 {
+	if m.Histogram != nil {
+		return m.Histogram.BucketBoundaries
+	}
+	return nil
+}
+
 func (m MetricDefinition) ValueType() string {
 	if m.Sum != nil {
 		return m.Sum.ValueType
@@ -124,6 +124,156 @@ metrics:
 	}
 }
 
+func TestMetadataParser_Parse_HistogramBuckets(t *testing.T) {
+	content := []byte(`
+type: sample
+
+metrics:
+  http.server.duration:
+    enabled: true
+    unit: s
+    histogram:
+      value_type: double
+      bucket_boundaries: [0.005, 0.01, 0.1, 1]
+`)
+
+	parser := NewMetadataParser()
+	meta, err := parser.Parse(content)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	m := meta.Metrics["http.server.duration"]
+	buckets := m.BucketBoundaries()
+	if len(buckets) != 4 || buckets[0] != 0.005 || buckets[3] != 1 {
+		t.Errorf("unexpected bucket boundaries: %v", buckets)
+	}
+
+	if m.Monotonic() != nil {
+		t.Errorf("expected no monotonicity for histogram, got %v", *m.Monotonic())
+	}
+}
+
+func TestMetadataParser_Parse_Status(t *testing.T) {
+	content := []byte(`
+type: kafkametrics
+
+status:
+  class: receiver
+  stability:
+    beta: [metrics]
+    development: [logs]
+    deprecated: [traces]
+  distributions: [contrib, k8s]
+  warnings: [Any additional information that should be brought to the consumer's attention]
+  codeowners:
+    active: [dmitryax]
+    emeritus: [someone]
+
+metrics:
+  kafka.brokers:
+    enabled: true
+    unit: "{brokers}"
+    gauge:
+      value_type: int
+    warnings:
+      if_enabled_not_set: This metric will be disabled by default soon.
+`)
+
+	parser := NewMetadataParser()
+	meta, err := parser.Parse(content)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	signals := meta.Status.Stability.Signals()
+	if signals["metrics"] != "beta" || signals["logs"] != "development" || signals["traces"] != "deprecated" {
+		t.Errorf("unexpected stability signals: %v", signals)
+	}
+	if len(meta.Status.Distributions) != 2 || meta.Status.Distributions[1] != "k8s" {
+		t.Errorf("unexpected distributions: %v", meta.Status.Distributions)
+	}
+	if len(meta.Status.Warnings) != 1 {
+		t.Errorf("expected 1 status warning, got %v", meta.Status.Warnings)
+	}
+	if len(meta.Status.Codeowners.Emeritus) != 1 {
+		t.Errorf("unexpected emeritus codeowners: %v", meta.Status.Codeowners.Emeritus)
+	}
+	if meta.Metrics["kafka.brokers"].Warnings.IfEnabledNotSet == "" {
+		t.Error("expected if_enabled_not_set warning to be parsed")
+	}
+}
+
+func TestMetadataParser_Parse_ResourceAttributesEventsAndTelemetry(t *testing.T) {
+	content := []byte(`
+type: k8s_cluster
+
+resource_attributes:
+  k8s.namespace.name:
+    description: The k8s namespace name.
+    type: string
+    enabled: true
+  k8s.pod.qos_class:
+    description: The QoS class of the pod.
+    type: string
+    enabled: false
+
+attributes:
+  reason:
+    description: The reason for the event.
+    type: string
+
+events:
+  k8s.pod.evicted:
+    enabled: true
+    description: A pod was evicted.
+    extended_documentation: Emitted once per eviction.
+    attributes: [reason]
+
+telemetry:
+  metrics:
+    otelcol_receiver_k8s_cluster_watch_errors:
+      enabled: true
+      description: Number of watch errors.
+      unit: "{errors}"
+      sum:
+        value_type: int
+        monotonic: true
+`)
+
+	parser := NewMetadataParser()
+	meta, err := parser.Parse(content)
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if len(meta.ResourceAttributes) != 2 {
+		t.Fatalf("expected 2 resource attributes, got %d", len(meta.ResourceAttributes))
+	}
+	if ra := meta.ResourceAttributes["k8s.namespace.name"]; !ra.Enabled || ra.Type != "string" {
+		t.Errorf("unexpected resource attribute: %+v", ra)
+	}
+	if meta.ResourceAttributes["k8s.pod.qos_class"].Enabled {
+		t.Error("expected k8s.pod.qos_class to be disabled")
+	}
+
+	event, ok := meta.Events["k8s.pod.evicted"]
+	if !ok {
+		t.Fatal("expected event k8s.pod.evicted not found")
+	}
+	if !event.Enabled || event.ExtendedDocumentation == "" || len(event.Attributes) != 1 {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	telemetry, ok := meta.Telemetry.Metrics["otelcol_receiver_k8s_cluster_watch_errors"]
+	if !ok {
+		t.Fatal("expected telemetry metric not found")
+	}
+	if telemetry.InstrumentType() != "counter" {
+		t.Errorf("expected telemetry metric to be a counter, got %q", telemetry.InstrumentType())
+	}
+}
+
 func TestMetadataParser_Parse_Attributes(t *testing.T) {
 	content := []byte(`
 type: test
@@ -0,0 +1,13 @@
+-- migrate:up
+ALTER TABLE metrics ADD COLUMN value_type TEXT DEFAULT '';
+ALTER TABLE metrics ADD COLUMN monotonic INTEGER;
+ALTER TABLE metrics ADD COLUMN aggregation_temporality TEXT DEFAULT '';
+ALTER TABLE metrics ADD COLUMN bucket_boundaries TEXT DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_metrics_value_type ON metrics(value_type);
+CREATE INDEX IF NOT EXISTS idx_metrics_aggregation_temporality ON metrics(aggregation_temporality);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_metrics_aggregation_temporality;
+DROP INDEX IF EXISTS idx_metrics_value_type;
+-- SQLite doesn't support DROP COLUMN, so we leave the columns
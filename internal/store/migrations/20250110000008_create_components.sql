@@ -0,0 +1,25 @@
+-- migrate:up
+CREATE TABLE IF NOT EXISTS components (
+    component_type      TEXT NOT NULL,
+    component_name      TEXT NOT NULL,
+    source_name         TEXT NOT NULL,
+    class               TEXT,
+    stability           TEXT DEFAULT '',
+    metrics_stability   TEXT DEFAULT '',
+    distributions       TEXT DEFAULT '',
+    codeowners          TEXT DEFAULT '',
+    emeritus_codeowners TEXT DEFAULT '',
+    warnings            TEXT DEFAULT '',
+    repo                TEXT,
+    path                TEXT,
+    "commit"            TEXT,
+    extracted_at        TIMESTAMP NOT NULL,
+    updated_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (component_type, component_name, source_name)
+);
+
+CREATE INDEX IF NOT EXISTS idx_components_metrics_stability ON components(metrics_stability);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_components_metrics_stability;
+DROP TABLE IF EXISTS components;
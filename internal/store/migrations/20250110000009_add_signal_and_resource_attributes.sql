@@ -0,0 +1,9 @@
+-- migrate:up
+ALTER TABLE metrics ADD COLUMN signal TEXT DEFAULT 'metric';
+ALTER TABLE metric_attributes ADD COLUMN resource INTEGER DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_metrics_signal ON metrics(signal);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_metrics_signal;
+-- SQLite doesn't support DROP COLUMN, so we leave the columns
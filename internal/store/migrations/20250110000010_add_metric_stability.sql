@@ -0,0 +1,8 @@
+-- migrate:up
+ALTER TABLE metrics ADD COLUMN stability TEXT DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_metrics_stability ON metrics(stability);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_metrics_stability;
+-- SQLite doesn't support DROP COLUMN, so we leave the column
@@ -0,0 +1,7 @@
+-- migrate:up
+ALTER TABLE metrics ADD COLUMN statistics TEXT DEFAULT '';
+ALTER TABLE metrics ADD COLUMN recommended_statistic TEXT DEFAULT '';
+ALTER TABLE metrics ADD COLUMN period TEXT DEFAULT '';
+
+-- migrate:down
+-- SQLite doesn't support DROP COLUMN, so we leave the columns
@@ -0,0 +1,8 @@
+-- migrate:up
+ALTER TABLE metrics ADD COLUMN owner TEXT DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_metrics_owner ON metrics(owner);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_metrics_owner;
+-- SQLite doesn't support DROP COLUMN, so we leave the column
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE metrics ADD COLUMN orientation INTEGER DEFAULT 0;
+
+-- migrate:down
+-- SQLite doesn't support DROP COLUMN, so we leave the column
@@ -3,13 +3,14 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 type SQLiteStore struct {
@@ -46,21 +47,25 @@ func (s *SQLiteStore) UpsertMetric(ctx context.Context, metric *domain.Canonical
 	}
 	defer func() { _ = tx.Rollback() }()
 
-	if err := s.upsertMetricTx(ctx, tx, metric); err != nil {
+	if err := s.upsertMetricTx(ctx, tx, metric, false); err != nil {
 		return err
 	}
 
 	return tx.Commit()
 }
 
-func (s *SQLiteStore) upsertMetricTx(ctx context.Context, tx *sql.Tx, metric *domain.CanonicalMetric) error {
+// upsertMetricTx writes metric and its attributes. With merge, attributes
+// already stored stay and only new names are added.
+func (s *SQLiteStore) upsertMetricTx(ctx context.Context, tx *sql.Tx, metric *domain.CanonicalMetric, merge bool) error {
 	query := `
 		INSERT INTO metrics (
 			id, metric_name, instrument_type, description, unit, enabled_by_default,
 			component_type, component_name, source_category, source_name, source_location,
 			extraction_method, source_confidence, repo, path, "commit", extracted_at,
-			semconv_match, semconv_name, semconv_stability, updated_at
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+			semconv_match, semconv_name, semconv_stability,
+			value_type, monotonic, aggregation_temporality, bucket_boundaries, signal, stability,
+			statistics, recommended_statistic, period, owner, orientation, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
 		ON CONFLICT(id) DO UPDATE SET
 			metric_name = excluded.metric_name,
 			instrument_type = excluded.instrument_type,
@@ -81,6 +86,17 @@ func (s *SQLiteStore) upsertMetricTx(ctx context.Context, tx *sql.Tx, metric *do
 			semconv_match = excluded.semconv_match,
 			semconv_name = excluded.semconv_name,
 			semconv_stability = excluded.semconv_stability,
+			value_type = excluded.value_type,
+			monotonic = excluded.monotonic,
+			aggregation_temporality = excluded.aggregation_temporality,
+			bucket_boundaries = excluded.bucket_boundaries,
+			signal = excluded.signal,
+			stability = excluded.stability,
+			statistics = excluded.statistics,
+			recommended_statistic = excluded.recommended_statistic,
+			period = excluded.period,
+			owner = excluded.owner,
+			orientation = excluded.orientation,
 			updated_at = CURRENT_TIMESTAMP
 	`
 
@@ -89,32 +105,84 @@ func (s *SQLiteStore) upsertMetricTx(ctx context.Context, tx *sql.Tx, metric *do
 		enabledByDefault = 1
 	}
 
+	signal := metric.Signal
+	if signal == "" {
+		signal = domain.SignalKindMetric
+	}
+
+	var monotonic sql.NullInt64
+	if metric.Monotonic != nil {
+		monotonic.Valid = true
+		if *metric.Monotonic {
+			monotonic.Int64 = 1
+		}
+	}
+
+	bucketBoundaries := ""
+	if len(metric.BucketBoundaries) > 0 {
+		encoded, err := json.Marshal(metric.BucketBoundaries)
+		if err != nil {
+			return fmt.Errorf("failed to encode bucket boundaries: %w", err)
+		}
+		bucketBoundaries = string(encoded)
+	}
+
+	statistics := ""
+	if len(metric.Statistics) > 0 {
+		encoded, err := json.Marshal(metric.Statistics)
+		if err != nil {
+			return fmt.Errorf("failed to encode statistics: %w", err)
+		}
+		statistics = string(encoded)
+	}
+
 	_, err := tx.ExecContext(ctx, query,
 		metric.ID, metric.MetricName, metric.InstrumentType, metric.Description, metric.Unit, enabledByDefault,
 		metric.ComponentType, metric.ComponentName, metric.SourceCategory, metric.SourceName, metric.SourceLocation,
 		metric.ExtractionMethod, metric.SourceConfidence, metric.Repo, metric.Path, metric.Commit, metric.ExtractedAt,
 		metric.SemconvMatch, metric.SemconvName, metric.SemconvStability,
+		metric.ValueType, monotonic, metric.AggregationTemporality, bucketBoundaries, signal, metric.Stability,
+		statistics, metric.RecommendedStatistic, metric.Period, metric.Owner, metric.Orientation,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to upsert metric: %w", err)
 	}
 
-	// Delete existing attributes
-	_, err = tx.ExecContext(ctx, "DELETE FROM metric_attributes WHERE metric_id = ?", metric.ID)
-	if err != nil {
-		return fmt.Errorf("failed to delete existing attributes: %w", err)
+	stored := make(map[string]bool)
+	if merge {
+		stored, err = storedAttributeNames(ctx, tx, metric.ID)
+		if err != nil {
+			return err
+		}
+	} else {
+		// Delete existing attributes
+		_, err = tx.ExecContext(ctx, "DELETE FROM metric_attributes WHERE metric_id = ?", metric.ID)
+		if err != nil {
+			return fmt.Errorf("failed to delete existing attributes: %w", err)
+		}
 	}
 
 	// Insert attributes
 	for _, attr := range metric.Attributes {
+		if stored[attr.Name] {
+			continue
+		}
 		required := 0
 		if attr.Required {
 			required = 1
 		}
+		inferred := 0
+		if attr.Inferred {
+			inferred = 1
+		}
+		resource := 0
+		if attr.Resource {
+			resource = 1
+		}
 
 		result, err := tx.ExecContext(ctx,
-			"INSERT INTO metric_attributes (metric_id, attribute_name, attribute_type, description, required) VALUES (?, ?, ?, ?, ?)",
-			metric.ID, attr.Name, attr.Type, attr.Description, required,
+			"INSERT INTO metric_attributes (metric_id, attribute_name, attribute_type, description, required, inferred, resource) VALUES (?, ?, ?, ?, ?, ?, ?)",
+			metric.ID, attr.Name, attr.Type, attr.Description, required, inferred, resource,
 		)
 		if err != nil {
 			return fmt.Errorf("failed to insert attribute: %w", err)
@@ -141,7 +209,33 @@ func (s *SQLiteStore) upsertMetricTx(ctx context.Context, tx *sql.Tx, metric *do
 	return nil
 }
 
+func storedAttributeNames(ctx context.Context, tx *sql.Tx, metricID string) (map[string]bool, error) {
+	rows, err := tx.QueryContext(ctx, "SELECT attribute_name FROM metric_attributes WHERE metric_id = ?", metricID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query existing attributes: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	names := make(map[string]bool)
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan attribute: %w", err)
+		}
+		names[name] = true
+	}
+	return names, rows.Err()
+}
+
 func (s *SQLiteStore) UpsertMetrics(ctx context.Context, metrics []*domain.CanonicalMetric) error {
+	return s.upsertMetrics(ctx, metrics, false)
+}
+
+func (s *SQLiteStore) MergeMetrics(ctx context.Context, metrics []*domain.CanonicalMetric) error {
+	return s.upsertMetrics(ctx, metrics, true)
+}
+
+func (s *SQLiteStore) upsertMetrics(ctx context.Context, metrics []*domain.CanonicalMetric, merge bool) error {
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -150,7 +244,7 @@ func (s *SQLiteStore) UpsertMetrics(ctx context.Context, metrics []*domain.Canon
 
 	for _, metric := range metrics {
 		metric.EnsureID()
-		if err := s.upsertMetricTx(ctx, tx, metric); err != nil {
+		if err := s.upsertMetricTx(ctx, tx, metric, merge); err != nil {
 			return err
 		}
 	}
@@ -163,7 +257,9 @@ func (s *SQLiteStore) GetMetric(ctx context.Context, id string) (*domain.Canonic
 		SELECT id, metric_name, instrument_type, description, unit, enabled_by_default,
 			component_type, component_name, source_category, source_name, source_location,
 			extraction_method, source_confidence, repo, path, "commit", extracted_at,
-			semconv_match, semconv_name, semconv_stability
+			semconv_match, semconv_name, semconv_stability,
+			value_type, monotonic, aggregation_temporality, bucket_boundaries, signal, stability,
+			statistics, recommended_statistic, period, owner, orientation
 		FROM metrics WHERE id = ?
 	`
 
@@ -171,12 +267,17 @@ func (s *SQLiteStore) GetMetric(ctx context.Context, id string) (*domain.Canonic
 	var enabledByDefault int
 	var description, unit, sourceLocation, repo, path, commit sql.NullString
 	var semconvMatch, semconvName, semconvStability sql.NullString
+	var valueType, temporality, bucketBoundaries, signal, stability sql.NullString
+	var statistics, recommendedStatistic, period, owner sql.NullString
+	var monotonic, orientation sql.NullInt64
 
 	err := s.db.QueryRowContext(ctx, query, id).Scan(
 		&metric.ID, &metric.MetricName, &metric.InstrumentType, &description, &unit, &enabledByDefault,
 		&metric.ComponentType, &metric.ComponentName, &metric.SourceCategory, &metric.SourceName, &sourceLocation,
 		&metric.ExtractionMethod, &metric.SourceConfidence, &repo, &path, &commit, &metric.ExtractedAt,
 		&semconvMatch, &semconvName, &semconvStability,
+		&valueType, &monotonic, &temporality, &bucketBoundaries, &signal, &stability,
+		&statistics, &recommendedStatistic, &period, &owner, &orientation,
 	)
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -195,6 +296,16 @@ func (s *SQLiteStore) GetMetric(ctx context.Context, id string) (*domain.Canonic
 	metric.SemconvMatch = domain.SemconvMatch(semconvMatch.String)
 	metric.SemconvName = semconvName.String
 	metric.SemconvStability = semconvStability.String
+	metric.Signal = domain.SignalKind(signal.String)
+	metric.Stability = domain.StabilityLevel(stability.String)
+	if err := setAggregationDetails(&metric, valueType, monotonic, temporality, bucketBoundaries); err != nil {
+		return nil, err
+	}
+	if err := setStatistics(&metric, statistics, recommendedStatistic, period); err != nil {
+		return nil, err
+	}
+	metric.Owner = owner.String
+	metric.Orientation = int(orientation.Int64)
 
 	// Get attributes
 	attrs, err := s.getMetricAttributes(ctx, id)
@@ -206,9 +317,40 @@ func (s *SQLiteStore) GetMetric(ctx context.Context, id string) (*domain.Canonic
 	return &metric, nil
 }
 
+func setAggregationDetails(metric *domain.CanonicalMetric, valueType sql.NullString, monotonic sql.NullInt64, temporality, bucketBoundaries sql.NullString) error {
+	metric.ValueType = domain.ValueType(valueType.String)
+	metric.AggregationTemporality = domain.AggregationTemporality(temporality.String)
+
+	if monotonic.Valid {
+		isMonotonic := monotonic.Int64 == 1
+		metric.Monotonic = &isMonotonic
+	}
+
+	if bucketBoundaries.String != "" {
+		if err := json.Unmarshal([]byte(bucketBoundaries.String), &metric.BucketBoundaries); err != nil {
+			return fmt.Errorf("failed to decode bucket boundaries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func setStatistics(metric *domain.CanonicalMetric, statistics, recommended, period sql.NullString) error {
+	metric.RecommendedStatistic = recommended.String
+	metric.Period = period.String
+
+	if statistics.String != "" {
+		if err := json.Unmarshal([]byte(statistics.String), &metric.Statistics); err != nil {
+			return fmt.Errorf("failed to decode statistics: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (s *SQLiteStore) getMetricAttributes(ctx context.Context, metricID string) ([]domain.Attribute, error) {
 	query := `
-		SELECT id, attribute_name, attribute_type, description, required
+		SELECT id, attribute_name, attribute_type, description, required, inferred, resource
 		FROM metric_attributes WHERE metric_id = ?
 	`
 
@@ -223,15 +365,18 @@ func (s *SQLiteStore) getMetricAttributes(ctx context.Context, metricID string)
 		var attr domain.Attribute
 		var attrID int64
 		var required int
+		var inferred, resource sql.NullInt64
 		var attrType, description sql.NullString
 
-		if err := rows.Scan(&attrID, &attr.Name, &attrType, &description, &required); err != nil {
+		if err := rows.Scan(&attrID, &attr.Name, &attrType, &description, &required, &inferred, &resource); err != nil {
 			return nil, fmt.Errorf("failed to scan attribute: %w", err)
 		}
 
 		attr.Type = attrType.String
 		attr.Description = description.String
 		attr.Required = required == 1
+		attr.Inferred = inferred.Int64 == 1
+		attr.Resource = resource.Int64 == 1
 
 		// Get enum values
 		enumRows, err := s.db.QueryContext(ctx, "SELECT enum_value FROM attribute_enum_values WHERE attribute_id = ?", attrID)
@@ -357,6 +502,86 @@ func (s *SQLiteStore) Search(ctx context.Context, query SearchQuery) (*SearchRes
 		conditions = append(conditions, fmt.Sprintf("m.unit IN (%s)", strings.Join(placeholders, ",")))
 	}
 
+	if len(query.ValueTypes) > 0 {
+		placeholders := make([]string, len(query.ValueTypes))
+		for i, v := range query.ValueTypes {
+			placeholders[i] = "?"
+			args = append(args, v)
+		}
+		conditions = append(conditions, fmt.Sprintf("m.value_type IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if len(query.AggregationTemporalities) > 0 {
+		placeholders := make([]string, len(query.AggregationTemporalities))
+		for i, t := range query.AggregationTemporalities {
+			placeholders[i] = "?"
+			args = append(args, t)
+		}
+		conditions = append(conditions, fmt.Sprintf("m.aggregation_temporality IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if query.Monotonic != nil {
+		monotonic := 0
+		if *query.Monotonic {
+			monotonic = 1
+		}
+		conditions = append(conditions, "m.monotonic = ?")
+		args = append(args, monotonic)
+	}
+
+	if query.HasBucketBoundaries {
+		conditions = append(conditions, "m.bucket_boundaries IS NOT NULL AND m.bucket_boundaries != ''")
+	}
+
+	if len(query.Signals) > 0 {
+		placeholders := make([]string, len(query.Signals))
+		for i, s := range query.Signals {
+			placeholders[i] = "?"
+			args = append(args, s)
+		}
+		conditions = append(conditions, fmt.Sprintf("m.signal IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if len(query.Stabilities) > 0 {
+		placeholders := make([]string, len(query.Stabilities))
+		for i, l := range query.Stabilities {
+			placeholders[i] = "?"
+			args = append(args, l)
+		}
+		conditions = append(conditions, fmt.Sprintf("m.stability IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if len(query.Owners) > 0 {
+		placeholders := make([]string, len(query.Owners))
+		for i, o := range query.Owners {
+			placeholders[i] = "?"
+			args = append(args, o)
+		}
+		conditions = append(conditions, fmt.Sprintf("m.owner IN (%s)", strings.Join(placeholders, ",")))
+	}
+
+	if len(query.Statistics) > 0 {
+		placeholders := make([]string, len(query.Statistics))
+		for i, st := range query.Statistics {
+			placeholders[i] = "?"
+			args = append(args, st)
+		}
+		// Statistics are stored as a JSON array; match case-insensitively
+		// so statistic=sum finds CloudWatch's Sum
+		conditions = append(conditions, fmt.Sprintf("EXISTS (SELECT 1 FROM json_each(NULLIF(m.statistics, '')) WHERE value COLLATE NOCASE IN (%s))", strings.Join(placeholders, ",")))
+	}
+
+	if len(query.ComponentStabilities) > 0 {
+		placeholders := make([]string, len(query.ComponentStabilities))
+		for i, l := range query.ComponentStabilities {
+			placeholders[i] = "?"
+			args = append(args, l)
+		}
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (SELECT 1 FROM components c
+			WHERE c.component_type = m.component_type AND c.component_name = m.component_name
+			AND c.source_name = m.source_name AND c.metrics_stability IN (%s))`, strings.Join(placeholders, ",")))
+	}
+
 	if len(query.AttributeNames) > 0 {
 		placeholders := make([]string, len(query.AttributeNames))
 		for i, n := range query.AttributeNames {
@@ -388,14 +613,15 @@ func (s *SQLiteStore) Search(ctx context.Context, query SearchQuery) (*SearchRes
 		offset = 0
 	}
 
-	// Order by: metric_name matches first, then description matches, then alphabetically
-	orderClause := "ORDER BY m.metric_name"
+	// Order by: metric_name matches first, then description matches, then
+	// alphabetically, with the ID breaking ties so pages don't overlap
+	orderClause := "ORDER BY m.metric_name, m.id"
 	if query.Text != "" {
 		searchPattern := "%" + query.Text + "%"
 		orderClause = `ORDER BY
 			CASE WHEN m.metric_name LIKE ? THEN 0 ELSE 1 END,
 			CASE WHEN m.description LIKE ? THEN 0 ELSE 1 END,
-			m.metric_name`
+			m.metric_name, m.id`
 		args = append(args, searchPattern, searchPattern)
 	}
 
@@ -404,7 +630,9 @@ func (s *SQLiteStore) Search(ctx context.Context, query SearchQuery) (*SearchRes
 		SELECT id, metric_name, instrument_type, description, unit, enabled_by_default,
 			component_type, component_name, source_category, source_name, source_location,
 			extraction_method, source_confidence, repo, path, "commit", extracted_at,
-			semconv_match, semconv_name, semconv_stability
+			semconv_match, semconv_name, semconv_stability,
+			value_type, monotonic, aggregation_temporality, bucket_boundaries, signal, stability,
+			statistics, recommended_statistic, period, owner, orientation
 		FROM metrics m %s
 		%s
 		LIMIT ? OFFSET ?
@@ -424,12 +652,17 @@ func (s *SQLiteStore) Search(ctx context.Context, query SearchQuery) (*SearchRes
 		var enabledByDefault int
 		var description, unit, sourceLocation, repo, path, commit sql.NullString
 		var semconvMatch, semconvName, semconvStability sql.NullString
+		var valueType, temporality, bucketBoundaries, signal, stability sql.NullString
+		var statistics, recommendedStatistic, period, owner sql.NullString
+		var monotonic, orientation sql.NullInt64
 
 		if err := rows.Scan(
 			&metric.ID, &metric.MetricName, &metric.InstrumentType, &description, &unit, &enabledByDefault,
 			&metric.ComponentType, &metric.ComponentName, &metric.SourceCategory, &metric.SourceName, &sourceLocation,
 			&metric.ExtractionMethod, &metric.SourceConfidence, &repo, &path, &commit, &metric.ExtractedAt,
 			&semconvMatch, &semconvName, &semconvStability,
+			&valueType, &monotonic, &temporality, &bucketBoundaries, &signal, &stability,
+			&statistics, &recommendedStatistic, &period, &owner, &orientation,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan metric: %w", err)
 		}
@@ -444,6 +677,16 @@ func (s *SQLiteStore) Search(ctx context.Context, query SearchQuery) (*SearchRes
 		metric.SemconvMatch = domain.SemconvMatch(semconvMatch.String)
 		metric.SemconvName = semconvName.String
 		metric.SemconvStability = semconvStability.String
+		metric.Signal = domain.SignalKind(signal.String)
+		metric.Stability = domain.StabilityLevel(stability.String)
+		if err := setAggregationDetails(&metric, valueType, monotonic, temporality, bucketBoundaries); err != nil {
+			return nil, err
+		}
+		if err := setStatistics(&metric, statistics, recommendedStatistic, period); err != nil {
+			return nil, err
+		}
+		metric.Owner = owner.String
+		metric.Orientation = int(orientation.Int64)
 
 		// Get attributes (could be optimized with a join)
 		attrs, err := s.getMetricAttributes(ctx, metric.ID)
@@ -685,16 +928,28 @@ func (s *SQLiteStore) GetLatestExtractionRun(ctx context.Context, adapterName st
 	return &run, nil
 }
 
-func (s *SQLiteStore) GetSemconvMetrics(ctx context.Context) ([]*domain.CanonicalMetric, error) {
+func (s *SQLiteStore) GetSemconvMetrics(ctx context.Context, sourceNames ...string) ([]*domain.CanonicalMetric, error) {
+	if len(sourceNames) == 0 {
+		sourceNames = []string{SemconvSource}
+	}
+	placeholders := make([]string, len(sourceNames))
+	args := make([]interface{}, len(sourceNames))
+	for i, name := range sourceNames {
+		placeholders[i] = "?"
+		args[i] = name
+	}
+
 	query := `
 		SELECT id, metric_name, instrument_type, description, unit, enabled_by_default,
 			component_type, component_name, source_category, source_name, source_location,
 			extraction_method, source_confidence, repo, path, "commit", extracted_at,
-			semconv_match, semconv_name, semconv_stability
-		FROM metrics WHERE source_name = 'otel-semconv'
+			semconv_match, semconv_name, semconv_stability,
+			value_type, monotonic, aggregation_temporality, bucket_boundaries, signal, stability,
+			statistics, recommended_statistic, period, owner, orientation
+		FROM metrics WHERE source_name IN (` + strings.Join(placeholders, ",") + `)
 	`
 
-	rows, err := s.db.QueryContext(ctx, query)
+	rows, err := s.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query semconv metrics: %w", err)
 	}
@@ -706,12 +961,17 @@ func (s *SQLiteStore) GetSemconvMetrics(ctx context.Context) ([]*domain.Canonica
 		var enabledByDefault int
 		var description, unit, sourceLocation, repo, path, commit sql.NullString
 		var semconvMatch, semconvName, semconvStability sql.NullString
+		var valueType, temporality, bucketBoundaries, signal, stability sql.NullString
+		var statistics, recommendedStatistic, period, owner sql.NullString
+		var monotonic, orientation sql.NullInt64
 
 		if err := rows.Scan(
 			&metric.ID, &metric.MetricName, &metric.InstrumentType, &description, &unit, &enabledByDefault,
 			&metric.ComponentType, &metric.ComponentName, &metric.SourceCategory, &metric.SourceName, &sourceLocation,
 			&metric.ExtractionMethod, &metric.SourceConfidence, &repo, &path, &commit, &metric.ExtractedAt,
 			&semconvMatch, &semconvName, &semconvStability,
+			&valueType, &monotonic, &temporality, &bucketBoundaries, &signal, &stability,
+			&statistics, &recommendedStatistic, &period, &owner, &orientation,
 		); err != nil {
 			return nil, fmt.Errorf("failed to scan semconv metric: %w", err)
 		}
@@ -726,6 +986,16 @@ func (s *SQLiteStore) GetSemconvMetrics(ctx context.Context) ([]*domain.Canonica
 		metric.SemconvMatch = domain.SemconvMatch(semconvMatch.String)
 		metric.SemconvName = semconvName.String
 		metric.SemconvStability = semconvStability.String
+		metric.Signal = domain.SignalKind(signal.String)
+		metric.Stability = domain.StabilityLevel(stability.String)
+		if err := setAggregationDetails(&metric, valueType, monotonic, temporality, bucketBoundaries); err != nil {
+			return nil, err
+		}
+		if err := setStatistics(&metric, statistics, recommendedStatistic, period); err != nil {
+			return nil, err
+		}
+		metric.Owner = owner.String
+		metric.Orientation = int(orientation.Int64)
 
 		metrics = append(metrics, &metric)
 	}
@@ -737,6 +1007,165 @@ func (s *SQLiteStore) GetSemconvMetrics(ctx context.Context) ([]*domain.Canonica
 	return metrics, nil
 }
 
+func (s *SQLiteStore) UpsertComponents(ctx context.Context, components []*domain.Component) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, c := range components {
+		if err := s.upsertComponentTx(ctx, tx, c); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLiteStore) upsertComponentTx(ctx context.Context, tx *sql.Tx, c *domain.Component) error {
+	query := `
+		INSERT INTO components (
+			component_type, component_name, source_name, class, stability, metrics_stability,
+			distributions, codeowners, emeritus_codeowners, warnings,
+			repo, path, "commit", extracted_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, CURRENT_TIMESTAMP)
+		ON CONFLICT(component_type, component_name, source_name) DO UPDATE SET
+			class = excluded.class,
+			stability = excluded.stability,
+			metrics_stability = excluded.metrics_stability,
+			distributions = excluded.distributions,
+			codeowners = excluded.codeowners,
+			emeritus_codeowners = excluded.emeritus_codeowners,
+			warnings = excluded.warnings,
+			repo = excluded.repo,
+			path = excluded.path,
+			"commit" = excluded."commit",
+			extracted_at = excluded.extracted_at,
+			updated_at = CURRENT_TIMESTAMP
+	`
+
+	encoded := make([]string, 0, 5)
+	for _, v := range []any{c.Stability, c.Distributions, c.Codeowners, c.EmeritusCodeowners, c.Warnings} {
+		data, err := encodeJSONColumn(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode component %s/%s: %w", c.Type, c.Name, err)
+		}
+		encoded = append(encoded, data)
+	}
+
+	_, err := tx.ExecContext(ctx, query,
+		c.Type, c.Name, c.SourceName, c.Class, encoded[0], c.MetricsStability(),
+		encoded[1], encoded[2], encoded[3], encoded[4],
+		c.Repo, c.Path, c.Commit, c.ExtractedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert component: %w", err)
+	}
+	return nil
+}
+
+// ReplaceComponentMetrics swaps the metrics the component's source holds for
+// it with metrics and upserts the component, all in one transaction, so a
+// resubmission drops metrics it no longer lists.
+func (s *SQLiteStore) ReplaceComponentMetrics(ctx context.Context, component *domain.Component, metrics []*domain.CanonicalMetric) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx,
+		"DELETE FROM metrics WHERE source_name = ? AND component_type = ? AND component_name = ?",
+		component.SourceName, component.Type, component.Name,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete component metrics: %w", err)
+	}
+
+	for _, metric := range metrics {
+		metric.EnsureID()
+		if err := s.upsertMetricTx(ctx, tx, metric, false); err != nil {
+			return err
+		}
+	}
+	if err := s.upsertComponentTx(ctx, tx, component); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetComponent returns the component with the given type and name. When more
+// than one source describes it, sourceName picks one; otherwise the first by
+// source name is returned.
+func (s *SQLiteStore) GetComponent(ctx context.Context, componentType, name, sourceName string) (*domain.Component, error) {
+	query := `
+		SELECT component_type, component_name, source_name, class, stability,
+			distributions, codeowners, emeritus_codeowners, warnings,
+			repo, path, "commit", extracted_at
+		FROM components
+		WHERE component_type = ? AND component_name = ? AND (? = '' OR source_name = ?)
+		ORDER BY source_name LIMIT 1
+	`
+
+	var c domain.Component
+	var class, stability, distributions, codeowners, emeritus, warnings sql.NullString
+	var repo, path, commit sql.NullString
+
+	err := s.db.QueryRowContext(ctx, query, componentType, name, sourceName, sourceName).Scan(
+		&c.Type, &c.Name, &c.SourceName, &class, &stability,
+		&distributions, &codeowners, &emeritus, &warnings,
+		&repo, &path, &commit, &c.ExtractedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get component: %w", err)
+	}
+
+	c.Class = class.String
+	c.Repo = repo.String
+	c.Path = path.String
+	c.Commit = commit.String
+
+	columns := []struct {
+		data   sql.NullString
+		target any
+	}{
+		{stability, &c.Stability},
+		{distributions, &c.Distributions},
+		{codeowners, &c.Codeowners},
+		{emeritus, &c.EmeritusCodeowners},
+		{warnings, &c.Warnings},
+	}
+	for _, col := range columns {
+		if col.data.String == "" {
+			continue
+		}
+		if err := json.Unmarshal([]byte(col.data.String), col.target); err != nil {
+			return nil, fmt.Errorf("failed to decode component: %w", err)
+		}
+	}
+
+	return &c, nil
+}
+
+// encodeJSONColumn stores empty collections as an empty string so they read
+// back as nil.
+func encodeJSONColumn(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	switch string(data) {
+	case "null", "[]", "{}":
+		return "", nil
+	}
+	return string(data), nil
+}
+
 // RunMigrations creates the database schema
 func (s *SQLiteStore) RunMigrations(ctx context.Context) error {
 	migrations := []string{
@@ -761,6 +1190,17 @@ func (s *SQLiteStore) RunMigrations(ctx context.Context) error {
 			semconv_match       TEXT DEFAULT '',
 			semconv_name        TEXT DEFAULT '',
 			semconv_stability   TEXT DEFAULT '',
+			value_type          TEXT DEFAULT '',
+			monotonic           INTEGER,
+			aggregation_temporality TEXT DEFAULT '',
+			bucket_boundaries   TEXT DEFAULT '',
+			signal              TEXT DEFAULT 'metric',
+			stability           TEXT DEFAULT '',
+			statistics          TEXT DEFAULT '',
+			recommended_statistic TEXT DEFAULT '',
+			period              TEXT DEFAULT '',
+			owner               TEXT DEFAULT '',
+			orientation         INTEGER DEFAULT 0,
 			created_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 			updated_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 		)`,
@@ -772,6 +1212,11 @@ func (s *SQLiteStore) RunMigrations(ctx context.Context) error {
 		`CREATE INDEX IF NOT EXISTS idx_metrics_source_name ON metrics(source_name)`,
 		`CREATE INDEX IF NOT EXISTS idx_metrics_source_confidence ON metrics(source_confidence)`,
 		`CREATE INDEX IF NOT EXISTS idx_metrics_semconv_match ON metrics(semconv_match)`,
+		`CREATE INDEX IF NOT EXISTS idx_metrics_value_type ON metrics(value_type)`,
+		`CREATE INDEX IF NOT EXISTS idx_metrics_aggregation_temporality ON metrics(aggregation_temporality)`,
+		`CREATE INDEX IF NOT EXISTS idx_metrics_signal ON metrics(signal)`,
+		`CREATE INDEX IF NOT EXISTS idx_metrics_stability ON metrics(stability)`,
+		`CREATE INDEX IF NOT EXISTS idx_metrics_owner ON metrics(owner)`,
 		`CREATE TABLE IF NOT EXISTS metric_attributes (
 			id              INTEGER PRIMARY KEY AUTOINCREMENT,
 			metric_id       TEXT NOT NULL REFERENCES metrics(id) ON DELETE CASCADE,
@@ -779,6 +1224,8 @@ func (s *SQLiteStore) RunMigrations(ctx context.Context) error {
 			attribute_type  TEXT,
 			description     TEXT,
 			required        INTEGER DEFAULT 0,
+			inferred        INTEGER DEFAULT 0,
+			resource        INTEGER DEFAULT 0,
 			UNIQUE(metric_id, attribute_name)
 		)`,
 		`CREATE TABLE IF NOT EXISTS attribute_enum_values (
@@ -811,6 +1258,25 @@ func (s *SQLiteStore) RunMigrations(ctx context.Context) error {
 		`CREATE INDEX IF NOT EXISTS idx_extraction_runs_adapter ON extraction_runs(adapter_name)`,
 		`CREATE INDEX IF NOT EXISTS idx_extraction_runs_status ON extraction_runs(status)`,
 		`CREATE INDEX IF NOT EXISTS idx_extraction_runs_started_at ON extraction_runs(started_at)`,
+		`CREATE TABLE IF NOT EXISTS components (
+			component_type      TEXT NOT NULL,
+			component_name      TEXT NOT NULL,
+			source_name         TEXT NOT NULL,
+			class               TEXT,
+			stability           TEXT DEFAULT '',
+			metrics_stability   TEXT DEFAULT '',
+			distributions       TEXT DEFAULT '',
+			codeowners          TEXT DEFAULT '',
+			emeritus_codeowners TEXT DEFAULT '',
+			warnings            TEXT DEFAULT '',
+			repo                TEXT,
+			path                TEXT,
+			"commit"            TEXT,
+			extracted_at        TIMESTAMP NOT NULL,
+			updated_at          TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (component_type, component_name, source_name)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_components_metrics_stability ON components(metrics_stability)`,
 	}
 
 	for _, migration := range migrations {
@@ -6,7 +6,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
 func setupTestStore(t *testing.T) *SQLiteStore {
@@ -124,7 +124,7 @@ func TestSQLiteStore_UpsertMetric_Update(t *testing.T) {
 	// Update the metric
 	metric.Description = "Updated description"
 	metric.Attributes = []domain.Attribute{
-		{Name: "new_attr", Type: "int"},
+		{Name: "new_attr", Type: "int", Inferred: true},
 	}
 
 	if err := store.UpsertMetric(ctx, metric); err != nil {
@@ -147,6 +147,260 @@ func TestSQLiteStore_UpsertMetric_Update(t *testing.T) {
 	if got.Attributes[0].Name != "new_attr" {
 		t.Errorf("Attribute name = %q, want %q", got.Attributes[0].Name, "new_attr")
 	}
+
+	if !got.Attributes[0].Inferred {
+		t.Error("Attribute Inferred = false, want true")
+	}
+}
+
+func TestSQLiteStore_UpsertMetric_AggregationDetails(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	monotonic := true
+	metric := testMetric()
+	metric.InstrumentType = domain.InstrumentHistogram
+	metric.ValueType = domain.ValueTypeDouble
+	metric.Monotonic = &monotonic
+	metric.AggregationTemporality = domain.TemporalityCumulative
+	metric.BucketBoundaries = []float64{0.005, 0.01, 0.025, 2.5, 10}
+
+	if err := store.UpsertMetric(ctx, metric); err != nil {
+		t.Fatalf("UpsertMetric failed: %v", err)
+	}
+
+	got, err := store.GetMetric(ctx, metric.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+
+	if got.ValueType != domain.ValueTypeDouble {
+		t.Errorf("ValueType = %q, want %q", got.ValueType, domain.ValueTypeDouble)
+	}
+
+	if got.Monotonic == nil || !*got.Monotonic {
+		t.Errorf("Monotonic = %v, want true", got.Monotonic)
+	}
+
+	if got.AggregationTemporality != domain.TemporalityCumulative {
+		t.Errorf("AggregationTemporality = %q, want %q", got.AggregationTemporality, domain.TemporalityCumulative)
+	}
+
+	if len(got.BucketBoundaries) != len(metric.BucketBoundaries) {
+		t.Fatalf("BucketBoundaries = %v, want %v", got.BucketBoundaries, metric.BucketBoundaries)
+	}
+	for i, b := range metric.BucketBoundaries {
+		if got.BucketBoundaries[i] != b {
+			t.Errorf("BucketBoundaries[%d] = %v, want %v", i, got.BucketBoundaries[i], b)
+		}
+	}
+}
+
+func TestSQLiteStore_UpsertMetric_EventWithResourceAttributes(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	plain := testMetric()
+	if err := store.UpsertMetric(ctx, plain); err != nil {
+		t.Fatalf("UpsertMetric failed: %v", err)
+	}
+
+	event := testMetric()
+	event.Signal = domain.SignalKindEvent
+	event.InstrumentType = ""
+	event.Attributes = append(event.Attributes, domain.Attribute{Name: "host.name", Type: "string", Resource: true})
+	if err := store.UpsertMetric(ctx, event); err != nil {
+		t.Fatalf("UpsertMetric failed: %v", err)
+	}
+
+	got, err := store.GetMetric(ctx, event.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+	if got.Signal != domain.SignalKindEvent {
+		t.Errorf("Signal = %q, want %q", got.Signal, domain.SignalKindEvent)
+	}
+
+	var resource []string
+	for _, a := range got.Attributes {
+		if a.Resource {
+			resource = append(resource, a.Name)
+		}
+	}
+	if len(resource) != 1 || resource[0] != "host.name" {
+		t.Errorf("resource attributes = %v, want [host.name]", resource)
+	}
+
+	gotPlain, err := store.GetMetric(ctx, plain.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+	if gotPlain.Signal != domain.SignalKindMetric {
+		t.Errorf("Signal = %q, want default %q", gotPlain.Signal, domain.SignalKindMetric)
+	}
+
+	result, err := store.Search(ctx, SearchQuery{Signals: []domain.SignalKind{domain.SignalKindEvent}})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if result.Total != 1 || result.Metrics[0].ID != event.ID {
+		t.Errorf("expected only the event, got %d results", result.Total)
+	}
+}
+
+func TestSQLiteStore_UpsertMetric_Stability(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	stable := testMetric()
+	stable.Stability = domain.StabilityStable
+	alpha := testMetric()
+	alpha.MetricName = "system.cpu.time"
+	alpha.Stability = domain.StabilityAlpha
+	if err := store.UpsertMetrics(ctx, []*domain.CanonicalMetric{stable, alpha}); err != nil {
+		t.Fatalf("UpsertMetrics failed: %v", err)
+	}
+
+	got, err := store.GetMetric(ctx, stable.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+	if got.Stability != domain.StabilityStable {
+		t.Errorf("Stability = %q, want %q", got.Stability, domain.StabilityStable)
+	}
+
+	result, err := store.Search(ctx, SearchQuery{Stabilities: []domain.StabilityLevel{domain.StabilityAlpha}})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if result.Total != 1 || result.Metrics[0].ID != alpha.ID {
+		t.Errorf("expected only the alpha metric, got %d results", result.Total)
+	}
+}
+
+func TestSQLiteStore_UpsertMetric_Statistics(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	requests := testMetric()
+	requests.MetricName = "RequestCount"
+	requests.Statistics = []string{"Sum", "SampleCount"}
+	requests.RecommendedStatistic = "Sum"
+	requests.Period = "60s"
+	latency := testMetric()
+	latency.MetricName = "TargetResponseTime"
+	latency.Statistics = []string{"Average", "p99"}
+	latency.RecommendedStatistic = "Average"
+	plain := testMetric()
+	if err := store.UpsertMetrics(ctx, []*domain.CanonicalMetric{requests, latency, plain}); err != nil {
+		t.Fatalf("UpsertMetrics failed: %v", err)
+	}
+
+	got, err := store.GetMetric(ctx, requests.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+	if len(got.Statistics) != 2 || got.Statistics[0] != "Sum" || got.RecommendedStatistic != "Sum" || got.Period != "60s" {
+		t.Errorf("Statistics = %v, recommended %q, period %q", got.Statistics, got.RecommendedStatistic, got.Period)
+	}
+
+	result, err := store.Search(ctx, SearchQuery{Statistics: []string{"p99"}})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if result.Total != 1 || result.Metrics[0].ID != latency.ID {
+		t.Errorf("expected only the latency metric, got %d results", result.Total)
+	}
+
+	result, err = store.Search(ctx, SearchQuery{Statistics: []string{"sum"}})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if result.Total != 1 || result.Metrics[0].ID != requests.ID {
+		t.Errorf("expected the statistic filter to ignore case, got %d results", result.Total)
+	}
+}
+
+func TestSQLiteStore_UpsertMetric_Owner(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	orders := testMetric()
+	orders.MetricName = "checkout.orders"
+	orders.SourceCategory = domain.SourceInternal
+	orders.ComponentType = domain.ComponentService
+	orders.Owner = "payments-team"
+	plain := testMetric()
+	if err := store.UpsertMetrics(ctx, []*domain.CanonicalMetric{orders, plain}); err != nil {
+		t.Fatalf("UpsertMetrics failed: %v", err)
+	}
+
+	got, err := store.GetMetric(ctx, orders.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+	if got.Owner != "payments-team" || got.SourceCategory != domain.SourceInternal {
+		t.Errorf("Owner = %q, category %q", got.Owner, got.SourceCategory)
+	}
+
+	result, err := store.Search(ctx, SearchQuery{Owners: []string{"payments-team"}})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+	if result.Total != 1 || result.Metrics[0].ID != orders.ID {
+		t.Errorf("expected only the owned metric, got %d results", result.Total)
+	}
+}
+
+func TestSQLiteStore_UpsertMetric_Orientation(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	rejected := testMetric()
+	rejected.MetricName = "redis.net.rejected"
+	rejected.Orientation = -1
+	if err := store.UpsertMetric(ctx, rejected); err != nil {
+		t.Fatalf("UpsertMetric failed: %v", err)
+	}
+
+	got, err := store.GetMetric(ctx, rejected.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+	if got.Orientation != -1 {
+		t.Errorf("Orientation = %d, want -1", got.Orientation)
+	}
+}
+
+func TestSQLiteStore_GetSemconvMetrics(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	otel := testMetric()
+	otel.SourceName = SemconvSource
+	acme := testMetric()
+	acme.MetricName = "acme.jobs.active"
+	acme.SourceName = "acme-semconv"
+	other := testMetric()
+	if err := store.UpsertMetrics(ctx, []*domain.CanonicalMetric{otel, acme, other}); err != nil {
+		t.Fatalf("UpsertMetrics failed: %v", err)
+	}
+
+	metrics, err := store.GetSemconvMetrics(ctx)
+	if err != nil {
+		t.Fatalf("GetSemconvMetrics failed: %v", err)
+	}
+	if len(metrics) != 1 || metrics[0].SourceName != SemconvSource {
+		t.Errorf("expected only otel-semconv by default, got %d", len(metrics))
+	}
+
+	metrics, err = store.GetSemconvMetrics(ctx, SemconvSource, "acme-semconv")
+	if err != nil {
+		t.Fatalf("GetSemconvMetrics failed: %v", err)
+	}
+	if len(metrics) != 2 {
+		t.Errorf("expected the registry's metrics too, got %d", len(metrics))
+	}
 }
 
 func TestSQLiteStore_UpsertMetrics_Batch(t *testing.T) {
@@ -177,6 +431,36 @@ func TestSQLiteStore_UpsertMetrics_Batch(t *testing.T) {
 	}
 }
 
+func TestSQLiteStore_MergeMetrics_KeepsAttributes(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	first := testMetric()
+	first.Attributes = []domain.Attribute{{Name: "cpu", Type: "string"}}
+	if err := store.MergeMetrics(ctx, []*domain.CanonicalMetric{first}); err != nil {
+		t.Fatalf("MergeMetrics failed: %v", err)
+	}
+
+	second := testMetric()
+	second.Attributes = []domain.Attribute{{Name: "cpu", Type: "int"}, {Name: "state", Type: "string"}}
+	if err := store.MergeMetrics(ctx, []*domain.CanonicalMetric{second}); err != nil {
+		t.Fatalf("MergeMetrics failed: %v", err)
+	}
+
+	got, err := store.GetMetric(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("GetMetric failed: %v", err)
+	}
+
+	types := make(map[string]string)
+	for _, attr := range got.Attributes {
+		types[attr.Name] = attr.Type
+	}
+	if len(got.Attributes) != 2 || types["cpu"] != "string" || types["state"] != "string" {
+		t.Errorf("expected the attribute union with cpu kept as first stored, got %+v", got.Attributes)
+	}
+}
+
 func TestSQLiteStore_GetMetric_NotFound(t *testing.T) {
 	store := setupTestStore(t)
 	ctx := context.Background()
@@ -345,6 +629,36 @@ func TestSQLiteStore_Search_TextOrdering(t *testing.T) {
 	}
 }
 
+func TestSQLiteStore_Search_PagesSameNamedMetrics(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	var metrics []*domain.CanonicalMetric
+	for _, source := range []string{"source-c", "source-a", "source-b"} {
+		m := testMetric()
+		m.SourceName = source
+		metrics = append(metrics, m)
+	}
+	if err := store.UpsertMetrics(ctx, metrics); err != nil {
+		t.Fatalf("UpsertMetrics failed: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for offset := 0; offset < len(metrics); offset++ {
+		result, err := store.Search(ctx, SearchQuery{Limit: 1, Offset: offset})
+		if err != nil {
+			t.Fatalf("Search failed: %v", err)
+		}
+		if result.Total != len(metrics) || len(result.Metrics) != 1 {
+			t.Fatalf("unexpected page at offset %d: total %d, %d metrics", offset, result.Total, len(result.Metrics))
+		}
+		seen[result.Metrics[0].ID] = true
+	}
+	if len(seen) != len(metrics) {
+		t.Errorf("expected every metric once across pages, got %d distinct", len(seen))
+	}
+}
+
 func TestSQLiteStore_Search_TextOnlyMatchesMetricNameAndDescription(t *testing.T) {
 	store := setupTestStore(t)
 	ctx := context.Background()
@@ -395,6 +709,9 @@ func TestSQLiteStore_Search_Filters(t *testing.T) {
 			m := testMetric()
 			m.MetricName = "http.duration"
 			m.InstrumentType = domain.InstrumentHistogram
+			m.ValueType = domain.ValueTypeDouble
+			m.AggregationTemporality = domain.TemporalityDelta
+			m.BucketBoundaries = []float64{0.1, 0.5, 1}
 			return m
 		}(),
 	}
@@ -403,6 +720,8 @@ func TestSQLiteStore_Search_Filters(t *testing.T) {
 		t.Fatalf("UpsertMetrics failed: %v", err)
 	}
 
+	monotonic := false
+
 	tests := []struct {
 		name  string
 		query SearchQuery
@@ -428,6 +747,26 @@ func TestSQLiteStore_Search_Filters(t *testing.T) {
 			query: SearchQuery{ConfidenceLevels: []domain.ConfidenceLevel{domain.ConfidenceAuthoritative}},
 			want:  2,
 		},
+		{
+			name:  "filter by value type",
+			query: SearchQuery{ValueTypes: []domain.ValueType{domain.ValueTypeDouble}},
+			want:  1,
+		},
+		{
+			name:  "filter by aggregation temporality",
+			query: SearchQuery{AggregationTemporalities: []domain.AggregationTemporality{domain.TemporalityDelta}},
+			want:  1,
+		},
+		{
+			name:  "filter by monotonic",
+			query: SearchQuery{Monotonic: &monotonic},
+			want:  0,
+		},
+		{
+			name:  "filter by bucket boundaries",
+			query: SearchQuery{HasBucketBoundaries: true},
+			want:  1,
+		},
 		{
 			name:  "combined filters",
 			query: SearchQuery{InstrumentTypes: []domain.InstrumentType{domain.InstrumentHistogram}, ComponentTypes: []domain.ComponentType{domain.ComponentReceiver}},
@@ -449,6 +788,135 @@ func TestSQLiteStore_Search_Filters(t *testing.T) {
 	}
 }
 
+func testComponent() *domain.Component {
+	return &domain.Component{
+		Type:               domain.ComponentReceiver,
+		Name:               "hostmetrics",
+		SourceName:         "opentelemetry-collector-contrib",
+		Class:              "receiver",
+		Stability:          map[string]domain.StabilityLevel{"metrics": domain.StabilityBeta},
+		Distributions:      []string{"core", "contrib"},
+		Codeowners:         []string{"dmitryax"},
+		EmeritusCodeowners: []string{"someone"},
+		Warnings: []domain.ComponentWarning{
+			{Metric: "system.cpu.utilization", Kind: "if_enabled_not_set", Message: "Will be disabled by default"},
+		},
+		Repo:        "https://github.com/open-telemetry/opentelemetry-collector-contrib",
+		Path:        "receiver/hostmetricsreceiver/metadata.yaml",
+		Commit:      "abc123",
+		ExtractedAt: time.Now(),
+	}
+}
+
+func TestSQLiteStore_UpsertAndGetComponent(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	if err := store.UpsertComponents(ctx, []*domain.Component{testComponent()}); err != nil {
+		t.Fatalf("UpsertComponents failed: %v", err)
+	}
+
+	updated := testComponent()
+	updated.Stability["metrics"] = domain.StabilityStable
+	updated.Warnings = nil
+	if err := store.UpsertComponents(ctx, []*domain.Component{updated}); err != nil {
+		t.Fatalf("UpsertComponents update failed: %v", err)
+	}
+
+	got, err := store.GetComponent(ctx, "receiver", "hostmetrics", "")
+	if err != nil {
+		t.Fatalf("GetComponent failed: %v", err)
+	}
+	if got == nil {
+		t.Fatal("GetComponent returned nil")
+	}
+
+	if got.Stability["metrics"] != domain.StabilityStable {
+		t.Errorf("Stability = %v, want metrics=stable", got.Stability)
+	}
+	if len(got.Distributions) != 2 || got.Distributions[0] != "core" {
+		t.Errorf("Distributions = %v", got.Distributions)
+	}
+	if len(got.Codeowners) != 1 || len(got.EmeritusCodeowners) != 1 {
+		t.Errorf("Codeowners = %v / %v", got.Codeowners, got.EmeritusCodeowners)
+	}
+	if got.Warnings != nil {
+		t.Errorf("Warnings = %v, want none", got.Warnings)
+	}
+	if got.Commit != "abc123" || got.Class != "receiver" {
+		t.Errorf("unexpected provenance: commit %q class %q", got.Commit, got.Class)
+	}
+
+	missing, err := store.GetComponent(ctx, "receiver", "hostmetrics", "other-source")
+	if err != nil {
+		t.Fatalf("GetComponent failed: %v", err)
+	}
+	if missing != nil {
+		t.Errorf("expected no component for other source, got %+v", missing)
+	}
+}
+
+func TestSQLiteStore_ReplaceComponentMetrics(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	retired := testMetric()
+	retired.MetricName = "system.cpu.retired"
+	other := testMetric()
+	other.MetricName = "container.cpu.usage"
+	other.ComponentName = "dockerstats"
+	if err := store.UpsertMetrics(ctx, []*domain.CanonicalMetric{testMetric(), retired, other}); err != nil {
+		t.Fatalf("UpsertMetrics failed: %v", err)
+	}
+
+	kept := testMetric()
+	kept.Description = "Resubmitted"
+	if err := store.ReplaceComponentMetrics(ctx, testComponent(), []*domain.CanonicalMetric{kept}); err != nil {
+		t.Fatalf("ReplaceComponentMetrics failed: %v", err)
+	}
+
+	if got, _ := store.GetMetric(ctx, retired.ID); got != nil {
+		t.Error("expected the metric missing from the resubmission to be deleted")
+	}
+	if got, _ := store.GetMetric(ctx, kept.ID); got == nil || got.Description != "Resubmitted" {
+		t.Errorf("expected the resubmitted metric, got %+v", got)
+	}
+	if got, _ := store.GetMetric(ctx, other.ID); got == nil {
+		t.Error("expected another component's metric to remain")
+	}
+	if got, _ := store.GetComponent(ctx, "receiver", "hostmetrics", ""); got == nil {
+		t.Error("expected the component to be stored")
+	}
+}
+
+func TestSQLiteStore_Search_ComponentStability(t *testing.T) {
+	store := setupTestStore(t)
+	ctx := context.Background()
+
+	other := testMetric()
+	other.MetricName = "system.memory.usage"
+	other.ComponentName = "dockerstats"
+	if err := store.UpsertMetrics(ctx, []*domain.CanonicalMetric{testMetric(), other}); err != nil {
+		t.Fatalf("UpsertMetrics failed: %v", err)
+	}
+
+	alpha := testComponent()
+	alpha.Name = "dockerstats"
+	alpha.Stability = map[string]domain.StabilityLevel{"metrics": domain.StabilityAlpha}
+	if err := store.UpsertComponents(ctx, []*domain.Component{testComponent(), alpha}); err != nil {
+		t.Fatalf("UpsertComponents failed: %v", err)
+	}
+
+	result, err := store.Search(ctx, SearchQuery{ComponentStabilities: []domain.StabilityLevel{domain.StabilityBeta}})
+	if err != nil {
+		t.Fatalf("Search failed: %v", err)
+	}
+
+	if result.Total != 1 || result.Metrics[0].ComponentName != "hostmetrics" {
+		t.Errorf("expected only the beta component's metric, got %d results", result.Total)
+	}
+}
+
 func TestSQLiteStore_Search_Pagination(t *testing.T) {
 	store := setupTestStore(t)
 	ctx := context.Background()
@@ -4,9 +4,13 @@ import (
 	"context"
 	"time"
 
-	"github.com/base-14/metric-library/internal/domain"
+	"github.com/base-14/metric-library/pkg/domain"
 )
 
+// SemconvSource is the source name of the OpenTelemetry semantic
+// conventions.
+const SemconvSource = "otel-semconv"
+
 type SearchQuery struct {
 	Text             string
 	InstrumentTypes  []domain.InstrumentType
@@ -18,8 +22,23 @@ type SearchQuery struct {
 	SemconvMatches   []domain.SemconvMatch
 	Units            []string
 	AttributeNames   []string
-	Limit            int
-	Offset           int
+	Signals          []domain.SignalKind
+	Stabilities      []domain.StabilityLevel
+	Owners           []string
+
+	// Aggregation filters
+	ValueTypes               []domain.ValueType
+	AggregationTemporalities []domain.AggregationTemporality
+	Monotonic                *bool
+	HasBucketBoundaries      bool
+	// Statistics matches metrics offering any of the named statistics
+	Statistics []string
+
+	// Stability of the metrics signal on the component that emits the metric
+	ComponentStabilities []domain.StabilityLevel
+
+	Limit  int
+	Offset int
 }
 
 type SearchResult struct {
@@ -58,6 +77,10 @@ type Store interface {
 	// Metrics
 	UpsertMetric(ctx context.Context, metric *domain.CanonicalMetric) error
 	UpsertMetrics(ctx context.Context, metrics []*domain.CanonicalMetric) error
+	// MergeMetrics upserts like UpsertMetrics but adds the attributes to
+	// those already stored rather than replacing them, for push sources
+	// that only see part of a metric's label set at a time
+	MergeMetrics(ctx context.Context, metrics []*domain.CanonicalMetric) error
 	GetMetric(ctx context.Context, id string) (*domain.CanonicalMetric, error)
 	DeleteMetric(ctx context.Context, id string) error
 	DeleteMetricsBySource(ctx context.Context, sourceName string) error
@@ -67,8 +90,16 @@ type Store interface {
 	GetFacetCounts(ctx context.Context) (*FacetCounts, error)
 	GetFilteredFacetCounts(ctx context.Context, query FacetQuery) (*FacetCounts, error)
 
-	// Semconv
-	GetSemconvMetrics(ctx context.Context) ([]*domain.CanonicalMetric, error)
+	// Components
+	UpsertComponents(ctx context.Context, components []*domain.Component) error
+	// ReplaceComponentMetrics replaces the metrics of the component's source
+	// and component with metrics, and upserts the component, atomically
+	ReplaceComponentMetrics(ctx context.Context, component *domain.Component, metrics []*domain.CanonicalMetric) error
+	GetComponent(ctx context.Context, componentType, name, sourceName string) (*domain.Component, error)
+
+	// Semconv metrics are those of the named sources, SemconvSource when
+	// none are given
+	GetSemconvMetrics(ctx context.Context, sourceNames ...string) ([]*domain.CanonicalMetric, error)
 
 	// Extraction runs
 	CreateExtractionRun(ctx context.Context, run *ExtractionRun) error
@@ -0,0 +1,71 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+type FetchOptions struct {
+	Commit   string
+	CacheDir string
+	Force    bool
+}
+
+type FetchResult struct {
+	RepoPath  string
+	Commit    string
+	Timestamp time.Time
+	Files     []string
+}
+
+type RawMetric struct {
+	Name             string
+	InstrumentType   string
+	Description      string
+	Unit             string
+	Attributes       []domain.Attribute
+	EnabledByDefault bool
+	ComponentType    string
+	ComponentName    string
+	SourceLocation   string
+	Path             string
+
+	// Signal defaults to metric when empty
+	Signal string
+	// Stability is the upstream stability level, when the source declares one
+	Stability string
+
+	ValueType              string
+	Monotonic              *bool
+	AggregationTemporality string
+	BucketBoundaries       []float64
+
+	// Statistics are the aggregations a metrics backend offers for the
+	// metric (CloudWatch statistics, Azure aggregation types), with the
+	// recommended one and the publishing period as a duration like 60s
+	Statistics           []string
+	RecommendedStatistic string
+	Period               string
+
+	// Orientation is 1 when a higher value is better, -1 when a lower one
+	// is, as vendors such as Datadog declare it
+	Orientation int
+}
+
+type Adapter interface {
+	Name() string
+	Fetch(ctx context.Context, opts FetchOptions) (*FetchResult, error)
+	Extract(ctx context.Context, result *FetchResult) ([]*RawMetric, error)
+	SourceCategory() domain.SourceCategory
+	Confidence() domain.ConfidenceLevel
+	ExtractionMethod() domain.ExtractionMethod
+	RepoURL() string
+}
+
+// ComponentExtractor is implemented by adapters whose sources also describe
+// the components behind the metrics (stability, codeowners, warnings).
+type ComponentExtractor interface {
+	ExtractComponents(ctx context.Context, result *FetchResult) ([]*domain.Component, error)
+}
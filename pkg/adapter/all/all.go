@@ -0,0 +1,51 @@
+// Package all registers every built-in adapter. A binary that imports it,
+// usually blank, can build any of them by name through adapter.New.
+package all
+
+import (
+	_ "github.com/base-14/metric-library/internal/adapter/clouddata"
+	_ "github.com/base-14/metric-library/internal/adapter/codingagent/claudecode"
+	_ "github.com/base-14/metric-library/internal/adapter/codingagent/codex"
+	_ "github.com/base-14/metric-library/internal/adapter/codingagent/gemini"
+	_ "github.com/base-14/metric-library/internal/adapter/datadog"
+	_ "github.com/base-14/metric-library/internal/adapter/jmx/exporter"
+	_ "github.com/base-14/metric-library/internal/adapter/kubernetes/cadvisor"
+	_ "github.com/base-14/metric-library/internal/adapter/kubernetes/controlplane"
+	_ "github.com/base-14/metric-library/internal/adapter/kubernetes/coredns"
+	_ "github.com/base-14/metric-library/internal/adapter/kubernetes/etcd"
+	_ "github.com/base-14/metric-library/internal/adapter/kubernetes/ksm"
+	_ "github.com/base-14/metric-library/internal/adapter/llm/openlit"
+	_ "github.com/base-14/metric-library/internal/adapter/llm/openllmetry"
+	_ "github.com/base-14/metric-library/internal/adapter/mesh/envoy"
+	_ "github.com/base-14/metric-library/internal/adapter/mesh/istio"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/dotnet"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/golang"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/java"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/jmx"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/js"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/python"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/rust"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/semconv"
+	_ "github.com/base-14/metric-library/internal/adapter/otel/weaver"
+	_ "github.com/base-14/metric-library/internal/adapter/otelcontrib"
+	_ "github.com/base-14/metric-library/internal/adapter/otelcore"
+	_ "github.com/base-14/metric-library/internal/adapter/otlp"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/clickhouse"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/clientgolang"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/cockroachdb"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/elasticsearch"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/haproxy"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/kafka"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/memcached"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/micrometer"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/mongodb"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/mysql"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/nats"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/nginx"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/node"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/postgres"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/rabbitmq"
+	_ "github.com/base-14/metric-library/internal/adapter/prometheus/redis"
+	_ "github.com/base-14/metric-library/internal/adapter/scrape"
+	_ "github.com/base-14/metric-library/internal/adapter/telegraf"
+)
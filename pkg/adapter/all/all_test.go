@@ -0,0 +1,36 @@
+package all
+
+import (
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/adapter"
+)
+
+func TestRegistrationsBuild(t *testing.T) {
+	registrations := adapter.Registrations()
+	if len(registrations) < 80 {
+		t.Fatalf("expected every built-in adapter to register, got %d", len(registrations))
+	}
+
+	opts := adapter.Options{CacheDir: t.TempDir(), File: "metrics.txt", Component: "app"}
+	for _, r := range registrations {
+		if !r.Category.IsValid() {
+			t.Errorf("%s: invalid category %q", r.Name, r.Category)
+		}
+		if r.Description == "" {
+			t.Errorf("%s: missing description", r.Name)
+		}
+
+		adp, err := r.New(opts)
+		if err != nil {
+			t.Errorf("%s: %v", r.Name, err)
+			continue
+		}
+		if adp.Name() != r.Name {
+			t.Errorf("registered as %s but named %s", r.Name, adp.Name())
+		}
+		if adp.SourceCategory() != r.Category {
+			t.Errorf("%s: registered as %s but reports %s", r.Name, r.Category, adp.SourceCategory())
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package adapter
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+var numberLiteral = regexp.MustCompile(`^-?(?:\d+\.?\d*|\.\d+)(?:[eE][-+]?\d+)?$`)
+
+// InferredAttributes builds the attributes a source parser found at an
+// instrument's call sites. types maps the keys whose value type the source
+// made plain; the others are left untyped.
+func InferredAttributes(names []string, types map[string]string) []domain.Attribute {
+	attrs := make([]domain.Attribute, 0, len(names))
+	for _, name := range names {
+		attrs = append(attrs, domain.Attribute{
+			Name:     name,
+			Type:     types[name],
+			Inferred: true,
+		})
+	}
+	return attrs
+}
+
+// MergeAttributes appends the attributes of more that attrs doesn't already
+// name, filling in a type attrs left empty.
+func MergeAttributes(attrs, more []domain.Attribute) []domain.Attribute {
+	for _, attr := range more {
+		found := false
+		for i, a := range attrs {
+			if a.Name == attr.Name {
+				found = true
+				if a.Type == "" {
+					attrs[i].Type = attr.Type
+				}
+				break
+			}
+		}
+		if !found {
+			attrs = append(attrs, attr)
+		}
+	}
+	return attrs
+}
+
+// AppendKey adds an attribute key found in source to keys, skipping empty
+// and repeated ones.
+func AppendKey(keys []string, key string) []string {
+	if key == "" || slices.Contains(keys, key) {
+		return keys
+	}
+	return append(keys, key)
+}
+
+// MergeKeys appends the keys of more that keys doesn't already hold.
+func MergeKeys(keys, more []string) []string {
+	for _, key := range more {
+		keys = AppendKey(keys, key)
+	}
+	return keys
+}
+
+// AssignedVariable returns the variable or field an instrument built at pos
+// in content is assigned to, using the language's assignment pattern, whose
+// first group is the name. A blank identifier counts as none.
+func AssignedVariable(pattern *regexp.Regexp, content string, pos int) string {
+	match := pattern.FindStringSubmatch(strings.TrimRight(content[:pos], " \t\r\n"))
+	if len(match) > 1 && match[1] != "_" {
+		return match[1]
+	}
+	return ""
+}
+
+// ParseNumberList parses comma-separated numeric literals such as
+// histogram bucket boundaries. trim, when set, first strips a language's
+// literal suffixes from each entry. A named constant or spread slice can't
+// be resolved, and partial boundaries mislead, so any entry that isn't a
+// plain literal voids the whole list.
+func ParseNumberList(list string, trim func(string) string) []float64 {
+	var values []float64
+	for _, part := range strings.Split(list, ",") {
+		part = strings.TrimSpace(part)
+		if trim != nil {
+			part = trim(part)
+		}
+		if part == "" {
+			continue
+		}
+		if !numberLiteral.MatchString(part) {
+			return nil
+		}
+		value, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return nil
+		}
+		values = append(values, value)
+	}
+	return values
+}
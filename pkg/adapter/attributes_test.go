@@ -0,0 +1,95 @@
+package adapter
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+func TestInferredAttributes_KeepsParsedTypes(t *testing.T) {
+	attrs := InferredAttributes([]string{"http.route", "rpc.grpc.status_code"}, map[string]string{"rpc.grpc.status_code": "int"})
+
+	if len(attrs) != 2 {
+		t.Fatalf("expected 2 attributes, got %d", len(attrs))
+	}
+	if attrs[0].Type != "" || !attrs[0].Inferred {
+		t.Errorf("expected an untyped inferred http.route, got %+v", attrs[0])
+	}
+	if attrs[1].Type != "int" {
+		t.Errorf("expected rpc.grpc.status_code to stay int, got %q", attrs[1].Type)
+	}
+}
+
+func TestMergeAttributes(t *testing.T) {
+	attrs := []domain.Attribute{{Name: "http.route"}, {Name: "http.request.method", Type: "string"}}
+	more := []domain.Attribute{{Name: "http.route", Type: "string"}, {Name: "http.request.method", Type: "int"}, {Name: "server.port", Type: "int"}}
+
+	merged := MergeAttributes(attrs, more)
+
+	if len(merged) != 3 {
+		t.Fatalf("expected 3 attributes, got %v", merged)
+	}
+	if merged[0].Type != "string" {
+		t.Errorf("expected the missing type to be filled in, got %q", merged[0].Type)
+	}
+	if merged[1].Type != "string" {
+		t.Errorf("expected the existing type to win, got %q", merged[1].Type)
+	}
+}
+
+func TestMergeKeys(t *testing.T) {
+	keys := AppendKey([]string{"http.route"}, "")
+	keys = MergeKeys(keys, []string{"http.route", "server.port", "server.port"})
+
+	if !slices.Equal(keys, []string{"http.route", "server.port"}) {
+		t.Errorf("expected each key once, got %v", keys)
+	}
+}
+
+func TestAssignedVariable(t *testing.T) {
+	pattern := regexp.MustCompile(`(\w+)\s*:?=\s*$`)
+
+	tests := []struct {
+		content  string
+		expected string
+	}{
+		{"requests := meter.Int64Counter(", "requests"},
+		{"_ = meter.Int64Counter(", ""},
+		{"meter.Int64Counter(", ""},
+	}
+
+	for _, tt := range tests {
+		pos := strings.Index(tt.content, "meter.")
+		if got := AssignedVariable(pattern, tt.content, pos); got != tt.expected {
+			t.Errorf("AssignedVariable(%q) = %q, expected %q", tt.content, got, tt.expected)
+		}
+	}
+}
+
+func TestParseNumberList(t *testing.T) {
+	trimJava := func(part string) string { return strings.TrimRight(part, "dDfFlL") }
+
+	tests := []struct {
+		name     string
+		list     string
+		trim     func(string) string
+		expected []float64
+	}{
+		{"literals", "0.005, 0.01, 1e3,", nil, []float64{0.005, 0.01, 1000}},
+		{"suffixes trimmed", "0.5d, 1L, 2.5f", trimJava, []float64{0.5, 1, 2.5}},
+		{"suffixes without trim", "0.5d, 1", nil, nil},
+		{"named constant", "0.1, MAX_LATENCY", nil, nil},
+		{"spread slice", "buckets...", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseNumberList(tt.list, tt.trim); !slices.Equal(got, tt.expected) {
+				t.Errorf("ParseNumberList(%q) = %v, expected %v", tt.list, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package adapter
+
+import (
+	"testing"
+)
+
+func TestMatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "pkg/a/b.go", true},
+		{"pkg/**/metrics.yaml", "pkg/metrics.yaml", true},
+		{"pkg/**/metrics.yaml", "pkg/a/b/metrics.yaml", true},
+		{"pkg/*.go", "pkg/a/b.go", false},
+		{"**/*_test.go", "pkg/a_test.go", true},
+		{"docs/*.csv", "docs/metrics.csv", true},
+	}
+
+	for _, tt := range tests {
+		if got := MatchGlob(tt.pattern, tt.name); got != tt.want {
+			t.Errorf("MatchGlob(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package adapter
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Default refresh schedules, as cron expressions. Sources tracked in git
+// move daily; documentation scrapes and embedded tables change rarely.
+// Adapters reading caller-supplied input have no schedule.
+const (
+	ScheduleDaily  = "0 2 * * *"
+	ScheduleWeekly = "0 3 * * 0"
+)
+
+// Options are what a Factory may need to build its adapter. Most only use
+// CacheDir; File, Component and Source configure the scrape and otlp
+// adapters, which read caller-supplied input.
+type Options struct {
+	CacheDir  string
+	File      string
+	Component string
+	Source    string
+}
+
+// Factory builds a registered adapter.
+type Factory func(opts Options) (Adapter, error)
+
+// Registration describes an adapter to the registry. Adapter packages
+// register themselves from init, so a binary includes an adapter by
+// importing its package, usually blank.
+type Registration struct {
+	Name        string                `json:"name"`
+	Category    domain.SourceCategory `json:"category"`
+	Description string                `json:"description"`
+	// Schedule is the default refresh schedule as a cron expression, empty
+	// for adapters that only run on demand
+	Schedule string   `json:"schedule,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	New      Factory  `json:"-"`
+}
+
+var (
+	registryMu    sync.RWMutex
+	registrations = make(map[string]Registration)
+)
+
+// Register adds an adapter. It panics if the name is empty, has no
+// factory or is already taken, all of which are programming errors.
+func Register(r Registration) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if r.Name == "" || r.New == nil {
+		panic("adapter: Register needs a name and a factory")
+	}
+	if _, dup := registrations[r.Name]; dup {
+		panic("adapter: Register called twice for " + r.Name)
+	}
+	registrations[r.Name] = r
+}
+
+// Lookup returns the registration for name.
+func Lookup(name string) (Registration, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	r, ok := registrations[name]
+	return r, ok
+}
+
+// Registrations lists every registered adapter by name.
+func Registrations() []Registration {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	result := make([]Registration, 0, len(registrations))
+	for _, r := range registrations {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// New builds the registered adapter called name.
+func New(name string, opts Options) (Adapter, error) {
+	r, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("unknown adapter: %s", name)
+	}
+	return r.New(opts)
+}
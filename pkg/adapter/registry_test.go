@@ -0,0 +1,120 @@
+package adapter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+type mockAdapter struct {
+	name string
+}
+
+func (m *mockAdapter) Name() string {
+	return m.name
+}
+
+func (m *mockAdapter) Fetch(_ context.Context, _ FetchOptions) (*FetchResult, error) {
+	return nil, nil
+}
+
+func (m *mockAdapter) Extract(_ context.Context, _ *FetchResult) ([]*RawMetric, error) {
+	return nil, nil
+}
+
+func (m *mockAdapter) SourceCategory() domain.SourceCategory {
+	return domain.SourceOTEL
+}
+
+func (m *mockAdapter) Confidence() domain.ConfidenceLevel {
+	return domain.ConfidenceAuthoritative
+}
+
+func (m *mockAdapter) ExtractionMethod() domain.ExtractionMethod {
+	return domain.ExtractionMetadata
+}
+
+func (m *mockAdapter) RepoURL() string {
+	return "https://github.com/test/repo"
+}
+
+func TestRegister_LookupAndNew(t *testing.T) {
+	Register(Registration{
+		Name:     "registry-test",
+		Category: domain.SourceOTEL,
+		Schedule: ScheduleDaily,
+		New: func(opts Options) (Adapter, error) {
+			return &mockAdapter{name: "registry-test:" + opts.CacheDir}, nil
+		},
+	})
+
+	r, ok := Lookup("registry-test")
+	if !ok {
+		t.Fatal("expected registry-test to be registered")
+	}
+	if r.Schedule != ScheduleDaily {
+		t.Errorf("unexpected schedule: %s", r.Schedule)
+	}
+
+	adp, err := New("registry-test", Options{CacheDir: "/tmp/cache"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if adp.Name() != "registry-test:/tmp/cache" {
+		t.Errorf("factory did not receive options: %s", adp.Name())
+	}
+
+	found := false
+	for _, r := range Registrations() {
+		if r.Name == "registry-test" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected registry-test in Registrations")
+	}
+}
+
+func TestRegister_Duplicate(t *testing.T) {
+	reg := Registration{
+		Name: "registry-duplicate",
+		New:  func(Options) (Adapter, error) { return &mockAdapter{}, nil },
+	}
+	Register(reg)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a duplicate registration to panic")
+		}
+	}()
+	Register(reg)
+}
+
+func TestRegister_MissingFactory(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a registration without a factory to panic")
+		}
+	}()
+	Register(Registration{Name: "registry-no-factory"})
+}
+
+func TestNew_Unknown(t *testing.T) {
+	if _, err := New("no-such-adapter", Options{}); err == nil {
+		t.Error("expected an error for an unknown adapter")
+	}
+}
+
+func TestRegistrations_Sorted(t *testing.T) {
+	for _, name := range []string{"registry-sort-b", "registry-sort-a"} {
+		Register(Registration{Name: name, New: func(Options) (Adapter, error) { return &mockAdapter{}, nil }})
+	}
+
+	all := Registrations()
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Name > all[i].Name {
+			t.Fatalf("registrations not sorted: %s before %s", all[i-1].Name, all[i].Name)
+		}
+	}
+}
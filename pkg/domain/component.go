@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// SignalMetrics is the stability key used for a component's metrics pipeline.
+const SignalMetrics = "metrics"
+
+type ComponentWarning struct {
+	Metric  string `json:"metric,omitempty"`
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+type Component struct {
+	Type               ComponentType             `json:"type"`
+	Name               string                    `json:"name"`
+	SourceName         string                    `json:"source_name"`
+	Class              string                    `json:"class,omitempty"`
+	Stability          map[string]StabilityLevel `json:"stability"`
+	Distributions      []string                  `json:"distributions,omitempty"`
+	Codeowners         []string                  `json:"codeowners,omitempty"`
+	EmeritusCodeowners []string                  `json:"emeritus_codeowners,omitempty"`
+	Warnings           []ComponentWarning        `json:"warnings,omitempty"`
+	Repo               string                    `json:"repo"`
+	Path               string                    `json:"path"`
+	Commit             string                    `json:"commit"`
+	ExtractedAt        time.Time                 `json:"extracted_at"`
+}
+
+var ErrInvalidStability = errors.New("invalid stability level")
+
+func (c *Component) Validate() error {
+	if c.Name == "" {
+		return ErrEmptyComponentName
+	}
+	if c.SourceName == "" {
+		return ErrEmptySourceName
+	}
+	if !c.Type.IsValid() {
+		return ErrInvalidComponent
+	}
+	for _, level := range c.Stability {
+		if !level.IsValid() {
+			return ErrInvalidStability
+		}
+	}
+	return nil
+}
+
+// MetricsStability is the level the component's metrics are filtered by.
+// Components without a metrics signal (extensions, for instance) fall back to
+// their only level when every signal they declare shares it.
+func (c *Component) MetricsStability() StabilityLevel {
+	if level, ok := c.Stability[SignalMetrics]; ok {
+		return level
+	}
+
+	var only StabilityLevel
+	for _, level := range c.Stability {
+		if only != "" && level != only {
+			return ""
+		}
+		only = level
+	}
+	return only
+}
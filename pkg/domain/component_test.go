@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func validComponent() *Component {
+	return &Component{
+		Type:       ComponentReceiver,
+		Name:       "mysql",
+		SourceName: "opentelemetry-collector-contrib",
+		Stability:  map[string]StabilityLevel{"metrics": StabilityBeta},
+	}
+}
+
+func TestComponent_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*Component)
+		wantErr error
+	}{
+		{"valid component", func(c *Component) {}, nil},
+		{"empty name", func(c *Component) { c.Name = "" }, ErrEmptyComponentName},
+		{"empty source name", func(c *Component) { c.SourceName = "" }, ErrEmptySourceName},
+		{"invalid type", func(c *Component) { c.Type = "invalid" }, ErrInvalidComponent},
+		{"invalid stability", func(c *Component) { c.Stability["logs"] = "experimental" }, ErrInvalidStability},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := validComponent()
+			tt.modify(c)
+			err := c.Validate()
+
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Validate() unexpected error: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestComponent_MetricsStability(t *testing.T) {
+	tests := []struct {
+		name      string
+		stability map[string]StabilityLevel
+		want      StabilityLevel
+	}{
+		{"metrics signal", map[string]StabilityLevel{"metrics": StabilityBeta, "logs": StabilityAlpha}, StabilityBeta},
+		{"single level", map[string]StabilityLevel{"extension": StabilityStable}, StabilityStable},
+		{"mixed levels without metrics", map[string]StabilityLevel{"traces": StabilityBeta, "logs": StabilityAlpha}, ""},
+		{"no stability", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Component{Stability: tt.stability}
+			if got := c.MetricsStability(); got != tt.want {
+				t.Errorf("MetricsStability() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -14,6 +14,8 @@ type Attribute struct {
 	Description string   `json:"description"`
 	Required    bool     `json:"required"`
 	Enum        []string `json:"enum,omitempty"`
+	Inferred    bool     `json:"inferred,omitempty"`
+	Resource    bool     `json:"resource,omitempty"`
 }
 
 type SemconvMatch string
@@ -44,6 +46,34 @@ type CanonicalMetric struct {
 	Commit           string           `json:"commit"`
 	ExtractedAt      time.Time        `json:"extracted_at"`
 
+	// Owner is the team responsible for a metric submitted to a catalog
+	Owner string `json:"owner,omitempty"`
+
+	// Signal is empty for metrics from adapters that predate events
+	Signal SignalKind `json:"signal,omitempty"`
+
+	// Stability declared upstream for the metric itself, where the source
+	// has such a notion (e.g. Kubernetes component-base stability levels)
+	Stability StabilityLevel `json:"stability,omitempty"`
+
+	// Aggregation details, left empty when the source doesn't declare them
+	ValueType              ValueType              `json:"value_type,omitempty"`
+	Monotonic              *bool                  `json:"monotonic,omitempty"`
+	AggregationTemporality AggregationTemporality `json:"aggregation_temporality,omitempty"`
+	BucketBoundaries       []float64              `json:"bucket_boundaries,omitempty"`
+
+	// How a metrics backend aggregates the series, for sources that publish
+	// pre-aggregated data: the valid statistics (CloudWatch statistics,
+	// Azure aggregation types), the recommended one and the publishing
+	// period as a duration such as 60s
+	Statistics           []string `json:"statistics,omitempty"`
+	RecommendedStatistic string   `json:"recommended_statistic,omitempty"`
+	Period               string   `json:"period,omitempty"`
+
+	// Orientation is the vendor's view of which way the metric is better:
+	// 1 when higher is, -1 when lower is, 0 when neither or unknown
+	Orientation int `json:"orientation,omitempty"`
+
 	// Semantic conventions enrichment
 	SemconvMatch     SemconvMatch `json:"semconv_match,omitempty"`
 	SemconvName      string       `json:"semconv_name,omitempty"`
@@ -59,6 +89,9 @@ var (
 	ErrInvalidSource      = errors.New("invalid source category")
 	ErrInvalidExtraction  = errors.New("invalid extraction method")
 	ErrInvalidConfidence  = errors.New("invalid confidence level")
+	ErrInvalidValueType   = errors.New("invalid value type")
+	ErrInvalidTemporality = errors.New("invalid aggregation temporality")
+	ErrInvalidSignal      = errors.New("invalid signal kind")
 )
 
 func (m *CanonicalMetric) Validate() error {
@@ -71,7 +104,14 @@ func (m *CanonicalMetric) Validate() error {
 	if m.SourceName == "" {
 		return ErrEmptySourceName
 	}
-	if !m.InstrumentType.IsValid() {
+	if m.Signal != "" && !m.Signal.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidSignal, m.Signal)
+	}
+	if m.Stability != "" && !m.Stability.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidStability, m.Stability)
+	}
+	// Events carry no instrument
+	if m.Signal != SignalKindEvent && !m.InstrumentType.IsValid() {
 		return fmt.Errorf("%w: %s", ErrInvalidInstrument, m.InstrumentType)
 	}
 	if !m.ComponentType.IsValid() {
@@ -86,6 +126,12 @@ func (m *CanonicalMetric) Validate() error {
 	if !m.SourceConfidence.IsValid() {
 		return fmt.Errorf("%w: %s", ErrInvalidConfidence, m.SourceConfidence)
 	}
+	if m.ValueType != "" && !m.ValueType.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidValueType, m.ValueType)
+	}
+	if m.AggregationTemporality != "" && !m.AggregationTemporality.IsValid() {
+		return fmt.Errorf("%w: %s", ErrInvalidTemporality, m.AggregationTemporality)
+	}
 	return nil
 }
 
@@ -96,6 +142,10 @@ func (m *CanonicalMetric) GenerateID() string {
 		m.ComponentName,
 		m.MetricName,
 	)
+	// Keep metric IDs stable while letting an event share a metric's name
+	if m.Signal == SignalKindEvent {
+		data = string(SignalKindEvent) + ":" + data
+	}
 	hash := sha256.Sum256([]byte(data))
 	return hex.EncodeToString(hash[:16])
 }
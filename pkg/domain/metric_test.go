@@ -73,6 +73,37 @@ func TestCanonicalMetric_Validate(t *testing.T) {
 			modify:  func(m *CanonicalMetric) { m.SourceConfidence = "invalid" },
 			wantErr: ErrInvalidConfidence,
 		},
+		{
+			name:    "invalid value type",
+			modify:  func(m *CanonicalMetric) { m.ValueType = "float" },
+			wantErr: ErrInvalidValueType,
+		},
+		{
+			name:    "invalid aggregation temporality",
+			modify:  func(m *CanonicalMetric) { m.AggregationTemporality = "instant" },
+			wantErr: ErrInvalidTemporality,
+		},
+		{
+			name:    "invalid signal kind",
+			modify:  func(m *CanonicalMetric) { m.Signal = "trace" },
+			wantErr: ErrInvalidSignal,
+		},
+		{
+			name: "event without instrument type",
+			modify: func(m *CanonicalMetric) {
+				m.Signal = SignalKindEvent
+				m.InstrumentType = ""
+			},
+			wantErr: nil,
+		},
+		{
+			name: "valid aggregation details",
+			modify: func(m *CanonicalMetric) {
+				m.ValueType = ValueTypeDouble
+				m.AggregationTemporality = TemporalityDelta
+			},
+			wantErr: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -144,6 +175,7 @@ func TestCanonicalMetric_GenerateID_Components(t *testing.T) {
 		{"different source name", func(m *CanonicalMetric) { m.SourceName = "different-source" }},
 		{"different component name", func(m *CanonicalMetric) { m.ComponentName = "different-component" }},
 		{"different metric name", func(m *CanonicalMetric) { m.MetricName = "different.metric" }},
+		{"event signal", func(m *CanonicalMetric) { m.Signal = SignalKindEvent }},
 	}
 
 	for _, tt := range tests {
@@ -155,6 +187,12 @@ func TestCanonicalMetric_GenerateID_Components(t *testing.T) {
 			}
 		})
 	}
+
+	metric := validMetric()
+	metric.Signal = SignalKindMetric
+	if metric.GenerateID() != baseID {
+		t.Error("GenerateID() should not change for an explicit metric signal")
+	}
 }
 
 func TestCanonicalMetric_EnsureID(t *testing.T) {
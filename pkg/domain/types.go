@@ -28,11 +28,15 @@ const (
 	ComponentConnector       ComponentType = "connector"
 	ComponentInstrumentation ComponentType = "instrumentation"
 	ComponentPlatform        ComponentType = "platform"
+	// ComponentTelemetry covers a component's internal self-monitoring metrics
+	ComponentTelemetry ComponentType = "telemetry"
+	// ComponentService is a team's own service, submitted to a catalog
+	ComponentService ComponentType = "service"
 )
 
 func (t ComponentType) IsValid() bool {
 	switch t {
-	case ComponentReceiver, ComponentExporter, ComponentProcessor, ComponentExtension, ComponentConnector, ComponentInstrumentation, ComponentPlatform:
+	case ComponentReceiver, ComponentExporter, ComponentProcessor, ComponentExtension, ComponentConnector, ComponentInstrumentation, ComponentPlatform, ComponentTelemetry, ComponentService:
 		return true
 	}
 	return false
@@ -47,11 +51,13 @@ const (
 	SourceCloud       SourceCategory = "cloud"
 	SourceVendor      SourceCategory = "vendor"
 	SourceCodingAgent SourceCategory = "codingagent"
+	// SourceInternal holds metrics teams submit for their own services
+	SourceInternal SourceCategory = "internal"
 )
 
 func (c SourceCategory) IsValid() bool {
 	switch c {
-	case SourceOTEL, SourcePrometheus, SourceKubernetes, SourceCloud, SourceVendor, SourceCodingAgent:
+	case SourceOTEL, SourcePrometheus, SourceKubernetes, SourceCloud, SourceVendor, SourceCodingAgent, SourceInternal:
 		return true
 	}
 	return false
@@ -90,3 +96,67 @@ func (c ConfidenceLevel) IsValid() bool {
 	}
 	return false
 }
+
+type ValueType string
+
+const (
+	ValueTypeInt    ValueType = "int"
+	ValueTypeDouble ValueType = "double"
+)
+
+func (t ValueType) IsValid() bool {
+	switch t {
+	case ValueTypeInt, ValueTypeDouble:
+		return true
+	}
+	return false
+}
+
+type AggregationTemporality string
+
+const (
+	TemporalityCumulative AggregationTemporality = "cumulative"
+	TemporalityDelta      AggregationTemporality = "delta"
+)
+
+func (t AggregationTemporality) IsValid() bool {
+	switch t {
+	case TemporalityCumulative, TemporalityDelta:
+		return true
+	}
+	return false
+}
+
+type StabilityLevel string
+
+const (
+	StabilityDevelopment  StabilityLevel = "development"
+	StabilityAlpha        StabilityLevel = "alpha"
+	StabilityBeta         StabilityLevel = "beta"
+	StabilityStable       StabilityLevel = "stable"
+	StabilityDeprecated   StabilityLevel = "deprecated"
+	StabilityUnmaintained StabilityLevel = "unmaintained"
+)
+
+func (l StabilityLevel) IsValid() bool {
+	switch l {
+	case StabilityDevelopment, StabilityAlpha, StabilityBeta, StabilityStable, StabilityDeprecated, StabilityUnmaintained:
+		return true
+	}
+	return false
+}
+
+type SignalKind string
+
+const (
+	SignalKindMetric SignalKind = "metric"
+	SignalKindEvent  SignalKind = "event"
+)
+
+func (k SignalKind) IsValid() bool {
+	switch k {
+	case SignalKindMetric, SignalKindEvent:
+		return true
+	}
+	return false
+}
@@ -39,6 +39,8 @@ func TestComponentType_IsValid(t *testing.T) {
 		{"connector", ComponentConnector, true},
 		{"instrumentation", ComponentInstrumentation, true},
 		{"platform", ComponentPlatform, true},
+		{"telemetry", ComponentTelemetry, true},
+		{"service", ComponentService, true},
 		{"invalid", ComponentType("invalid"), false},
 		{"empty", ComponentType(""), false},
 	}
@@ -63,6 +65,7 @@ func TestSourceCategory_IsValid(t *testing.T) {
 		{"kubernetes", SourceKubernetes, true},
 		{"cloud", SourceCloud, true},
 		{"vendor", SourceVendor, true},
+		{"internal", SourceInternal, true},
 		{"invalid", SourceCategory("invalid"), false},
 		{"empty", SourceCategory(""), false},
 	}
@@ -121,3 +124,91 @@ func TestConfidenceLevel_IsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestValueType_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		v     ValueType
+		valid bool
+	}{
+		{"int", ValueTypeInt, true},
+		{"double", ValueTypeDouble, true},
+		{"invalid", ValueType("float"), false},
+		{"empty", ValueType(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.IsValid(); got != tt.valid {
+				t.Errorf("ValueType(%q).IsValid() = %v, want %v", tt.v, got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestAggregationTemporality_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     AggregationTemporality
+		valid bool
+	}{
+		{"cumulative", TemporalityCumulative, true},
+		{"delta", TemporalityDelta, true},
+		{"invalid", AggregationTemporality("instant"), false},
+		{"empty", AggregationTemporality(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.IsValid(); got != tt.valid {
+				t.Errorf("AggregationTemporality(%q).IsValid() = %v, want %v", tt.a, got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestStabilityLevel_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		l     StabilityLevel
+		valid bool
+	}{
+		{"development", StabilityDevelopment, true},
+		{"alpha", StabilityAlpha, true},
+		{"beta", StabilityBeta, true},
+		{"stable", StabilityStable, true},
+		{"deprecated", StabilityDeprecated, true},
+		{"unmaintained", StabilityUnmaintained, true},
+		{"invalid", StabilityLevel("experimental"), false},
+		{"empty", StabilityLevel(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.l.IsValid(); got != tt.valid {
+				t.Errorf("StabilityLevel(%q).IsValid() = %v, want %v", tt.l, got, tt.valid)
+			}
+		})
+	}
+}
+
+func TestSignalKind_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		k     SignalKind
+		valid bool
+	}{
+		{"metric", SignalKindMetric, true},
+		{"event", SignalKindEvent, true},
+		{"invalid", SignalKind("trace"), false},
+		{"empty", SignalKind(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.k.IsValid(); got != tt.valid {
+				t.Errorf("SignalKind(%q).IsValid() = %v, want %v", tt.k, got, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,813 @@
+// Package glossary is the glossary command line. Main runs every built-in
+// adapter along with any the binary registered before calling it, so a
+// custom build only needs its own adapter packages and a main that calls
+// Main; see examples/customadapter.
+package glossary
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/base-14/metric-library/internal/adapter/clouddata"
+	"github.com/base-14/metric-library/internal/adapter/declarative"
+	"github.com/base-14/metric-library/internal/adapter/otel/semconv"
+	"github.com/base-14/metric-library/internal/adapter/otlp"
+	"github.com/base-14/metric-library/internal/api"
+	"github.com/base-14/metric-library/internal/config"
+	"github.com/base-14/metric-library/internal/enricher"
+	"github.com/base-14/metric-library/internal/lint"
+	"github.com/base-14/metric-library/internal/orchestrator"
+	"github.com/base-14/metric-library/internal/store"
+	"github.com/base-14/metric-library/pkg/adapter"
+	_ "github.com/base-14/metric-library/pkg/adapter/all"
+	"github.com/base-14/metric-library/pkg/domain"
+)
+
+// Main runs the subcommand named by args[0], the arguments after the
+// program name, and serves the API when there is none.
+func Main(args []string) error {
+	if len(args) == 0 {
+		return runServe(nil)
+	}
+
+	switch args[0] {
+	case "serve":
+		return runServe(args[1:])
+	case "extract":
+		return runExtract(args[1:])
+	case "enrich":
+		return runEnrich(args[1:])
+	case "refresh-cloud":
+		return runRefreshCloud(args[1:])
+	case "adapters":
+		return runAdapters(args[1:])
+	case "config":
+		return runConfig(args[1:])
+	case "registry":
+		return runRegistry(args[1:])
+	case "lint":
+		return runLint(args[1:])
+	default:
+		return runServe(nil)
+	}
+}
+
+// loadConfig reads glossary.yaml, or the file named by -config or
+// $GLOSSARY_CONFIG, with the environment applied. Callers apply their flags
+// on top.
+func loadConfig(file string) (*config.Config, error) {
+	cfg, err := config.Load(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	if cfg.File != "" {
+		log.Printf("Using config %s", cfg.File)
+	}
+	return cfg, nil
+}
+
+func runServe(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	configFile := fs.String("config", "", "Config file (default: $GLOSSARY_CONFIG or ./glossary.yaml if present)")
+	port := fs.String("port", "", "Port to listen on (default: $PORT or server.port)")
+	dbPath := fs.String("db", "", "Database path (default: $DATABASE_PATH or server.database_path)")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if *port != "" {
+		cfg.Server.Port = *port
+	}
+	if *dbPath != "" {
+		cfg.Server.DatabasePath = *dbPath
+	}
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	if err := declarative.Register(cfg.Server.AdaptersDir); err != nil {
+		return fmt.Errorf("failed to load adapter specs: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.Server.DatabasePath), 0750); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	log.Printf("Connecting to database at %s", cfg.Server.DatabasePath)
+	s, err := store.NewSQLiteStoreWithMigrations(cfg.Server.DatabasePath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize store: %w", err)
+	}
+	defer func() { _ = s.Close() }()
+
+	linter, err := lint.New(cfg.Lint.Rules)
+	if err != nil {
+		return fmt.Errorf("lint.rules: %w", err)
+	}
+
+	opts := api.Options{
+		AllowedOrigins: cfg.CORS.AllowedOrigins,
+		Tokens:         cfg.Auth.Tokens,
+		WriteTokens:    cfg.Auth.WriteTokens,
+		Linter:         linter,
+	}
+	if cfg.Server.OTLPReceiverAddr != "" {
+		opts.ReservedSources = []string{cfg.Server.OTLPSourceName}
+	}
+	handler := api.NewHandlerWithOptions(s, opts)
+
+	var otlpServer *http.Server
+	if addr := cfg.Server.OTLPReceiverAddr; addr != "" {
+		sourceName := cfg.Server.OTLPSourceName
+
+		ext := orchestrator.NewExtractor(otlp.NewAdapter(sourceName, nil), s)
+		receiver := otlp.NewReceiver(func(ctx context.Context, metrics []*adapter.RawMetric, result *adapter.FetchResult) error {
+			_, err := ext.Ingest(ctx, metrics, result)
+			return err
+		})
+
+		otlpServer = &http.Server{
+			Addr:         addr,
+			Handler:      api.RequireWriteToken(cfg.Auth.WriteTokens, receiver),
+			ReadTimeout:  15 * time.Second,
+			WriteTimeout: 15 * time.Second,
+		}
+
+		go func() {
+			log.Printf("Starting OTLP/HTTP receiver on %s (source %q)", addr, sourceName)
+			if err := otlpServer.ListenAndServe(); err != http.ErrServerClosed {
+				log.Printf("OTLP receiver error: %v", err)
+			}
+		}()
+	}
+
+	server := &http.Server{
+		Addr:         ":" + cfg.Server.Port,
+		Handler:      handler,
+		ReadTimeout:  15 * time.Second,
+		WriteTimeout: 15 * time.Second,
+		IdleTimeout:  60 * time.Second,
+	}
+
+	done := make(chan struct{})
+	go func() {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+		<-sigCh
+
+		log.Println("Shutting down server...")
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(ctx); err != nil {
+			log.Printf("Server shutdown error: %v", err)
+		}
+		if otlpServer != nil {
+			if err := otlpServer.Shutdown(ctx); err != nil {
+				log.Printf("OTLP receiver shutdown error: %v", err)
+			}
+		}
+		close(done)
+	}()
+
+	log.Printf("Starting server on :%s", cfg.Server.Port)
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		return fmt.Errorf("server error: %w", err)
+	}
+
+	<-done
+	log.Println("Server stopped")
+	return nil
+}
+
+func runExtract(args []string) error {
+	fs := flag.NewFlagSet("extract", flag.ExitOnError)
+	configFile := fs.String("config", "", "Config file (default: $GLOSSARY_CONFIG or ./glossary.yaml if present)")
+	adapterName := fs.String("adapter", "otel-collector-contrib", "Adapter to use for extraction")
+	cacheDir := fs.String("cache-dir", "", "Directory to cache git repositories (default: $CACHE_DIR or server.cache_dir)")
+	force := fs.Bool("force", false, "Force re-fetch even if cached")
+	ref := fs.String("ref", "", "Commit, tag or branch to extract (default: the adapter's ref in the config, else latest)")
+	dbPath := fs.String("db", "", "Database path (default: $DATABASE_PATH or server.database_path)")
+	file := fs.String("file", "", "Exposition file or http(s) endpoint (scrape), comma-separated OTLP payload files (otlp), or registry directory or git URL (weaver)")
+	component := fs.String("component", "", "Component name to catalog scraped metrics under (scrape adapter)")
+	source := fs.String("source", "", "Source name to store metrics under (otlp and weaver adapters, default: the adapter name)")
+	adaptersDir := fs.String("adapters-dir", "", "Directory of declarative adapter specs (default: $ADAPTERS_DIR or server.adapters_dir)")
+	include := fs.String("include", "", "Comma-separated component name globs to keep (replaces the config's include)")
+	exclude := fs.String("exclude", "", "Comma-separated component name globs to drop (replaces the config's exclude)")
+	includeMetrics := fs.String("include-metrics", "", "Comma-separated metric name globs to keep")
+	excludeMetrics := fs.String("exclude-metrics", "", "Comma-separated metric name globs to drop")
+	includePaths := fs.String("include-paths", "", "Comma-separated source path globs to keep, ** spanning directories")
+	excludePaths := fs.String("exclude-paths", "", "Comma-separated source path globs to drop, ** spanning directories")
+	enabledByDefault := fs.String("enabled-by-default", "", "Keep only metrics enabled (true) or not enabled (false) by default")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Server.DatabasePath
+	}
+	if *cacheDir == "" {
+		*cacheDir = cfg.Server.CacheDir
+	}
+	if *adaptersDir == "" {
+		*adaptersDir = cfg.Server.AdaptersDir
+	}
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	if err := declarative.Register(*adaptersDir); err != nil {
+		return fmt.Errorf("failed to load adapter specs: %w", err)
+	}
+
+	adapterCfg := cfg.Adapter(*adapterName)
+	if !adapterCfg.IsEnabled() {
+		return fmt.Errorf("adapter %s is disabled in %s", *adapterName, cfg.File)
+	}
+	if *ref == "" {
+		*ref = adapterCfg.Ref
+	}
+	if *ref != "" && !config.ValidRef(*ref) {
+		return fmt.Errorf("-ref: %q is not a commit, tag or branch", *ref)
+	}
+
+	filter := orchestrator.Filter{
+		IncludeComponents: globs(*include, adapterCfg.Include),
+		ExcludeComponents: globs(*exclude, adapterCfg.Exclude),
+		IncludeMetrics:    globs(*includeMetrics, adapterCfg.IncludeMetrics),
+		ExcludeMetrics:    globs(*excludeMetrics, adapterCfg.ExcludeMetrics),
+		IncludePaths:      globs(*includePaths, adapterCfg.IncludePaths),
+		ExcludePaths:      globs(*excludePaths, adapterCfg.ExcludePaths),
+		EnabledByDefault:  adapterCfg.EnabledByDefault,
+	}
+	if *enabledByDefault != "" {
+		enabled, err := strconv.ParseBool(*enabledByDefault)
+		if err != nil {
+			return fmt.Errorf("-enabled-by-default: %w", err)
+		}
+		filter.EnabledByDefault = &enabled
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*dbPath), 0750); err != nil {
+		return fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	log.Printf("Connecting to database at %s", *dbPath)
+	s, err := store.NewSQLiteStoreWithMigrations(*dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize store: %w", err)
+	}
+	defer func() { _ = s.Close() }()
+
+	adp, err := adapter.New(*adapterName, adapter.Options{
+		CacheDir:  *cacheDir,
+		File:      *file,
+		Component: *component,
+		Source:    *source,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Starting extraction with adapter: %s", adp.Name())
+	log.Printf("Cache directory: %s", *cacheDir)
+
+	ext := orchestrator.NewExtractor(adp, s)
+	ctx := context.Background()
+
+	result, err := ext.Run(ctx, orchestrator.Options{
+		Commit:   *ref,
+		CacheDir: *cacheDir,
+		Force:    *force,
+		Filter:   filter,
+	})
+	if err != nil {
+		return fmt.Errorf("extraction failed: %w", err)
+	}
+
+	log.Printf("Extraction completed successfully")
+	log.Printf("  Adapter: %s", result.AdapterName)
+	log.Printf("  Commit: %s", result.Commit)
+	log.Printf("  Metrics extracted: %d", result.MetricsExtracted)
+	if result.MetricsFiltered > 0 {
+		log.Printf("  Metrics filtered out: %d", result.MetricsFiltered)
+	}
+	log.Printf("  Metrics stored: %d", result.MetricsStored)
+	log.Printf("  Components stored: %d", result.ComponentsStored)
+	log.Printf("  Duration: %s", result.Duration)
+
+	if cfg.Enrichment.AfterExtract {
+		if err := enrich(ctx, s, cfg.Enrichment); err != nil {
+			log.Printf("Enrichment skipped: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// searchPageSize is how many metrics searchAll loads per query.
+const searchPageSize = 1000
+
+// searchAll loads every metric query matches, a page at a time, ignoring
+// the query's own Limit and Offset.
+func searchAll(ctx context.Context, s store.Store, query store.SearchQuery) ([]*domain.CanonicalMetric, error) {
+	query.Limit = searchPageSize
+	query.Offset = 0
+
+	var metrics []*domain.CanonicalMetric
+	for {
+		result, err := s.Search(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+		metrics = append(metrics, result.Metrics...)
+		if len(result.Metrics) == 0 || len(metrics) >= result.Total {
+			return metrics, nil
+		}
+		query.Offset += len(result.Metrics)
+	}
+}
+
+// globs splits a comma-separated flag, falling back to the configured
+// patterns when the flag wasn't given.
+func globs(flagValue string, configured []string) []string {
+	if flagValue == "" {
+		return configured
+	}
+	var patterns []string
+	for _, p := range strings.Split(flagValue, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+func runAdapters(args []string) error {
+	if len(args) == 0 || args[0] != "list" {
+		return fmt.Errorf("usage: glossary adapters list [-category c] [-tag t] [-adapters-dir dir]")
+	}
+
+	fs := flag.NewFlagSet("adapters list", flag.ExitOnError)
+	category := fs.String("category", "", "Only list adapters in this source category")
+	tag := fs.String("tag", "", "Only list adapters with this tag")
+	enabled := fs.Bool("enabled", false, "Only list adapters the config doesn't disable")
+	configFile := fs.String("config", "", "Config file (default: $GLOSSARY_CONFIG or ./glossary.yaml if present)")
+	adaptersDir := fs.String("adapters-dir", "", "Directory of declarative adapter specs (default: $ADAPTERS_DIR or server.adapters_dir)")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if *adaptersDir == "" {
+		*adaptersDir = cfg.Server.AdaptersDir
+	}
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	if err := declarative.Register(*adaptersDir); err != nil {
+		return fmt.Errorf("failed to load adapter specs: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "NAME\tCATEGORY\tSCHEDULE\tTAGS\tDESCRIPTION")
+	for _, r := range adapter.Registrations() {
+		if *category != "" && string(r.Category) != *category {
+			continue
+		}
+		if *tag != "" && !slices.Contains(r.Tags, *tag) {
+			continue
+		}
+		adapterCfg := cfg.Adapter(r.Name)
+		if *enabled && !adapterCfg.IsEnabled() {
+			continue
+		}
+		schedule := r.Schedule
+		if adapterCfg.Schedule != "" {
+			schedule = adapterCfg.Schedule
+		}
+		if schedule == "" {
+			schedule = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.Name, r.Category, schedule, strings.Join(r.Tags, ","), r.Description)
+	}
+	return w.Flush()
+}
+
+func runConfig(args []string) error {
+	if len(args) == 0 || args[0] != "validate" {
+		return fmt.Errorf("usage: glossary config validate [-config file]")
+	}
+
+	fs := flag.NewFlagSet("config validate", flag.ExitOnError)
+	configFile := fs.String("config", "", "Config file (default: $GLOSSARY_CONFIG or ./glossary.yaml if present)")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+
+	if err := declarative.Register(cfg.Server.AdaptersDir); err != nil {
+		return fmt.Errorf("failed to load adapter specs: %w", err)
+	}
+	known := func(name string) bool {
+		_, ok := adapter.Lookup(name)
+		return ok
+	}
+
+	if err := cfg.Validate(known); err != nil {
+		return fmt.Errorf("invalid config:\n%w", err)
+	}
+
+	file := cfg.File
+	if file == "" {
+		file = "defaults"
+	}
+	fmt.Printf("%s: OK (%d adapters configured)\n", file, len(cfg.Adapters))
+	return nil
+}
+
+func runRegistry(args []string) error {
+	if len(args) == 0 || args[0] != "export" {
+		return fmt.Errorf("usage: glossary registry export -name n -out dir [-source s] [-category c] [-component c] [-q text]")
+	}
+
+	fs := flag.NewFlagSet("registry export", flag.ExitOnError)
+	configFile := fs.String("config", "", "Config file (default: $GLOSSARY_CONFIG or ./glossary.yaml if present)")
+	dbPath := fs.String("db", "", "Database path (default: $DATABASE_PATH or server.database_path)")
+	name := fs.String("name", "", "Registry name for the manifest")
+	out := fs.String("out", "", "Directory to write the registry to")
+	version := fs.String("version", "", "semconv_version to stamp on the manifest")
+	description := fs.String("description", "", "Registry description")
+	sources := fs.String("source", "", "Comma-separated source names to export")
+	categories := fs.String("category", "", "Comma-separated source categories to export")
+	components := fs.String("component", "", "Comma-separated component names to export")
+	owners := fs.String("owner", "", "Comma-separated owners to export")
+	text := fs.String("q", "", "Full-text search the exported metrics must match")
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *name == "" || *out == "" {
+		return fmt.Errorf("registry export requires -name and -out")
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Server.DatabasePath
+	}
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	s, err := store.NewSQLiteStoreWithMigrations(*dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize store: %w", err)
+	}
+	defer func() { _ = s.Close() }()
+
+	query := store.SearchQuery{
+		Text:           *text,
+		SourceNames:    globs(*sources, nil),
+		ComponentNames: globs(*components, nil),
+		Owners:         globs(*owners, nil),
+	}
+	for _, c := range globs(*categories, nil) {
+		query.SourceCategories = append(query.SourceCategories, domain.SourceCategory(c))
+	}
+
+	metrics, err := searchAll(context.Background(), s, query)
+	if err != nil {
+		return fmt.Errorf("failed to load metrics: %w", err)
+	}
+	if len(metrics) == 0 {
+		return fmt.Errorf("no metrics match")
+	}
+
+	manifest := semconv.Manifest{
+		Name:           *name,
+		Description:    *description,
+		SemconvVersion: *version,
+	}
+	if err := semconv.Export(*out, manifest, metrics); err != nil {
+		return fmt.Errorf("export failed: %w", err)
+	}
+
+	log.Printf("Exported registry %s from %d metrics to %s", *name, len(metrics), *out)
+	return nil
+}
+
+func runLint(args []string) error {
+	fs := flag.NewFlagSet("lint", flag.ExitOnError)
+	configFile := fs.String("config", "", "Config file (default: $GLOSSARY_CONFIG or ./glossary.yaml if present)")
+	dbPath := fs.String("db", "", "Database path (default: $DATABASE_PATH or server.database_path)")
+	sources := fs.String("source", "", "Comma-separated source names to lint (default: all)")
+	categories := fs.String("category", "", "Comma-separated source categories to lint")
+	components := fs.String("component", "", "Comma-separated component names to lint")
+	owners := fs.String("owner", "", "Comma-separated owners to lint")
+	text := fs.String("q", "", "Full-text search the linted metrics must match")
+	minSeverity := fs.String("severity", string(lint.SeverityWarning), "Least severe violation to list (info, warning, error)")
+	failOn := fs.String("fail-on", "", "Exit non-zero when any violation is at least this severe")
+	format := fs.String("format", "text", "Output format (text, json)")
+	listRules := fs.Bool("rules", false, "List the rules and their severities, then exit")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Server.DatabasePath
+	}
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	linter, err := lint.New(cfg.Lint.Rules)
+	if err != nil {
+		return fmt.Errorf("lint.rules: %w", err)
+	}
+
+	if *listRules {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "RULE\tSTYLE\tSEVERITY\tDESCRIPTION")
+		for _, rule := range linter.Rules() {
+			style := string(rule.Style)
+			if style == "" {
+				style = "any"
+			}
+			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", rule.ID, style, rule.Severity, rule.Description)
+		}
+		return w.Flush()
+	}
+
+	for _, s := range []string{*minSeverity, *failOn} {
+		if s != "" && !lint.Severity(s).IsValid() {
+			return fmt.Errorf("unknown severity %q", s)
+		}
+	}
+
+	s, err := store.NewSQLiteStoreWithMigrations(*dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize store: %w", err)
+	}
+	defer func() { _ = s.Close() }()
+
+	query := store.SearchQuery{
+		Text:           *text,
+		SourceNames:    globs(*sources, nil),
+		ComponentNames: globs(*components, nil),
+		Owners:         globs(*owners, nil),
+	}
+	for _, c := range globs(*categories, nil) {
+		query.SourceCategories = append(query.SourceCategories, domain.SourceCategory(c))
+	}
+
+	metrics, err := searchAll(context.Background(), s, query)
+	if err != nil {
+		return fmt.Errorf("failed to load metrics: %w", err)
+	}
+
+	results, scores := linter.LintAll(metrics)
+
+	// Only list what reaches the minimum severity
+	listed := make([]*lint.Result, 0, len(results))
+	failed := 0
+	for _, r := range results {
+		var shown []lint.Violation
+		for _, v := range r.Violations {
+			if v.Severity.AtLeast(lint.Severity(*minSeverity)) {
+				shown = append(shown, v)
+			}
+		}
+		if *failOn != "" && r.Has(lint.Severity(*failOn)) {
+			failed++
+		}
+		if len(shown) > 0 {
+			listed = append(listed, &lint.Result{
+				MetricID:   r.MetricID,
+				MetricName: r.MetricName,
+				SourceName: r.SourceName,
+				Style:      r.Style,
+				Violations: shown,
+				Score:      r.Score,
+			})
+		}
+	}
+
+	switch *format {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(map[string]any{"results": listed, "sources": scores}); err != nil {
+			return err
+		}
+	case "text":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		if len(listed) > 0 {
+			_, _ = fmt.Fprintln(w, "SEVERITY\tRULE\tSOURCE\tMETRIC\tMESSAGE")
+			for _, r := range listed {
+				for _, v := range r.Violations {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", v.Severity, v.Rule, r.SourceName, r.MetricName, v.Message)
+				}
+			}
+			_, _ = fmt.Fprintln(w)
+		}
+		_, _ = fmt.Fprintln(w, "SOURCE\tMETRICS\tERRORS\tWARNINGS\tINFO\tSCORE")
+		for _, sc := range scores {
+			_, _ = fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%.1f\n", sc.SourceName, sc.Metrics, sc.Errors, sc.Warnings, sc.Infos, sc.Score)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d metrics have %s violations", failed, *failOn)
+	}
+	return nil
+}
+
+func runRefreshCloud(args []string) error {
+	fs := flag.NewFlagSet("refresh-cloud", flag.ExitOnError)
+	table := fs.String("table", "", "Cloud metric table to refresh, e.g. cloudwatch/ec2 (empty lists the tables)")
+	input := fs.String("input", "", "Saved CloudWatch doc page, GCP metricDescriptors JSON or Azure supported-metrics page")
+	version := fs.String("version", "", "Version to stamp on the table (default: today)")
+	out := fs.String("out", "", "File to write (default: the table under "+clouddata.SourceDir+")")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *table == "" {
+		for _, key := range clouddata.Keys() {
+			fmt.Println(key)
+		}
+		return nil
+	}
+	if *input == "" {
+		return fmt.Errorf("refresh-cloud requires -input")
+	}
+	if *out == "" {
+		*out = filepath.Join(clouddata.SourceDir, *table+".yaml")
+	}
+
+	upstream, err := os.ReadFile(*input)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", *input, err)
+	}
+
+	refreshed, err := clouddata.Refresh(*table, upstream, *version)
+	if err != nil {
+		return fmt.Errorf("refresh failed: %w", err)
+	}
+
+	content, err := refreshed.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*out, content, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", *out, err)
+	}
+
+	log.Printf("Refreshed %s to version %s with %d metrics", *table, refreshed.Version, len(refreshed.Metrics))
+	log.Printf("  Written to: %s", *out)
+
+	return nil
+}
+
+func runEnrich(args []string) error {
+	fs := flag.NewFlagSet("enrich", flag.ExitOnError)
+	configFile := fs.String("config", "", "Config file (default: $GLOSSARY_CONFIG or ./glossary.yaml if present)")
+	dbPath := fs.String("db", "", "Database path (default: $DATABASE_PATH or server.database_path)")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configFile)
+	if err != nil {
+		return err
+	}
+	if *dbPath == "" {
+		*dbPath = cfg.Server.DatabasePath
+	}
+	if err := cfg.Validate(nil); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	log.Printf("Connecting to database at %s", *dbPath)
+	s, err := store.NewSQLiteStoreWithMigrations(*dbPath)
+	if err != nil {
+		return fmt.Errorf("failed to initialize store: %w", err)
+	}
+	defer func() { _ = s.Close() }()
+
+	return enrich(context.Background(), s, cfg.Enrichment)
+}
+
+// enrich matches every stored metric against the semconv metrics and those
+// of the configured registries, which are taken to be at the default
+// stability unless they say otherwise.
+func enrich(ctx context.Context, s store.Store, cfg config.Enrichment) error {
+	// Load semconv metrics
+	semconvMetrics, err := s.GetSemconvMetrics(ctx, append([]string{store.SemconvSource}, cfg.Registries...)...)
+	if err != nil {
+		return fmt.Errorf("failed to load semconv metrics: %w", err)
+	}
+
+	if len(semconvMetrics) == 0 {
+		return fmt.Errorf("no semconv metrics found. Run 'extract -adapter otel-semconv' first")
+	}
+
+	log.Printf("Loaded %d semconv metrics for enrichment", len(semconvMetrics))
+
+	// Build enricher index
+	semconvIndex := make([]enricher.SemconvMetric, 0, len(semconvMetrics))
+	for _, m := range semconvMetrics {
+		metricStability := m.Stability
+		if metricStability == "" {
+			metricStability = cfg.DefaultStability
+		}
+		semconvIndex = append(semconvIndex, enricher.SemconvMetric{
+			Name:      m.MetricName,
+			Stability: string(metricStability),
+		})
+	}
+
+	e := enricher.NewSemconvEnricher(semconvIndex)
+
+	// Load all metrics and enrich them
+	metrics, err := searchAll(ctx, s, store.SearchQuery{})
+	if err != nil {
+		return fmt.Errorf("failed to load metrics: %w", err)
+	}
+
+	log.Printf("Enriching %d metrics...", len(metrics))
+
+	// Enrich all metrics
+	e.EnrichAll(metrics)
+
+	// Count results
+	var exactCount, prefixCount, noneCount int
+	for _, m := range metrics {
+		switch m.SemconvMatch {
+		case "exact":
+			exactCount++
+		case "prefix":
+			prefixCount++
+		default:
+			noneCount++
+		}
+	}
+
+	// Update enriched metrics in database
+	if err := s.UpsertMetrics(ctx, metrics); err != nil {
+		return fmt.Errorf("failed to update enriched metrics: %w", err)
+	}
+
+	log.Printf("Enrichment completed successfully")
+	log.Printf("  Exact matches: %d", exactCount)
+	log.Printf("  Prefix matches: %d", prefixCount)
+	log.Printf("  No match: %d", noneCount)
+
+	return nil
+}
@@ -19,6 +19,7 @@ const sourceGroupConfig: { prefix: string; label: string }[] = [
   { prefix: 'openllmetry', label: 'OpenLLMetry' },
   { prefix: 'openlit', label: 'OpenLIT' },
   { prefix: 'codingagent-', label: 'Coding Agents' },
+  { prefix: 'vendor-', label: 'Vendors' },
 ];
 
 interface FilterPanelProps {
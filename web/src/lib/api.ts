@@ -1,4 +1,4 @@
-import { CanonicalMetric, FacetResponse, SearchParams, SearchResponse } from '@/types/api';
+import { AdaptersResponse, CanonicalMetric, FacetResponse, LintResult, SearchParams, SearchResponse } from '@/types/api';
 
 const API_BASE = process.env.NEXT_PUBLIC_API_URL || '';
 
@@ -36,6 +36,16 @@ export async function getMetric(id: string): Promise<CanonicalMetric> {
   return response.json();
 }
 
+export async function getMetricLint(id: string): Promise<LintResult> {
+  const response = await fetch(`${API_BASE}/api/metrics/${id}/lint`);
+
+  if (!response.ok) {
+    throw new Error(`Failed to lint metric: ${response.statusText}`);
+  }
+
+  return response.json();
+}
+
 export async function getFacets(sourceName?: string): Promise<FacetResponse> {
   const params = new URLSearchParams();
   if (sourceName) params.set('source_name', sourceName);
@@ -49,3 +59,13 @@ export async function getFacets(sourceName?: string): Promise<FacetResponse> {
 
   return response.json();
 }
+
+export async function getAdapters(): Promise<AdaptersResponse> {
+  const response = await fetch(`${API_BASE}/api/adapters`);
+
+  if (!response.ok) {
+    throw new Error(`Failed to get adapters: ${response.statusText}`);
+  }
+
+  return response.json();
+}
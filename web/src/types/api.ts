@@ -4,6 +4,8 @@ export interface Attribute {
   description: string;
   required: boolean;
   enum?: string[];
+  inferred?: boolean;
+  resource?: boolean;
 }
 
 export type SemconvMatch = 'exact' | 'prefix' | 'none' | '';
@@ -30,6 +32,59 @@ export interface CanonicalMetric {
   semconv_match?: SemconvMatch;
   semconv_name?: string;
   semconv_stability?: string;
+  value_type?: 'int' | 'double';
+  monotonic?: boolean;
+  aggregation_temporality?: 'cumulative' | 'delta';
+  bucket_boundaries?: number[];
+  signal?: 'metric' | 'event';
+  stability?: StabilityLevel;
+  statistics?: string[];
+  recommended_statistic?: string;
+  period?: string;
+  owner?: string;
+  orientation?: -1 | 1;
+}
+
+export type StabilityLevel =
+  | 'development'
+  | 'alpha'
+  | 'beta'
+  | 'stable'
+  | 'deprecated'
+  | 'unmaintained';
+
+export interface ComponentWarning {
+  metric?: string;
+  kind: string;
+  message: string;
+}
+
+export interface Component {
+  type: string;
+  name: string;
+  source_name: string;
+  class?: string;
+  stability: Record<string, StabilityLevel>;
+  distributions?: string[];
+  codeowners?: string[];
+  emeritus_codeowners?: string[];
+  warnings?: ComponentWarning[];
+  repo: string;
+  path: string;
+  commit: string;
+  extracted_at: string;
+}
+
+export interface AdapterRegistration {
+  name: string;
+  category: string;
+  description: string;
+  schedule?: string;
+  tags?: string[];
+}
+
+export interface AdaptersResponse {
+  adapters: AdapterRegistration[];
 }
 
 export interface SearchResponse {
@@ -50,6 +105,23 @@ export interface FacetResponse {
   units: Record<string, number>;
 }
 
+export type LintSeverity = 'info' | 'warning' | 'error';
+
+export interface LintViolation {
+  rule: string;
+  severity: LintSeverity;
+  message: string;
+}
+
+export interface LintResult {
+  metric_id: string;
+  metric_name: string;
+  source_name: string;
+  style?: 'otel' | 'prometheus';
+  violations: LintViolation[];
+  score: number;
+}
+
 export interface SearchParams {
   q?: string;
   instrument_type?: string;
@@ -59,6 +131,11 @@ export interface SearchParams {
   source_name?: string;
   confidence?: string;
   semconv_match?: string;
+  signal?: string;
+  stability?: string;
+  attribute?: string;
+  component_stability?: string;
+  statistic?: string;
   limit?: number;
   offset?: number;
 }